@@ -0,0 +1,279 @@
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/operationstats"
+	"github.com/senzing-garage/sz-sdk-go-grpc/withinfo"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Runner runs a benchmark and produces a [Report].
+*/
+type Runner struct {
+	SzDiagnostic senzing.SzDiagnostic
+
+	// Optional. Needed for the workload.
+	SzEngine senzing.SzEngine
+
+	// Optional. The data source of workload records. If empty, DefaultDataSourceCode is used.
+	DataSourceCode string
+
+	// Optional. The number of round trips timed. If 0, DefaultLatencySamples is used.
+	LatencySamples int
+
+	// Optional. A label for the report, such as the name of the environment.
+	Name string
+
+	// Optional. The prefix of workload record identifiers. If empty, one is made from the start time.
+	RecordIDPrefix string
+
+	// Optional. The number of times each duration is run. If 0, DefaultRepetitions is used.
+	Repetitions int
+
+	// Optional. The durations, in seconds, of CheckRepositoryPerformance. If empty, DefaultSecondsToRun is used.
+	SecondsToRun []int
+
+	// Optional. The number of records the workload adds, reads and deletes. If 0, there is no workload.
+	WorkloadRecords int
+}
+
+type performanceResponse struct {
+	InsertTime         int64 `json:"insertTime"`
+	NumRecordsInserted int64 `json:"numRecordsInserted"`
+}
+
+// ----------------------------------------------------------------------------
+// Runner methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Run runs the benchmark: round-trip latency, then CheckRepositoryPerformance, then the workload, if any.
+Workload records are deleted when the workload ends.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The report.
+*/
+func (runner *Runner) Run(ctx context.Context) (*Report, error) {
+	startedAt := time.Now()
+	result := &Report{
+		Duration:    0,
+		Name:        runner.Name,
+		Operations:  []OperationStats{},
+		Performance: []PerformanceResult{},
+		StartedAt:   startedAt.UTC(),
+	}
+
+	roundTrip, err := runner.measureRoundTrip(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Operations = append(result.Operations, roundTrip)
+
+	result.Performance, err = runner.measurePerformance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if runner.WorkloadRecords > 0 {
+		if runner.SzEngine == nil {
+			return nil, wraperror.Errorf(errForPackage, "a workload needs SzEngine")
+		}
+
+		workload, err := runner.runWorkload(ctx, startedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Operations = append(result.Operations, workload...)
+	}
+
+	result.Duration = time.Since(startedAt)
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (runner *Runner) measurePerformance(ctx context.Context) ([]PerformanceResult, error) {
+	secondsToRun := runner.SecondsToRun
+	if len(secondsToRun) == 0 {
+		secondsToRun = []int{DefaultSecondsToRun}
+	}
+
+	repetitions := runner.Repetitions
+	if repetitions <= 0 {
+		repetitions = DefaultRepetitions
+	}
+
+	result := make([]PerformanceResult, 0, len(secondsToRun))
+
+	for _, seconds := range secondsToRun {
+		runs := make([]PerformanceRun, 0, repetitions)
+
+		for range repetitions {
+			responseJSON, err := runner.SzDiagnostic.CheckRepositoryPerformance(ctx, seconds)
+			if err != nil {
+				return nil, wraperror.Errorf(err, "CheckRepositoryPerformance(%d)", seconds)
+			}
+
+			response := performanceResponse{} //exhaustruct:ignore
+
+			err = json.Unmarshal([]byte(responseJSON), &response)
+			if err != nil {
+				return nil, wraperror.Errorf(err, "json.Unmarshal: CheckRepositoryPerformance")
+			}
+
+			runs = append(runs, newPerformanceRun(response))
+		}
+
+		result = append(result, newPerformanceResult(seconds, runs))
+	}
+
+	return result, nil
+}
+
+func (runner *Runner) measureRoundTrip(ctx context.Context) (OperationStats, error) {
+	samples := runner.LatencySamples
+	if samples <= 0 {
+		samples = DefaultLatencySamples
+	}
+
+	latencies := make([]time.Duration, 0, samples)
+
+	for range samples {
+		start := time.Now()
+
+		_, err := runner.SzDiagnostic.GetRepositoryInfo(ctx)
+		if err != nil {
+			return OperationStats{}, wraperror.Errorf(err, "GetRepositoryInfo")
+		}
+
+		latencies = append(latencies, time.Since(start))
+	}
+
+	return operationstats.New(OperationRoundTrip, latencies, 0, 0), nil
+}
+
+/*
+Add, read and delete WorkloadRecords records, one at a time.
+Failed calls are counted and do not stop the workload.
+*/
+func (runner *Runner) runWorkload(ctx context.Context, startedAt time.Time) ([]OperationStats, error) {
+	dataSourceCode := runner.DataSourceCode
+	if len(dataSourceCode) == 0 {
+		dataSourceCode = DefaultDataSourceCode
+	}
+
+	recordIDPrefix := runner.RecordIDPrefix
+	if len(recordIDPrefix) == 0 {
+		recordIDPrefix = "BENCHMARK-" + strconv.FormatInt(startedAt.UnixNano(), 10) + "-"
+	}
+
+	var (
+		addLatencies, getLatencies, deleteLatencies []time.Duration
+		addErrors, getErrors, deleteErrors          int
+		recordIDs                                   []string
+	)
+
+	for index := range runner.WorkloadRecords {
+		recordID := recordIDPrefix + strconv.Itoa(index)
+		start := time.Now()
+
+		infoJSON, err := runner.SzEngine.AddRecord(ctx, dataSourceCode, recordID,
+			syntheticRecord(dataSourceCode, recordID, index), senzing.SzWithInfo)
+		if err != nil {
+			addErrors++
+
+			if ctx.Err() != nil {
+				break
+			}
+
+			continue
+		}
+
+		addLatencies = append(addLatencies, time.Since(start))
+		recordIDs = append(recordIDs, recordID)
+
+		latency, err := runner.getEntity(ctx, infoJSON)
+		if err != nil {
+			getErrors++
+
+			continue
+		}
+
+		getLatencies = append(getLatencies, latency)
+	}
+
+	// Records are deleted even if the context has been canceled, so that the repository is left as it was found.
+
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	for _, recordID := range recordIDs {
+		start := time.Now()
+
+		_, err := runner.SzEngine.DeleteRecord(cleanupCtx, dataSourceCode, recordID, senzing.SzNoFlags)
+		if err != nil {
+			deleteErrors++
+
+			continue
+		}
+
+		deleteLatencies = append(deleteLatencies, time.Since(start))
+	}
+
+	result := []OperationStats{
+		operationstats.New(OperationAddRecord, addLatencies, addErrors, 0),
+		operationstats.New(OperationGetEntityByEntityID, getLatencies, getErrors, 0),
+		operationstats.New(OperationDeleteRecord, deleteLatencies, deleteErrors, 0),
+	}
+
+	return result, wraperror.Errorf(ctx.Err(), "workload")
+}
+
+func (runner *Runner) getEntity(ctx context.Context, infoJSON string) (time.Duration, error) {
+	info, err := withinfo.Parse(infoJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(info.AffectedEntities) == 0 {
+		return 0, wraperror.Errorf(errForPackage, "record %s affected no entity", info.RecordID)
+	}
+
+	start := time.Now()
+
+	_, err = runner.SzEngine.GetEntityByEntityID(ctx, info.AffectedEntities[0].EntityID, senzing.SzEntityDefaultFlags)
+	if err != nil {
+		return 0, wraperror.Errorf(err, "GetEntityByEntityID")
+	}
+
+	return time.Since(start), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func syntheticRecord(dataSourceCode string, recordID string, index int) string {
+	return fmt.Sprintf(
+		`{"DATA_SOURCE": %q, "RECORD_ID": %q, "NAME_FULL": "Benchmark Person %d",`+
+			` "ADDR_FULL": "%d Main Street, Las Vegas, NV 89101", "PHONE_NUMBER": "702-555-%04d"}`,
+		dataSourceCode, recordID, index, index+1, index%phoneNumbers)
+}
@@ -0,0 +1,68 @@
+package benchmark_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/benchmark"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleRunner_Run() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/benchmark/benchmark_test.go
+	ctx := context.TODO()
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic:    getSzDiagnostic(ctx),
+		SzEngine:        getSzEngine(ctx),
+		Name:            "before",
+		SecondsToRun:    []int{3},
+		WorkloadRecords: 10,
+	}
+
+	report, err := runner.Run(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%.0f inserts/s\n", report.Performance[0].InsertsPerSecond)
+
+	for _, operation := range report.Operations {
+		fmt.Println(operation.Name, operation.Count)
+	}
+	// Output:
+	// 2000 inserts/s
+	// SzDiagnostic.GetRepositoryInfo 20
+	// SzEngine.AddRecord 10
+	// SzEngine.GetEntityByEntityID 10
+	// SzEngine.DeleteRecord 10
+}
+
+func ExampleCompare() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/benchmark/benchmark_test.go
+	before := getReport("before", 1000, 4*time.Millisecond)
+	after := getReport("after", 1250, 2*time.Millisecond)
+
+	fmt.Print(benchmark.Compare(before, after))
+	// Output:
+	// Metric                                               before  after   Change
+	// CheckRepositoryPerformance 3s (inserts/s)            1000.0  1250.0  +25.0% better
+	// SzDiagnostic.GetRepositoryInfo p50 (ms)              4.0     2.0     -50.0% better
+	// SzDiagnostic.GetRepositoryInfo p95 (ms)              8.0     4.0     -50.0% better
+	// SzDiagnostic.GetRepositoryInfo throughput (calls/s)  250.0   500.0   +100.0% better
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzDiagnostic(_ context.Context) *fakeSzDiagnostic {
+	return &fakeSzDiagnostic{} //exhaustruct:ignore
+}
+
+func getSzEngine(_ context.Context) *fakeSzEngine {
+	return newFakeSzEngine()
+}
@@ -0,0 +1,381 @@
+package benchmark_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/benchmark"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRunner_Run(test *testing.T) {
+	ctx := test.Context()
+	diagnostic := &fakeSzDiagnostic{} //exhaustruct:ignore
+	runner := &benchmark.Runner{
+		SzDiagnostic:   diagnostic,
+		LatencySamples: 5,
+		Name:           "before",
+		Repetitions:    2,
+		SecondsToRun:   []int{1, 2},
+	} //exhaustruct:ignore
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Equal(test, "before", report.Name)
+	require.Equal(test, []int{1, 1, 2, 2}, diagnostic.secondsToRun)
+	require.Len(test, report.Performance, 2)
+	require.Equal(test, 2, report.Performance[1].SecondsToRun)
+	require.Len(test, report.Performance[1].Runs, 2)
+	require.InDelta(test, 2000.0, report.Performance[1].InsertsPerSecond, 1e-9)
+	require.Len(test, report.Operations, 1)
+
+	roundTrip, isOK := report.Operation(benchmark.OperationRoundTrip)
+	require.True(test, isOK)
+	require.Equal(test, 5, roundTrip.Count)
+	require.LessOrEqual(test, roundTrip.Min, roundTrip.P50)
+	require.LessOrEqual(test, roundTrip.P50, roundTrip.P95)
+	require.LessOrEqual(test, roundTrip.P95, roundTrip.Max)
+
+	_, isOK = report.Operation(benchmark.OperationAddRecord)
+	require.False(test, isOK)
+}
+
+func TestRunner_Run_defaults(test *testing.T) {
+	ctx := test.Context()
+	diagnostic := &fakeSzDiagnostic{}                     //exhaustruct:ignore
+	runner := &benchmark.Runner{SzDiagnostic: diagnostic} //exhaustruct:ignore
+
+	report, err := runner.Run(ctx)
+	require.NoError(test, err)
+	require.Equal(test, []int{3, 3, 3}, diagnostic.secondsToRun)
+
+	roundTrip, isOK := report.Operation(benchmark.OperationRoundTrip)
+	require.True(test, isOK)
+	require.Equal(test, benchmark.DefaultLatencySamples, roundTrip.Count)
+}
+
+func TestRunner_Run_workload(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic:    &fakeSzDiagnostic{}, //exhaustruct:ignore
+		SzEngine:        engine,
+		RecordIDPrefix:  "BENCH-",
+		Repetitions:     1,
+		WorkloadRecords: 5,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Len(test, report.Operations, 4)
+
+	for _, name := range []string{
+		benchmark.OperationAddRecord,
+		benchmark.OperationGetEntityByEntityID,
+		benchmark.OperationDeleteRecord,
+	} {
+		operation, isOK := report.Operation(name)
+		require.True(test, isOK, name)
+		require.Equal(test, 5, operation.Count, name)
+		require.Zero(test, operation.Errors, name)
+	}
+
+	require.Equal(test, 5, engine.added)
+	require.Empty(test, engine.records, "workload records are deleted")
+	require.Equal(test, []int64{1, 2, 3, 4, 5}, engine.entityIDs)
+}
+
+func TestRunner_Run_workloadErrors(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	engine.failRecordID = "BENCH-2"
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic:    &fakeSzDiagnostic{}, //exhaustruct:ignore
+		SzEngine:        engine,
+		RecordIDPrefix:  "BENCH-",
+		Repetitions:     1,
+		WorkloadRecords: 4,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+
+	addRecord, _ := report.Operation(benchmark.OperationAddRecord)
+	require.Equal(test, 3, addRecord.Count)
+	require.Equal(test, 1, addRecord.Errors)
+
+	deleteRecord, _ := report.Operation(benchmark.OperationDeleteRecord)
+	require.Equal(test, 3, deleteRecord.Count)
+	require.Empty(test, engine.records)
+}
+
+func TestRunner_Run_workloadCanceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	engine := newFakeSzEngine()
+	engine.onAdd = func(count int) {
+		if count == 2 {
+			cancel()
+		}
+	}
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic:    &fakeSzDiagnostic{}, //exhaustruct:ignore
+		SzEngine:        engine,
+		Repetitions:     1,
+		WorkloadRecords: 100,
+	}
+
+	_, err := runner.Run(ctx)
+	printDebug(test, err)
+	require.Error(test, err)
+	require.Empty(test, engine.records, "workload records are deleted after cancellation")
+}
+
+func TestRunner_Run_noEngine(test *testing.T) {
+	ctx := test.Context()
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic:    &fakeSzDiagnostic{}, //exhaustruct:ignore
+		Repetitions:     1,
+		WorkloadRecords: 1,
+	}
+
+	_, err := runner.Run(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "SzEngine")
+}
+
+func TestRunner_Run_error(test *testing.T) {
+	ctx := test.Context()
+	runner := &benchmark.Runner{ //exhaustruct:ignore
+		SzDiagnostic: &fakeSzDiagnostic{
+			err: szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`),
+		}, //exhaustruct:ignore
+	}
+
+	_, err := runner.Run(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+}
+
+func TestCompare(test *testing.T) {
+	comparison := benchmark.Compare(getReport("before", 1000, 4*time.Millisecond), getReport("after", 1250, 2*time.Millisecond))
+	printDebug(test, nil, comparison)
+	require.Equal(test, "before", comparison.Before)
+	require.Equal(test, "after", comparison.After)
+
+	metrics := map[string]benchmark.MetricComparison{}
+	for _, metric := range comparison.Metrics {
+		metrics[metric.Name] = metric
+	}
+
+	performance := metrics["CheckRepositoryPerformance 3s"]
+	require.InDelta(test, 0.25, performance.Change, 1e-9)
+	require.True(test, performance.Improved)
+	require.Equal(test, "inserts/s", performance.Unit)
+
+	latency := metrics[benchmark.OperationRoundTrip+" p50"]
+	require.InDelta(test, 4.0, latency.Before, 1e-9)
+	require.InDelta(test, -0.5, latency.Change, 1e-9)
+	require.True(test, latency.Improved)
+
+	// Metrics found in only one report are left out.
+
+	after := getReport("after", 1250, 2*time.Millisecond)
+	after.Performance[0].SecondsToRun = 10
+	comparison = benchmark.Compare(getReport("before", 1000, 4*time.Millisecond), after)
+	require.Len(test, comparison.Metrics, 3)
+}
+
+func TestComparison_String(test *testing.T) {
+	comparison := benchmark.Compare(getReport("before", 1000, 4*time.Millisecond), getReport("after", 900, 4*time.Millisecond))
+	text := comparison.String()
+	printDebug(test, nil, text)
+	require.Regexp(test, `CheckRepositoryPerformance 3s \(inserts/s\)\s+1000.0\s+900.0\s+-10.0% worse`, text)
+	require.Regexp(test, `p50 \(ms\)\s+4.0\s+4.0\s+\+0.0% same`, text)
+}
+
+func TestReport_JSON(test *testing.T) {
+	report := getReport("before", 1000, 4*time.Millisecond)
+
+	reportJSON, err := report.JSON()
+	printDebug(test, err, reportJSON)
+	require.NoError(test, err)
+	require.True(test, json.Valid([]byte(reportJSON)))
+
+	parsed, err := benchmark.ParseReport(reportJSON)
+	require.NoError(test, err)
+	require.Equal(test, report, parsed)
+
+	_, err = benchmark.ParseReport("}{")
+	require.Error(test, err)
+}
+
+func TestReport_String(test *testing.T) {
+	text := getReport("before", 1000, 4*time.Millisecond).String()
+	printDebug(test, nil, text)
+	require.Contains(test, text, `Benchmark "before" started 2025-10-21T18:00:00Z`)
+	require.Regexp(test, `\n  3\s+1\s+1000.0\n`, text)
+	require.Regexp(test, `SzDiagnostic.GetRepositoryInfo\s+20\s+0\s+1ms\s+4ms`, text)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzDiagnostic struct {
+	senzing.SzDiagnostic
+
+	err          error
+	secondsToRun []int
+}
+
+func (diagnostic *fakeSzDiagnostic) CheckRepositoryPerformance(_ context.Context, secondsToRun int) (string, error) {
+	diagnostic.secondsToRun = append(diagnostic.secondsToRun, secondsToRun)
+
+	return fmt.Sprintf(`{"insertTime": %d, "numRecordsInserted": %d}`, secondsToRun*1000, secondsToRun*2000), nil
+}
+
+func (diagnostic *fakeSzDiagnostic) GetRepositoryInfo(_ context.Context) (string, error) {
+	if diagnostic.err != nil {
+		return "", diagnostic.err
+	}
+
+	return `{"dataStores": [{"id": "CORE", "location": "/tmp/sqlite/G2C.db", "type": "sqlite3"}]}`, nil
+}
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	added        int
+	entityIDs    []int64
+	failRecordID string
+	mutex        sync.Mutex
+	onAdd        func(count int)
+	records      map[string]int64
+}
+
+func newFakeSzEngine() *fakeSzEngine {
+	return &fakeSzEngine{records: map[string]int64{}} //exhaustruct:ignore
+}
+
+func (engine *fakeSzEngine) AddRecord(
+	_ context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	_ int64,
+) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	if recordID == engine.failRecordID {
+		return "", szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)
+	}
+
+	record := map[string]any{}
+	if err := json.Unmarshal([]byte(recordDefinition), &record); err != nil || record["RECORD_ID"] != recordID {
+		return "", errors.New("bad record definition")
+	}
+
+	engine.added++
+	entityID := int64(engine.added)
+	engine.records[recordID] = entityID
+
+	if engine.onAdd != nil {
+		engine.onAdd(engine.added)
+	}
+
+	return fmt.Sprintf(`{"DATA_SOURCE": %q, "RECORD_ID": %q, "AFFECTED_ENTITIES": [{"ENTITY_ID": %d}]}`,
+		dataSourceCode, recordID, entityID), nil
+}
+
+func (engine *fakeSzEngine) DeleteRecord(ctx context.Context, _ string, recordID string, _ int64) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	delete(engine.records, recordID)
+
+	return "", nil
+}
+
+func (engine *fakeSzEngine) GetEntityByEntityID(_ context.Context, entityID int64, _ int64) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	engine.entityIDs = append(engine.entityIDs, entityID)
+
+	return fmt.Sprintf(`{"RESOLVED_ENTITY": {"ENTITY_ID": %d}}`, entityID), nil
+}
+
+func getReport(name string, insertsPerSecond float64, p50 time.Duration) *benchmark.Report {
+	return &benchmark.Report{
+		Duration: 12 * time.Second,
+		Name:     name,
+		Operations: []benchmark.OperationStats{
+			{
+				Count:      20,
+				Errors:     0,
+				ErrorTypes: nil,
+				Max:        2 * p50,
+				Mean:       p50,
+				Min:        time.Millisecond,
+				Name:       benchmark.OperationRoundTrip,
+				P50:        p50,
+				P95:        2 * p50,
+				P99:        2 * p50,
+				PerSecond:  float64(time.Second / p50),
+			},
+		},
+		Performance: []benchmark.PerformanceResult{
+			{
+				InsertsPerSecond: insertsPerSecond,
+				Runs: []benchmark.PerformanceRun{
+					{InsertsPerSecond: insertsPerSecond, InsertTime: 3000, NumRecordsInserted: int64(3 * insertsPerSecond)},
+				},
+				SecondsToRun: 3,
+			},
+		},
+		StartedAt: time.Date(2025, time.October, 21, 18, 0, 0, 0, time.UTC),
+	}
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
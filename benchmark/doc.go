@@ -0,0 +1,20 @@
+/*
+Package benchmark measures the performance of a Senzing environment so that environments can be compared,
+for example before and after an infrastructure change.
+
+A [Runner] gathers three kinds of measurement into a [Report]:
+
+  - SzDiagnostic.CheckRepositoryPerformance, run repeatedly for each configured duration.
+  - Client-measured round-trip latency of a lightweight call, SzDiagnostic.GetRepositoryInfo.
+  - Optionally, a synthetic workload of SzEngine.AddRecord, SzEngine.GetEntityByEntityID and SzEngine.DeleteRecord.
+
+A Report can be saved as JSON and read back with [ParseReport].
+[Compare] lines up two reports; both reports and comparisons have a text form for people.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package benchmark
@@ -0,0 +1,49 @@
+package benchmark
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultDataSourceCode is the data source of workload records when Runner.DataSourceCode is not set.
+*/
+const DefaultDataSourceCode = "TEST"
+
+/*
+DefaultLatencySamples is the number of round trips timed when Runner.LatencySamples is not set.
+*/
+const DefaultLatencySamples = 20
+
+/*
+DefaultRepetitions is the number of times each duration is run when Runner.Repetitions is not set.
+*/
+const DefaultRepetitions = 3
+
+/*
+DefaultSecondsToRun is the duration of CheckRepositoryPerformance when Runner.SecondsToRun is not set.
+*/
+const DefaultSecondsToRun = 3
+
+// Names of the timed operations.
+const (
+	OperationAddRecord           = "SzEngine.AddRecord"
+	OperationDeleteRecord        = "SzEngine.DeleteRecord"
+	OperationGetEntityByEntityID = "SzEngine.GetEntityByEntityID"
+	OperationRoundTrip           = "SzDiagnostic.GetRepositoryInfo"
+)
+
+const (
+	millisecondsPerSecond = 1000
+	percent               = 100
+	phoneNumbers          = 10000
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("benchmark")
@@ -0,0 +1,329 @@
+package benchmark
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/operationstats"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Report is the result of [Runner.Run].
+*/
+type Report struct {
+	Duration    time.Duration       `json:"DURATION"`
+	Name        string              `json:"NAME"`
+	Operations  []OperationStats    `json:"OPERATIONS"` // The round trip, then the workload operations, if any.
+	Performance []PerformanceResult `json:"PERFORMANCE"`
+	StartedAt   time.Time           `json:"STARTED_AT"`
+}
+
+/*
+PerformanceResult summarizes the CheckRepositoryPerformance runs of one duration.
+*/
+type PerformanceResult struct {
+	InsertsPerSecond float64          `json:"INSERTS_PER_SECOND"` // Mean of the runs.
+	Runs             []PerformanceRun `json:"RUNS"`
+	SecondsToRun     int              `json:"SECONDS_TO_RUN"`
+}
+
+/*
+PerformanceRun is the result of one CheckRepositoryPerformance call.
+*/
+type PerformanceRun struct {
+	InsertsPerSecond   float64 `json:"INSERTS_PER_SECOND"`
+	InsertTime         int64   `json:"INSERT_TIME"` // In milliseconds, as reported by Senzing.
+	NumRecordsInserted int64   `json:"NUM_RECORDS_INSERTED"`
+}
+
+/*
+OperationStats summarizes the client-measured latency of an operation.
+Failed calls are counted in Errors and not timed.
+PerSecond is the rate of calls made one at a time.
+*/
+type OperationStats = operationstats.Stats
+
+/*
+Comparison lines up the metrics of two reports.
+Metrics found in only one of the reports are left out.
+*/
+type Comparison struct {
+	After   string             `json:"AFTER"`
+	Before  string             `json:"BEFORE"`
+	Metrics []MetricComparison `json:"METRICS"`
+}
+
+/*
+MetricComparison compares one metric of two reports.
+*/
+type MetricComparison struct {
+	After    float64 `json:"AFTER"`
+	Before   float64 `json:"BEFORE"`
+	Change   float64 `json:"CHANGE"`   // (After - Before) / Before; 0 if Before is 0.
+	Improved bool    `json:"IMPROVED"` // Whether After is better than Before.
+	Name     string  `json:"NAME"`
+	Unit     string  `json:"UNIT"`
+}
+
+type metric struct {
+	higherIsBetter bool
+	name           string
+	unit           string
+	value          float64
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compare function compares two reports, such as reports from before and after an infrastructure change.
+
+Input
+  - before: The baseline report.
+  - after: The report compared with the baseline.
+
+Output
+  - The comparison, metric by metric.
+*/
+func Compare(before *Report, after *Report) *Comparison {
+	result := &Comparison{
+		After:   after.Name,
+		Before:  before.Name,
+		Metrics: []MetricComparison{},
+	}
+
+	afterMetrics := after.metrics()
+
+	for _, beforeMetric := range before.metrics() {
+		index := slices.IndexFunc(afterMetrics, func(afterMetric metric) bool {
+			return afterMetric.name == beforeMetric.name
+		})
+		if index < 0 {
+			continue
+		}
+
+		afterMetric := afterMetrics[index]
+		comparison := MetricComparison{
+			After:    afterMetric.value,
+			Before:   beforeMetric.value,
+			Change:   0,
+			Improved: false,
+			Name:     beforeMetric.name,
+			Unit:     beforeMetric.unit,
+		}
+
+		if beforeMetric.value != 0 {
+			comparison.Change = (afterMetric.value - beforeMetric.value) / beforeMetric.value
+		}
+
+		if beforeMetric.higherIsBetter {
+			comparison.Improved = afterMetric.value > beforeMetric.value
+		} else {
+			comparison.Improved = afterMetric.value < beforeMetric.value
+		}
+
+		result.Metrics = append(result.Metrics, comparison)
+	}
+
+	return result
+}
+
+/*
+The ParseReport function reads a report saved with Report.JSON.
+
+Input
+  - reportJSON: The JSON document.
+
+Output
+  - The report.
+*/
+func ParseReport(reportJSON string) (*Report, error) {
+	result := &Report{} //exhaustruct:ignore
+
+	err := operationstats.ParseReport(reportJSON, result)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method JSON returns the report as a JSON document.
+
+Output
+  - The JSON document.
+*/
+func (report *Report) JSON() (string, error) {
+	return operationstats.MarshalReport(report) //nolint:wrapcheck
+}
+
+/*
+Method Operation returns the statistics of an operation.
+
+Input
+  - name: One of the Operation* constants.
+
+Output
+  - The statistics, and whether the operation was measured.
+*/
+func (report *Report) Operation(name string) (OperationStats, bool) {
+	return operationstats.Find(report.Operations, name)
+}
+
+/*
+Method String returns the report as text.
+
+Output
+  - The report, as aligned columns.
+*/
+func (report *Report) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Benchmark %q started %s, took %s\n",
+		report.Name, report.StartedAt.Format(time.RFC3339), report.Duration.Round(time.Millisecond))
+
+	builder.WriteString("\nCheckRepositoryPerformance\n")
+
+	writer := operationstats.NewTabWriter(&builder)
+	fmt.Fprintln(writer, "  Seconds\tRuns\tInserts/s")
+
+	for _, performance := range report.Performance {
+		fmt.Fprintf(writer, "  %d\t%d\t%.1f\n", performance.SecondsToRun, len(performance.Runs), performance.InsertsPerSecond)
+	}
+
+	_ = writer.Flush()
+
+	builder.WriteString("\nOperations\n")
+
+	operationstats.WriteTable(&builder, report.Operations)
+
+	return builder.String()
+}
+
+// ----------------------------------------------------------------------------
+// Comparison methods
+// ----------------------------------------------------------------------------
+
+/*
+Method String returns the comparison as text.
+
+Output
+  - The comparison, as aligned columns.
+*/
+func (comparison *Comparison) String() string {
+	var builder strings.Builder
+
+	writer := operationstats.NewTabWriter(&builder)
+	fmt.Fprintf(writer, "Metric\t%s\t%s\tChange\n", labelOr(comparison.Before, "before"), labelOr(comparison.After, "after"))
+
+	for _, metric := range comparison.Metrics {
+		verdict := "same"
+
+		switch {
+		case metric.Improved:
+			verdict = "better"
+		case metric.After != metric.Before:
+			verdict = "worse"
+		}
+
+		fmt.Fprintf(writer, "%s (%s)\t%.1f\t%.1f\t%+.1f%% %s\n",
+			metric.Name, metric.Unit, metric.Before, metric.After, metric.Change*percent, verdict)
+	}
+
+	_ = writer.Flush()
+
+	return builder.String()
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (report *Report) metrics() []metric {
+	result := []metric{}
+
+	for _, performance := range report.Performance {
+		result = append(result, metric{
+			higherIsBetter: true,
+			name:           fmt.Sprintf("CheckRepositoryPerformance %ds", performance.SecondsToRun),
+			unit:           "inserts/s",
+			value:          performance.InsertsPerSecond,
+		})
+	}
+
+	for _, operation := range report.Operations {
+		if operation.Count == 0 {
+			continue
+		}
+
+		result = append(result,
+			metric{higherIsBetter: false, name: operation.Name + " p50", unit: "ms", value: milliseconds(operation.P50)},
+			metric{higherIsBetter: false, name: operation.Name + " p95", unit: "ms", value: milliseconds(operation.P95)},
+			metric{higherIsBetter: true, name: operation.Name + " throughput", unit: "calls/s", value: operation.PerSecond},
+		)
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func labelOr(label string, defaultLabel string) string {
+	if len(label) == 0 {
+		return defaultLabel
+	}
+
+	return label
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
+
+func newPerformanceResult(secondsToRun int, runs []PerformanceRun) PerformanceResult {
+	result := PerformanceResult{
+		InsertsPerSecond: 0,
+		Runs:             runs,
+		SecondsToRun:     secondsToRun,
+	}
+
+	if len(runs) == 0 {
+		return result
+	}
+
+	for _, run := range runs {
+		result.InsertsPerSecond += run.InsertsPerSecond
+	}
+
+	result.InsertsPerSecond /= float64(len(runs))
+
+	return result
+}
+
+func newPerformanceRun(response performanceResponse) PerformanceRun {
+	result := PerformanceRun{
+		InsertsPerSecond:   0,
+		InsertTime:         response.InsertTime,
+		NumRecordsInserted: response.NumRecordsInserted,
+	}
+
+	if response.InsertTime > 0 {
+		result.InsertsPerSecond = float64(response.NumRecordsInserted) * millisecondsPerSecond / float64(response.InsertTime)
+	}
+
+	return result
+}
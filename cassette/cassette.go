@@ -0,0 +1,221 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Cassette is a recording of gRPC calls, in the order they were made.
+*/
+type Cassette struct {
+	Interactions []Interaction `json:"INTERACTIONS"`
+}
+
+/*
+Interaction is one recorded call.
+*/
+type Interaction struct {
+	Method    string            `json:"METHOD"`           // e.g. "/szengine.SzEngine/AddRecord"
+	Request   json.RawMessage   `json:"REQUEST"`          // Normalized; null if the request is not a protocol buffer message.
+	Responses []json.RawMessage `json:"RESPONSES"`        // One for a unary call; one per message of a stream.
+	Status    *Status           `json:"STATUS,omitempty"` // Nil if the call succeeded.
+}
+
+/*
+Status is the gRPC status of a failed call.
+*/
+type Status struct {
+	Code    codes.Code `json:"CODE"`
+	Message string     `json:"MESSAGE"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Load function reads a cassette saved with Cassette.Save.
+
+Input
+  - path: The cassette file.
+
+Output
+  - The cassette.
+*/
+func Load(path string) (*Cassette, error) {
+	safePath := filepath.Clean(path)
+
+	cassetteBytes, err := os.ReadFile(safePath)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile: %s", safePath)
+	}
+
+	result := &Cassette{Interactions: []Interaction{}}
+
+	err = json.Unmarshal(cassetteBytes, result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal: %s", safePath)
+	}
+
+	return result, nil
+}
+
+/*
+The Normalize function returns the canonical form of a JSON document, as used to match requests.
+Object keys are sorted, white space is removed, and strings holding JSON objects or arrays,
+such as record definitions, are normalized too.
+
+Input
+  - document: The JSON document.
+
+Output
+  - The normalized JSON document.
+*/
+func Normalize(document []byte) (json.RawMessage, error) {
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Decode")
+	}
+
+	result, err := json.Marshal(normalizeValue(value))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Cassette methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Save writes the cassette as an indented JSON document.
+
+Input
+  - path: The cassette file. It is replaced if it exists.
+*/
+func (cassette *Cassette) Save(path string) error {
+	cassetteBytes, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return wraperror.Errorf(err, "json.MarshalIndent")
+	}
+
+	err = os.WriteFile(path, cassetteBytes, filePermissions)
+
+	return wraperror.Errorf(err, "os.WriteFile: %s", path)
+}
+
+// ----------------------------------------------------------------------------
+// Status methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Err returns the status as a gRPC error.
+
+Output
+  - The error, or nil if status is nil.
+*/
+func (aStatus *Status) Err() error {
+	if aStatus == nil {
+		return nil
+	}
+
+	return status.Error(aStatus.Code, aStatus.Message) //nolint:wrapcheck
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func marshalMessage(message any) (json.RawMessage, bool, error) {
+	protoMessage, isProto := message.(proto.Message)
+	if !isProto {
+		return nil, false, nil
+	}
+
+	result, err := protojson.Marshal(protoMessage)
+	if err != nil {
+		return nil, false, wraperror.Errorf(err, "protojson.Marshal")
+	}
+
+	return result, true, nil
+}
+
+func newStatus(err error) *Status {
+	grpcStatus := status.Convert(err)
+
+	return &Status{
+		Code:    grpcStatus.Code(),
+		Message: grpcStatus.Message(),
+	}
+}
+
+/*
+The normalized form of a request, or null if it is not a protocol buffer message.
+*/
+func normalizeRequest(request any) (json.RawMessage, error) {
+	requestJSON, isProto, err := marshalMessage(request)
+	if err != nil || !isProto {
+		return json.RawMessage("null"), err
+	}
+
+	return Normalize(requestJSON)
+}
+
+func normalizeValue(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		for key, child := range typedValue {
+			typedValue[key] = normalizeValue(child)
+		}
+	case []any:
+		for index, child := range typedValue {
+			typedValue[index] = normalizeValue(child)
+		}
+	case string:
+		trimmed := strings.TrimSpace(typedValue)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") || !json.Valid([]byte(trimmed)) {
+			return typedValue
+		}
+
+		normalized, err := Normalize([]byte(trimmed))
+		if err == nil {
+			return string(normalized)
+		}
+	}
+
+	return value
+}
+
+func unmarshalMessage(messageJSON json.RawMessage, message any) error {
+	protoMessage, isProto := message.(proto.Message)
+	if !isProto {
+		return nil
+	}
+
+	unmarshalOptions := protojson.UnmarshalOptions{DiscardUnknown: true} //exhaustruct:ignore
+
+	err := unmarshalOptions.Unmarshal(messageJSON, protoMessage)
+
+	return wraperror.Errorf(err, "protojson.Unmarshal")
+}
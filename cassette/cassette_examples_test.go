@@ -0,0 +1,68 @@
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/cassette"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExamplePlayer_NewSzAbstractFactory() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/cassette/cassette_test.go
+	ctx := context.TODO()
+	player := &cassette.Player{Cassette: getCassette()} //exhaustruct:ignore
+
+	szAbstractFactory, err := player.NewSzAbstractFactory()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	defer func() { _ = szAbstractFactory.GrpcConnection.Close() }()
+
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	version, err := szProduct.GetVersion(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(version)
+	// Output: {"VERSION": "4.1.1"}
+}
+
+func ExampleRecorder_DialOptions() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/cassette/cassette_test.go
+	recorder := &cassette.Recorder{}
+	dialOptions := recorder.DialOptions()
+
+	// Pass dialOptions to grpc.NewClient, exercise the server, then save the cassette.
+
+	fmt.Println(len(dialOptions), len(recorder.Cassette().Interactions))
+	// Output: 2 0
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getCassette() *cassette.Cassette {
+	return &cassette.Cassette{
+		Interactions: []cassette.Interaction{
+			{
+				Method:    szproductpb.SzProduct_GetVersion_FullMethodName,
+				Request:   json.RawMessage(`{}`),
+				Responses: []json.RawMessage{json.RawMessage(`{"result": "{\"VERSION\": \"4.1.1\"}"}`)},
+				Status:    nil,
+			},
+		},
+	}
+}
@@ -0,0 +1,344 @@
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/cassette"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/bufconntest"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+const (
+	licenseErrorMessage = `{"reason": "SENZ9000|License check failed"}`
+	recordDefinition    = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`
+	versionJSON         = `{"VERSION": "4.1.1"}`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRecorder_Player(test *testing.T) {
+	ctx := test.Context()
+	server := &fakeServer{} //exhaustruct:ignore
+	recorder := &cassette.Recorder{}
+	liveFactory := getRecordingFactory(test, server, recorder)
+
+	expected := exercise(ctx, test, liveFactory, recordDefinition)
+	calls := server.calls.Load()
+
+	cassettePath := filepath.Join(test.TempDir(), "cassette.json")
+	require.NoError(test, recorder.Save(cassettePath))
+
+	recorded, err := cassette.Load(cassettePath)
+	require.NoError(test, err)
+	printDebug(test, err, recorded)
+	require.Len(test, recorded.Interactions, 4)
+
+	player := &cassette.Player{Cassette: recorded} //exhaustruct:ignore
+	replayFactory, err := player.NewSzAbstractFactory()
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, replayFactory.GrpcConnection.Close()) }()
+
+	// The same record definition, with other key order and white space, matches.
+
+	actual := exercise(ctx, test, replayFactory, `{"RECORD_ID":"1","NAME_FULL":"Robert Smith","DATA_SOURCE":"TEST"}`)
+	require.Equal(test, expected, actual)
+	require.Empty(test, player.Unmatched())
+	require.Equal(test, calls, server.calls.Load())
+}
+
+func TestRecorder_Cassette_status(test *testing.T) {
+	ctx := test.Context()
+	recorder := &cassette.Recorder{}
+	szAbstractFactory := getRecordingFactory(test, &fakeServer{}, recorder) //exhaustruct:ignore
+
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(test, err)
+
+	_, err = szProduct.GetLicense(ctx)
+	require.Error(test, err)
+
+	recorded := recorder.Cassette()
+	require.Len(test, recorded.Interactions, 1)
+	require.Equal(test, szproductpb.SzProduct_GetLicense_FullMethodName, recorded.Interactions[0].Method)
+	require.Empty(test, recorded.Interactions[0].Responses)
+	require.NotNil(test, recorded.Interactions[0].Status)
+	require.Equal(test, codes.Unknown, recorded.Interactions[0].Status.Code)
+	require.Equal(test, licenseErrorMessage, recorded.Interactions[0].Status.Message)
+}
+
+func TestPlayer_unmatched(test *testing.T) {
+	ctx := test.Context()
+	unmatched := []cassette.UnmatchedCall{}
+	player := &cassette.Player{
+		Cassette:    &cassette.Cassette{Interactions: []cassette.Interaction{}},
+		OnUnmatched: func(call cassette.UnmatchedCall) { unmatched = append(unmatched, call) },
+	} //exhaustruct:ignore
+	szAbstractFactory, err := player.NewSzAbstractFactory()
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, szAbstractFactory.GrpcConnection.Close()) }()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	_, err = szEngine.AddRecord(ctx, "TEST", "1", recordDefinition, senzing.SzWithInfo)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "no recorded interaction")
+
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		require.Error(test, fragment.Error)
+	}
+
+	require.Len(test, unmatched, 2)
+	require.Equal(test, unmatched, player.Unmatched())
+	require.Equal(test, szenginepb.SzEngine_AddRecord_FullMethodName, unmatched[0].Method)
+	require.Contains(test, string(unmatched[0].Request), "Robert Smith")
+	require.Equal(test, szenginepb.SzEngine_StreamExportJsonEntityReport_FullMethodName, unmatched[1].Method)
+}
+
+func TestPlayer_repeatedCalls(test *testing.T) {
+	ctx := test.Context()
+	player := &cassette.Player{Cassette: getRepeatedCassette()} //exhaustruct:ignore
+	szProduct := getReplayProduct(ctx, test, player)
+
+	for _, expected := range []string{"first", "second"} {
+		actual, err := szProduct.GetVersion(ctx)
+		require.NoError(test, err)
+		require.Equal(test, expected, actual)
+	}
+
+	// The recorded interactions are used up.
+
+	_, err := szProduct.GetVersion(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "no recorded interaction")
+	require.Len(test, player.Unmatched(), 1)
+	require.Equal(test, szproductpb.SzProduct_GetVersion_FullMethodName, player.Unmatched()[0].Method)
+}
+
+func TestPlayer_repeatedCalls_repeat(test *testing.T) {
+	ctx := test.Context()
+	player := &cassette.Player{Cassette: getRepeatedCassette(), Repeat: true} //exhaustruct:ignore
+	szProduct := getReplayProduct(ctx, test, player)
+
+	for _, expected := range []string{"first", "second", "second"} {
+		actual, err := szProduct.GetVersion(ctx)
+		require.NoError(test, err)
+		require.Equal(test, expected, actual)
+	}
+
+	require.Empty(test, player.Unmatched())
+}
+
+func TestNormalize(test *testing.T) {
+	actual, err := cassette.Normalize([]byte(`{"b": 1, "a": {"recordDefinition": "{ \"Y\": 2,  \"X\": 1.50 }"}, "c": "{not JSON"}`))
+	require.NoError(test, err)
+	require.JSONEq(test, `{"a": {"recordDefinition": "{\"X\":1.50,\"Y\":2}"}, "b": 1, "c": "{not JSON"}`, string(actual))
+
+	_, err = cassette.Normalize([]byte(`}{`))
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestLoad_error(test *testing.T) {
+	_, err := cassette.Load(filepath.Join(test.TempDir(), "missing.json"))
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type exercised struct {
+	export     string
+	info       string
+	licenseErr string
+	version    string
+}
+
+type fakeServer struct {
+	szenginepb.UnimplementedSzEngineServer
+	szproductpb.UnimplementedSzProductServer
+
+	calls atomic.Int64
+}
+
+func (server *fakeServer) AddRecord(
+	_ context.Context,
+	request *szenginepb.AddRecordRequest,
+) (*szenginepb.AddRecordResponse, error) {
+	server.calls.Add(1)
+
+	return &szenginepb.AddRecordResponse{
+		Result: fmt.Sprintf(`{"DATA_SOURCE": %q, "RECORD_ID": %q, "AFFECTED_ENTITIES": [{"ENTITY_ID": 1}]}`,
+			request.GetDataSourceCode(), request.GetRecordId()),
+	}, nil
+}
+
+func (server *fakeServer) GetLicense(
+	_ context.Context,
+	_ *szproductpb.GetLicenseRequest,
+) (*szproductpb.GetLicenseResponse, error) {
+	server.calls.Add(1)
+
+	return nil, status.Error(codes.Unknown, licenseErrorMessage)
+}
+
+func (server *fakeServer) GetVersion(
+	_ context.Context,
+	_ *szproductpb.GetVersionRequest,
+) (*szproductpb.GetVersionResponse, error) {
+	server.calls.Add(1)
+
+	return &szproductpb.GetVersionResponse{Result: versionJSON}, nil
+}
+
+func (server *fakeServer) StreamExportJsonEntityReport(
+	_ *szenginepb.StreamExportJsonEntityReportRequest,
+	stream grpc.ServerStreamingServer[szenginepb.StreamExportJsonEntityReportResponse],
+) error {
+	server.calls.Add(1)
+
+	for _, line := range []string{`{"RESOLVED_ENTITY": {"ENTITY_ID": 1}}`, `{"RESOLVED_ENTITY": {"ENTITY_ID": 2}}`} {
+		err := stream.Send(&szenginepb.StreamExportJsonEntityReportResponse{Result: line + "\n"})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+Make the calls recorded and replayed by the tests.
+*/
+func exercise(
+	ctx context.Context,
+	t *testing.T,
+	szAbstractFactory senzing.SzAbstractFactory,
+	recordDefinition string,
+) exercised {
+	t.Helper()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(t, err)
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(t, err)
+
+	result := exercised{} //exhaustruct:ignore
+
+	result.info, err = szEngine.AddRecord(ctx, "TEST", "1", recordDefinition, senzing.SzWithInfo)
+	require.NoError(t, err)
+
+	var export strings.Builder
+
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		require.NoError(t, fragment.Error)
+		export.WriteString(fragment.Value)
+	}
+
+	result.export = export.String()
+
+	_, err = szProduct.GetLicense(ctx)
+	require.ErrorIs(t, err, szerror.ErrSz)
+	result.licenseErr = err.Error()
+
+	result.version, err = szProduct.GetVersion(ctx)
+	require.NoError(t, err)
+
+	return result
+}
+
+/*
+Serve SzEngine and SzProduct over an in-memory connection that is recorded.
+*/
+func getRecordingFactory(
+	t *testing.T,
+	fake *fakeServer,
+	recorder *cassette.Recorder,
+) *szabstractfactory.Szabstractfactory {
+	t.Helper()
+
+	connection := bufconntest.Connection(t, func(server *grpc.Server) {
+		szenginepb.RegisterSzEngineServer(server, fake)
+		szproductpb.RegisterSzProductServer(server, fake)
+	}, recorder.DialOptions()...)
+
+	return &szabstractfactory.Szabstractfactory{GrpcConnection: connection} //exhaustruct:ignore
+}
+
+/*
+A cassette with two answers to the same SzProduct.GetVersion call.
+*/
+func getRepeatedCassette() *cassette.Cassette {
+	return &cassette.Cassette{
+		Interactions: []cassette.Interaction{
+			{
+				Method:    szproductpb.SzProduct_GetVersion_FullMethodName,
+				Request:   []byte(`{}`),
+				Responses: []json.RawMessage{[]byte(`{"result": "first"}`)},
+			},
+			{
+				Method:    szproductpb.SzProduct_GetVersion_FullMethodName,
+				Request:   []byte(`{}`),
+				Responses: []json.RawMessage{[]byte(`{"result": "second"}`)},
+			},
+		},
+	} //exhaustruct:ignore
+}
+
+func getReplayProduct(ctx context.Context, t *testing.T, player *cassette.Player) senzing.SzProduct {
+	t.Helper()
+
+	szAbstractFactory, err := player.NewSzAbstractFactory()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, szAbstractFactory.GrpcConnection.Close()) })
+
+	result, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(t, err)
+
+	return result
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
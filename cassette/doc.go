@@ -0,0 +1,25 @@
+/*
+Package cassette records gRPC traffic with a Senzing server and replays it, for deterministic tests.
+
+A [Recorder] is a pair of gRPC client interceptors.
+Dial a live server with Recorder.DialOptions, exercise it through the usual Senzing objects,
+then save the [Cassette] of every call made: the method, the request,
+each response (one for a unary call, one per message of a streaming export) and the error status, if any.
+
+A [Player] serves calls from a cassette without a server.
+Player.NewSzAbstractFactory returns a Szabstractfactory whose objects are answered from the cassette.
+A call matches a recorded [Interaction] with the same method and the same normalized request:
+requests are compared as canonical JSON, including JSON documents held in string fields,
+so differences of key order and white space do not matter.
+Identical calls replay their recorded interactions in order.
+Calls with no match, including identical calls made after their recorded interactions are used up,
+fail with codes.NotFound and are listed by Player.Unmatched.
+Set Player.Repeat to replay the last interaction of a call instead.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package cassette
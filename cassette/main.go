@@ -0,0 +1,21 @@
+package cassette
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	filePermissions = 0o600
+	keySeparator    = "\x00"
+	replayTarget    = "passthrough:///cassette"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("cassette")
@@ -0,0 +1,298 @@
+package cassette
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Player serves gRPC calls from a cassette.
+*/
+type Player struct {
+	Cassette *Cassette
+
+	// Optional. Called for each call with no recorded interaction.
+	OnUnmatched func(call UnmatchedCall)
+
+	// Optional. If true, once the recorded interactions of a call are used up, the last one repeats.
+	// Otherwise further identical calls are unmatched.
+	Repeat bool
+
+	index     map[string][]int // Interactions of each method and normalized request, in recorded order.
+	mutex     sync.Mutex       // Guards index, next and unmatched.
+	next      map[string]int   // Position in index of the next interaction to replay.
+	unmatched []UnmatchedCall
+}
+
+/*
+UnmatchedCall is a call the cassette has no interaction for.
+*/
+type UnmatchedCall struct {
+	Method  string          `json:"METHOD"`
+	Request json.RawMessage `json:"REQUEST"` // Normalized.
+}
+
+type replayStream struct {
+	ctx         context.Context //nolint:containedctx // The context of the stream, as returned by Context.
+	interaction *Interaction
+	method      string
+	next        int // Position in interaction.Responses of the next message.
+	player      *Player
+	request     any
+}
+
+// ----------------------------------------------------------------------------
+// Player methods
+// ----------------------------------------------------------------------------
+
+/*
+Method DialOptions returns the options that make a connection answer from the cassette.
+The connection's server is never contacted.
+
+Output
+  - The dial options.
+*/
+func (player *Player) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(player.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(player.StreamClientInterceptor),
+	}
+}
+
+/*
+Method NewClient returns a connection that answers from the cassette.
+
+Output
+  - The connection. Close it when done.
+*/
+func (player *Player) NewClient() (*grpc.ClientConn, error) {
+	result, err := grpc.NewClient(replayTarget, player.DialOptions()...)
+
+	return result, wraperror.Errorf(err, "grpc.NewClient")
+}
+
+/*
+Method NewSzAbstractFactory returns a factory whose Senzing objects answer from the cassette.
+
+Output
+  - The factory. Close its GrpcConnection when done.
+*/
+func (player *Player) NewSzAbstractFactory() (*szabstractfactory.Szabstractfactory, error) {
+	connection, err := player.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &szabstractfactory.Szabstractfactory{
+		GrpcConnection: connection,
+	} //exhaustruct:ignore
+
+	return result, nil
+}
+
+/*
+Method StreamClientInterceptor is a grpc.StreamClientInterceptor that replays streams.
+The stream is matched when its first message is received.
+*/
+func (player *Player) StreamClientInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	connection *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	options ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	_ = desc
+	_ = connection
+	_ = streamer
+	_ = options
+
+	result := &replayStream{
+		ctx:         ctx,
+		interaction: nil,
+		method:      method,
+		next:        0,
+		player:      player,
+		request:     nil,
+	}
+
+	return result, nil
+}
+
+/*
+Method UnaryClientInterceptor is a grpc.UnaryClientInterceptor that replays unary calls.
+*/
+func (player *Player) UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	request any,
+	reply any,
+	connection *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	options ...grpc.CallOption,
+) error {
+	_ = ctx
+	_ = connection
+	_ = invoker
+	_ = options
+
+	interaction, err := player.find(method, request)
+	if err != nil {
+		return err
+	}
+
+	if len(interaction.Responses) > 0 {
+		err = unmarshalMessage(interaction.Responses[0], reply)
+		if err != nil {
+			return wraperror.Errorf(err, "%s", method)
+		}
+	}
+
+	return interaction.Status.Err()
+}
+
+/*
+Method Unmatched lists the calls the cassette had no interaction for.
+
+Output
+  - The unmatched calls, in the order they were made.
+*/
+func (player *Player) Unmatched() []UnmatchedCall {
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+
+	return append([]UnmatchedCall{}, player.unmatched...)
+}
+
+// ----------------------------------------------------------------------------
+// replayStream methods
+// ----------------------------------------------------------------------------
+
+func (stream *replayStream) CloseSend() error {
+	return nil
+}
+
+func (stream *replayStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *replayStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (stream *replayStream) RecvMsg(message any) error {
+	if stream.interaction == nil {
+		interaction, err := stream.player.find(stream.method, stream.request)
+		if err != nil {
+			return err
+		}
+
+		stream.interaction = interaction
+	}
+
+	if stream.next < len(stream.interaction.Responses) {
+		response := stream.interaction.Responses[stream.next]
+		stream.next++
+
+		return wraperror.Errorf(unmarshalMessage(response, message), "%s", stream.method)
+	}
+
+	err := stream.interaction.Status.Err()
+	if err != nil {
+		return err
+	}
+
+	return io.EOF
+}
+
+func (stream *replayStream) SendMsg(message any) error {
+	stream.request = message
+
+	return nil
+}
+
+func (stream *replayStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Find the next interaction for a call, or return a codes.NotFound error and flag the call.
+A call with no interaction left is unmatched, unless Repeat is set.
+*/
+func (player *Player) find(method string, request any) (*Interaction, error) {
+	normalizedRequest, err := normalizeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	player.mutex.Lock()
+
+	if player.index == nil {
+		player.buildIndex()
+	}
+
+	key := method + keySeparator + string(normalizedRequest)
+	positions := player.index[key]
+	position := player.next[key]
+
+	switch {
+	case position < len(positions):
+		player.next[key] = position + 1
+	case player.Repeat && len(positions) > 0:
+		position = len(positions) - 1
+	default:
+		call := UnmatchedCall{Method: method, Request: normalizedRequest}
+		player.unmatched = append(player.unmatched, call)
+		player.mutex.Unlock()
+
+		if player.OnUnmatched != nil {
+			player.OnUnmatched(call)
+		}
+
+		// The request is left out of the message, which would otherwise be read as a Senzing error. See Unmatched.
+
+		return nil, status.Errorf(codes.NotFound, "%s: no recorded interaction for %s", errForPackage, method) //nolint:wrapcheck
+	}
+
+	result := &player.Cassette.Interactions[positions[position]]
+	player.mutex.Unlock()
+
+	return result, nil
+}
+
+// Requires player.mutex.
+func (player *Player) buildIndex() {
+	player.index = map[string][]int{}
+	player.next = map[string]int{}
+
+	for position, interaction := range player.Cassette.Interactions {
+		request := interaction.Request
+
+		normalizedRequest, err := Normalize(request)
+		if err == nil {
+			request = normalizedRequest
+		}
+
+		key := interaction.Method + keySeparator + string(request)
+		player.index[key] = append(player.index[key], position)
+	}
+}
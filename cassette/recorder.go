@@ -0,0 +1,232 @@
+package cassette
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Recorder records the calls made through a gRPC connection.
+The zero value is ready to use.
+*/
+type Recorder struct {
+	err          error // The first failure to record a message.
+	interactions []*Interaction
+	mutex        sync.Mutex // Guards err and interactions, including the interactions of open streams.
+}
+
+type recordingStream struct {
+	grpc.ClientStream
+
+	interaction *Interaction
+	recorder    *Recorder
+}
+
+// ----------------------------------------------------------------------------
+// Recorder methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Cassette returns what has been recorded so far.
+
+Output
+  - A copy of the recording.
+*/
+func (recorder *Recorder) Cassette() *Cassette {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	result := &Cassette{Interactions: make([]Interaction, 0, len(recorder.interactions))}
+
+	for _, interaction := range recorder.interactions {
+		result.Interactions = append(result.Interactions, Interaction{
+			Method:    interaction.Method,
+			Request:   interaction.Request,
+			Responses: slices.Clone(interaction.Responses),
+			Status:    interaction.Status,
+		})
+	}
+
+	return result
+}
+
+/*
+Method DialOptions returns the options that add the recorder to a connection, such as one made with grpc.NewClient.
+
+Output
+  - The dial options.
+*/
+func (recorder *Recorder) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(recorder.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(recorder.StreamClientInterceptor),
+	}
+}
+
+/*
+Method Save writes what has been recorded so far to a cassette file.
+Streams still open are saved with the responses received so far.
+
+Input
+  - path: The cassette file. It is replaced if it exists.
+*/
+func (recorder *Recorder) Save(path string) error {
+	recorder.mutex.Lock()
+	err := recorder.err
+	recorder.mutex.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	return recorder.Cassette().Save(path)
+}
+
+/*
+Method StreamClientInterceptor is a grpc.StreamClientInterceptor that records streams, such as streaming exports.
+*/
+func (recorder *Recorder) StreamClientInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	connection *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	options ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	interaction := &Interaction{
+		Method:    method,
+		Request:   json.RawMessage("null"),
+		Responses: []json.RawMessage{},
+		Status:    nil,
+	}
+	recorder.add(interaction)
+
+	clientStream, err := streamer(ctx, desc, connection, method, options...)
+	if err != nil {
+		recorder.update(func() { interaction.Status = newStatus(err) })
+
+		return nil, err
+	}
+
+	return &recordingStream{ClientStream: clientStream, interaction: interaction, recorder: recorder}, nil
+}
+
+/*
+Method UnaryClientInterceptor is a grpc.UnaryClientInterceptor that records unary calls.
+*/
+func (recorder *Recorder) UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	request any,
+	reply any,
+	connection *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	options ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, request, reply, connection, options...)
+
+	interaction := &Interaction{
+		Method:    method,
+		Request:   recorder.normalizeRequest(request),
+		Responses: []json.RawMessage{},
+		Status:    nil,
+	}
+
+	if err != nil {
+		interaction.Status = newStatus(err)
+	} else {
+		interaction.Responses = recorder.appendResponse(interaction.Responses, reply)
+	}
+
+	recorder.add(interaction)
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// recordingStream methods
+// ----------------------------------------------------------------------------
+
+func (stream *recordingStream) RecvMsg(message any) error {
+	err := stream.ClientStream.RecvMsg(message)
+
+	switch {
+	case err == nil:
+		response := stream.recorder.appendResponse(nil, message)
+		stream.recorder.update(func() {
+			stream.interaction.Responses = append(stream.interaction.Responses, response...)
+		})
+	case errors.Is(err, io.EOF):
+	default:
+		stream.recorder.update(func() { stream.interaction.Status = newStatus(err) })
+	}
+
+	return err //nolint:wrapcheck // Callers compare with io.EOF.
+}
+
+/*
+The request of a server stream. For client streams, only the last request is kept.
+*/
+func (stream *recordingStream) SendMsg(message any) error {
+	request := stream.recorder.normalizeRequest(message)
+	stream.recorder.update(func() { stream.interaction.Request = request })
+
+	return stream.ClientStream.SendMsg(message) //nolint:wrapcheck
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (recorder *Recorder) add(interaction *Interaction) {
+	recorder.update(func() { recorder.interactions = append(recorder.interactions, interaction) })
+}
+
+func (recorder *Recorder) appendResponse(responses []json.RawMessage, message any) []json.RawMessage {
+	response, isProto, err := marshalMessage(message)
+	if err != nil {
+		recorder.fail(err)
+
+		return responses
+	}
+
+	if !isProto {
+		return responses
+	}
+
+	return append(responses, response)
+}
+
+func (recorder *Recorder) fail(err error) {
+	recorder.update(func() {
+		if recorder.err == nil {
+			recorder.err = err
+		}
+	})
+}
+
+func (recorder *Recorder) normalizeRequest(request any) json.RawMessage {
+	result, err := normalizeRequest(request)
+	if err != nil {
+		recorder.fail(err)
+	}
+
+	return result
+}
+
+func (recorder *Recorder) update(change func()) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	change()
+}
@@ -0,0 +1,24 @@
+/*
+Command loadtest runs a mixed SzEngine workload against a Senzing gRPC server and reports
+latency percentiles per method and errors by szerror type.
+
+Usage:
+
+	go run ./cmd/loadtest -duration 1m -concurrency 8 -qps 200 \
+	  -mix "AddRecord=1,SearchByAttributes=2,GetEntityByRecordID=6,WhyEntities=1" -output report.json
+
+The server is at -grpc-url, or SENZING_TOOLS_GRPC_URL, such as "grpc://localhost:8261".
+TLS is configured by the same SENZING_TOOLS_* environment variables as helper.GetGrpcTransportCredentials.
+The data source, TEST by default, must be registered.
+The report is printed as text; -output also saves it as JSON.
+Run "go run ./cmd/loadtest -help" for all flags.
+
+# Related information
+
+  - [Senzing gRPC server]
+  - [Senzing Go SDK API definitions]
+
+[Senzing gRPC server]: https://github.com/senzing-garage/serve-grpc
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package main
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go-grpc/loadtest"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const reportFileMode = 0o600
+
+// ----------------------------------------------------------------------------
+// Main
+// ----------------------------------------------------------------------------
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	err := run(ctx, os.Args[1:])
+
+	stop()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err) //nolint
+
+		os.Exit(1)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+/*
+Parse the flags, run the load test and write the report.
+An interrupt ends the run early; the report of the run so far is still written.
+*/
+func run(ctx context.Context, arguments []string) error {
+	runner := &loadtest.Runner{} //exhaustruct:ignore
+	flagSet := flag.NewFlagSet("loadtest", flag.ContinueOnError)
+
+	grpcURL := flagSet.String("grpc-url", helper.GetGrpcURL(),
+		"URL of the Senzing gRPC server. Also "+helper.GrpcURLEnvVar)
+	mix := flagSet.String("mix", "", "Weights of operations, such as \"AddRecord=1,GetEntityByRecordID=4\"")
+	output := flagSet.String("output", "", "If set, the file the JSON report is written to")
+
+	flagSet.IntVar(&runner.Concurrency, "concurrency", loadtest.DefaultConcurrency, "Number of workers")
+	flagSet.StringVar(&runner.DataSourceCode, "data-source", loadtest.DefaultDataSourceCode,
+		"Data source of added records")
+	flagSet.DurationVar(&runner.Duration, "duration", loadtest.DefaultDuration, "How long operations are sent")
+	flagSet.BoolVar(&runner.KeepRecords, "keep-records", false, "Leave added records in the repository")
+	flagSet.StringVar(&runner.Name, "name", "", "Label of the report")
+	flagSet.Float64Var(&runner.TargetQPS, "qps", 0, "Operations started per second; 0 is as fast as possible")
+	flagSet.StringVar(&runner.RecordIDPrefix, "record-id-prefix", "", "Prefix of added record identifiers")
+	flagSet.Uint64Var(&runner.Seed, "seed", 0, "Seed of the random choices; 0 is from the time")
+	flagSet.IntVar(&runner.SeedRecords, "seed-records", loadtest.DefaultSeedRecords,
+		"Records added before the run")
+
+	err := flagSet.Parse(arguments)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return wraperror.Errorf(err, "flags")
+	}
+
+	if len(*mix) > 0 {
+		runner.Mix, err = loadtest.ParseMix(*mix)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	grpcConnection, err := helper.GetGrpcConnection(ctx, *grpcURL)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	defer func() { _ = grpcConnection.Close() }()
+
+	runner.SzAbstractFactory = &szabstractfactory.Szabstractfactory{GrpcConnection: grpcConnection} //exhaustruct:ignore
+
+	report, runErr := runner.Run(ctx)
+	if report == nil {
+		return runErr //nolint:wrapcheck
+	}
+
+	fmt.Print(report) //nolint
+
+	if len(*output) > 0 {
+		reportJSON, err := report.JSON()
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		err = os.WriteFile(*output, []byte(reportJSON+"\n"), reportFileMode)
+		if err != nil {
+			return wraperror.Errorf(err, "WriteFile %s", *output)
+		}
+	}
+
+	return runErr //nolint:wrapcheck
+}
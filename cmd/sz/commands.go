@@ -0,0 +1,824 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A subcommand, which calls one method.
+The result of call is printed: a string, an int64, a stream of senzing.StringFragment, or nothing if nil.
+*/
+type command struct {
+	call         func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error)
+	defaultFlags string // The name of the default senzing.Sz* flags, if the method takes flags.
+	method       string // Such as "SzEngine.GetEntityByEntityID".
+	parameters   []parameter
+}
+
+/*
+The subcommands of one Senzing object.
+*/
+type object struct {
+	commands []command
+	name     string // Such as "engine".
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getObjects() []object {
+	return []object{
+		{commands: getConfigCommands(), name: "config"},
+		{commands: getConfigManagerCommands(), name: "configmanager"},
+		{commands: getDiagnosticCommands(), name: "diagnostic"},
+		{commands: getEngineCommands(), name: "engine"},
+		{commands: getProductCommands(), name: "product"},
+	}
+}
+
+/*
+SzConfig methods, on a configuration loaded with -config-id or -config-definition.
+*/
+func getConfigCommands() []command {
+	configSource := []parameter{
+		optional(kindInt64, "config-id", "", "Configuration to use; if not set, the default configuration"),
+		optional(kindInput, "config-definition", "", "Configuration to use, instead of -config-id"),
+	}
+	save := []parameter{
+		optional(kindBool, "save", "", "Make the changed configuration the default configuration"),
+		optional(kindString, "comment", "", "Comment of the saved configuration"),
+	}
+
+	return []command{
+		{
+			call: withConfig(func(ctx context.Context, szConfig senzing.SzConfig, _ *arguments) (any, error) {
+				return szConfig.Export(ctx) //nolint:wrapcheck
+			}),
+			method:     "SzConfig.Export",
+			parameters: configSource,
+		}, //exhaustruct:ignore
+		{
+			call: withConfig(func(ctx context.Context, szConfig senzing.SzConfig, _ *arguments) (any, error) {
+				return szConfig.GetDataSourceRegistry(ctx) //nolint:wrapcheck
+			}),
+			method:     "SzConfig.GetDataSourceRegistry",
+			parameters: configSource,
+		}, //exhaustruct:ignore
+		{
+			call: withSavedConfig("register-data-source",
+				func(ctx context.Context, szConfig senzing.SzConfig, args *arguments) (string, error) {
+					return szConfig.RegisterDataSource(ctx, args.string("data-source")) //nolint:wrapcheck
+				}),
+			method:     "SzConfig.RegisterDataSource",
+			parameters: append([]parameter{dataSource("data-source")}, append(configSource, save...)...),
+		}, //exhaustruct:ignore
+		{
+			call: withSavedConfig("unregister-data-source",
+				func(ctx context.Context, szConfig senzing.SzConfig, args *arguments) (string, error) {
+					return szConfig.UnregisterDataSource(ctx, args.string("data-source")) //nolint:wrapcheck
+				}),
+			method:     "SzConfig.UnregisterDataSource",
+			parameters: append([]parameter{dataSource("data-source")}, append(configSource, save...)...),
+		}, //exhaustruct:ignore
+	}
+}
+
+/*
+SzConfigManager methods. Methods that create an SzConfig print its definition.
+*/
+func getConfigManagerCommands() []command {
+	configDefinition := required(kindInput, "config-definition", "Configuration definition")
+	comment := optional(kindString, "comment", "", "Comment of the configuration")
+
+	return []command{
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, args.int64("config-id"))
+
+					return export(ctx, szConfig, err)
+				}),
+			method:     "SzConfigManager.CreateConfigFromConfigID",
+			parameters: []parameter{required(kindInt64, "config-id", "Configuration ID")},
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					szConfig, err := szConfigManager.CreateConfigFromString(ctx, args.string("config-definition"))
+
+					return export(ctx, szConfig, err)
+				}),
+			method:     "SzConfigManager.CreateConfigFromString",
+			parameters: []parameter{configDefinition},
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, _ *arguments) (any, error) {
+					szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+
+					return export(ctx, szConfig, err)
+				}),
+			method: "SzConfigManager.CreateConfigFromTemplate",
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, _ *arguments) (any, error) {
+					return szConfigManager.GetConfigRegistry(ctx) //nolint:wrapcheck
+				}),
+			method: "SzConfigManager.GetConfigRegistry",
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, _ *arguments) (any, error) {
+					return szConfigManager.GetDefaultConfigID(ctx) //nolint:wrapcheck
+				}),
+			method: "SzConfigManager.GetDefaultConfigID",
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					return szConfigManager.RegisterConfig(ctx, //nolint:wrapcheck
+						args.string("config-definition"), args.string("comment"))
+				}),
+			method:     "SzConfigManager.RegisterConfig",
+			parameters: []parameter{configDefinition, comment},
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					return nil, szConfigManager.ReplaceDefaultConfigID(ctx, //nolint:wrapcheck
+						args.int64("current-default-config-id"), args.int64("new-default-config-id"))
+				}),
+			method: "SzConfigManager.ReplaceDefaultConfigID",
+			parameters: []parameter{
+				required(kindInt64, "current-default-config-id", "The default configuration ID expected"),
+				required(kindInt64, "new-default-config-id", "The new default configuration ID"),
+			},
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					return szConfigManager.SetDefaultConfig(ctx, //nolint:wrapcheck
+						args.string("config-definition"), args.string("comment"))
+				}),
+			method:     "SzConfigManager.SetDefaultConfig",
+			parameters: []parameter{configDefinition, comment},
+		}, //exhaustruct:ignore
+		{
+			call: withConfigManager(
+				func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+					return nil, szConfigManager.SetDefaultConfigID(ctx, args.int64("config-id")) //nolint:wrapcheck
+				}),
+			method:     "SzConfigManager.SetDefaultConfigID",
+			parameters: []parameter{required(kindInt64, "config-id", "Configuration ID")},
+		}, //exhaustruct:ignore
+	}
+}
+
+func getDiagnosticCommands() []command {
+	return []command{
+		{
+			call: withDiagnostic(func(ctx context.Context, szDiagnostic senzing.SzDiagnostic, args *arguments) (any, error) {
+				return szDiagnostic.CheckRepositoryPerformance(ctx, //nolint:wrapcheck
+					int(args.int64("seconds-to-run")))
+			}),
+			method:     "SzDiagnostic.CheckRepositoryPerformance",
+			parameters: []parameter{optional(kindInt64, "seconds-to-run", "3", "Seconds to insert records for")},
+		}, //exhaustruct:ignore
+		{
+			call: withDiagnostic(func(ctx context.Context, szDiagnostic senzing.SzDiagnostic, args *arguments) (any, error) {
+				return szDiagnostic.GetFeature(ctx, args.int64("feature-id")) //nolint:wrapcheck
+			}),
+			method:     "SzDiagnostic.GetFeature",
+			parameters: []parameter{required(kindInt64, "feature-id", "Feature ID")},
+		}, //exhaustruct:ignore
+		{
+			call: withDiagnostic(func(ctx context.Context, szDiagnostic senzing.SzDiagnostic, _ *arguments) (any, error) {
+				return szDiagnostic.GetRepositoryInfo(ctx) //nolint:wrapcheck
+			}),
+			method: "SzDiagnostic.GetRepositoryInfo",
+		}, //exhaustruct:ignore
+		{
+			call: withDiagnostic(func(ctx context.Context, szDiagnostic senzing.SzDiagnostic, _ *arguments) (any, error) {
+				return nil, szDiagnostic.PurgeRepository(ctx) //nolint:wrapcheck
+			}),
+			method: "SzDiagnostic.PurgeRepository",
+		}, //exhaustruct:ignore
+	}
+}
+
+/*
+SzEngine methods. The export handle methods, ExportCsvEntityReport, ExportJSONEntityReport, FetchNext and
+CloseExportReport, are covered by the export subcommands, which stream the whole report,
+or read it with the export handle methods if the server does not implement the streaming export.
+*/
+func getEngineCommands() []command { //nolint:maintidx
+	attributes := required(kindInput, "attributes", "Search attributes, as JSON")
+	entityID := required(kindInt64, "entity-id", "Entity ID")
+	maxDegrees := optional(kindInt64, "max-degrees", "2", "Maximum degrees of separation")
+	recordID := required(kindString, "record-id", "Record ID")
+	recordKeys := required(kindRecordKeys, "record-keys", "Records, as DATA_SOURCE:RECORD_ID,...")
+	requiredDataSources := optional(kindDataSources, "required-data-sources", "",
+		"Data sources a path must include, as DATA_SOURCE,...")
+	searchProfile := optional(kindString, "search-profile", "", "Search profile")
+	buildOut := []parameter{
+		optional(kindInt64, "build-out-degrees", "1", "Degrees of separation to build out the network"),
+		optional(kindInt64, "build-out-max-entities", "10", "Maximum entities to build out"),
+	}
+
+	return []command{
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				dataSourceCode, recordID, err := recordKeyOf(args)
+				if err != nil {
+					return nil, err
+				}
+
+				return szEngine.AddRecord(ctx, dataSourceCode, recordID, //nolint:wrapcheck
+					args.string("record-definition"), args.flags)
+			}),
+			defaultFlags: "SzAddRecordDefaultFlags",
+			method:       "SzEngine.AddRecord",
+			parameters: []parameter{
+				optional(kindString, "data-source", "", "Data source code; if not set, the record's DATA_SOURCE"),
+				optional(kindString, "record-id", "", "Record ID; if not set, the record's RECORD_ID"),
+				required(kindInput, "record-definition", "Record, as JSON"),
+			},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, _ *arguments) (any, error) {
+				return szEngine.CountRedoRecords(ctx) //nolint:wrapcheck
+			}),
+			method: "SzEngine.CountRedoRecords",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.DeleteRecord(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzDeleteRecordDefaultFlags",
+			method:       "SzEngine.DeleteRecord",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withExport(
+				szabstractfactory.CapabilityStreamExportCsvEntityReport,
+				func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) chan senzing.StringFragment {
+					return szEngine.ExportCsvEntityReportIterator(ctx, args.string("csv-column-list"), args.flags)
+				},
+				func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (uintptr, error) {
+					return szEngine.ExportCsvEntityReport(ctx, args.string("csv-column-list"), args.flags) //nolint:wrapcheck
+				},
+			),
+			defaultFlags: "SzExportDefaultFlags",
+			method:       "SzEngine.ExportCsvEntityReportIterator",
+			parameters:   []parameter{optional(kindString, "csv-column-list", "", "Columns, separated by commas")},
+		},
+		{
+			call: withExport(
+				szabstractfactory.CapabilityStreamExportJSONEntityReport,
+				func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) chan senzing.StringFragment {
+					return szEngine.ExportJSONEntityReportIterator(ctx, args.flags)
+				},
+				func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (uintptr, error) {
+					return szEngine.ExportJSONEntityReport(ctx, args.flags) //nolint:wrapcheck
+				},
+			),
+			defaultFlags: "SzExportDefaultFlags",
+			method:       "SzEngine.ExportJSONEntityReportIterator",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindInterestingEntitiesByEntityID(ctx, //nolint:wrapcheck
+					args.int64("entity-id"), args.flags)
+			}),
+			defaultFlags: "SzFindInterestingEntitiesDefaultFlags",
+			method:       "SzEngine.FindInterestingEntitiesByEntityID",
+			parameters:   []parameter{entityID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindInterestingEntitiesByRecordID(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzFindInterestingEntitiesDefaultFlags",
+			method:       "SzEngine.FindInterestingEntitiesByRecordID",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindNetworkByEntityID(ctx, args.string("entity-ids"), //nolint:wrapcheck
+					args.int64("max-degrees"), args.int64("build-out-degrees"), args.int64("build-out-max-entities"),
+					args.flags)
+			}),
+			defaultFlags: "SzFindNetworkDefaultFlags",
+			method:       "SzEngine.FindNetworkByEntityID",
+			parameters: append([]parameter{
+				required(kindEntityIDs, "entity-ids", "Entity IDs, separated by commas"),
+				maxDegrees,
+			}, buildOut...),
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindNetworkByRecordID(ctx, args.string("record-keys"), //nolint:wrapcheck
+					args.int64("max-degrees"), args.int64("build-out-degrees"), args.int64("build-out-max-entities"),
+					args.flags)
+			}),
+			defaultFlags: "SzFindNetworkDefaultFlags",
+			method:       "SzEngine.FindNetworkByRecordID",
+			parameters:   append([]parameter{recordKeys, maxDegrees}, buildOut...),
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindPathByEntityID(ctx, //nolint:wrapcheck
+					args.int64("start-entity-id"), args.int64("end-entity-id"), args.int64("max-degrees"),
+					args.string("avoid-entity-ids"), args.string("required-data-sources"), args.flags)
+			}),
+			defaultFlags: "SzFindPathDefaultFlags",
+			method:       "SzEngine.FindPathByEntityID",
+			parameters: []parameter{
+				required(kindInt64, "start-entity-id", "Entity ID the path starts at"),
+				required(kindInt64, "end-entity-id", "Entity ID the path ends at"),
+				maxDegrees,
+				optional(kindEntityIDs, "avoid-entity-ids", "", "Entity IDs to avoid, separated by commas"),
+				requiredDataSources,
+			},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.FindPathByRecordID(ctx, //nolint:wrapcheck
+					args.string("start-data-source"), args.string("start-record-id"),
+					args.string("end-data-source"), args.string("end-record-id"), args.int64("max-degrees"),
+					args.string("avoid-record-keys"), args.string("required-data-sources"), args.flags)
+			}),
+			defaultFlags: "SzFindPathDefaultFlags",
+			method:       "SzEngine.FindPathByRecordID",
+			parameters: []parameter{
+				dataSource("start-data-source"),
+				required(kindString, "start-record-id", "Record ID the path starts at"),
+				dataSource("end-data-source"),
+				required(kindString, "end-record-id", "Record ID the path ends at"),
+				maxDegrees,
+				optional(kindRecordKeys, "avoid-record-keys", "", "Records to avoid, as DATA_SOURCE:RECORD_ID,..."),
+				requiredDataSources,
+			},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, _ *arguments) (any, error) {
+				return szEngine.GetActiveConfigID(ctx) //nolint:wrapcheck
+			}),
+			method: "SzEngine.GetActiveConfigID",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.GetEntityByEntityID(ctx, args.int64("entity-id"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzEntityDefaultFlags",
+			method:       "SzEngine.GetEntityByEntityID",
+			parameters:   []parameter{entityID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.GetEntityByRecordID(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzEntityDefaultFlags",
+			method:       "SzEngine.GetEntityByRecordID",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.GetRecord(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzRecordDefaultFlags",
+			method:       "SzEngine.GetRecord",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.GetRecordPreview(ctx, args.string("record-definition"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzRecordPreviewDefaultFlags",
+			method:       "SzEngine.GetRecordPreview",
+			parameters:   []parameter{required(kindInput, "record-definition", "Record, as JSON")},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, _ *arguments) (any, error) {
+				return szEngine.GetRedoRecord(ctx) //nolint:wrapcheck
+			}),
+			method: "SzEngine.GetRedoRecord",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, _ *arguments) (any, error) {
+				return szEngine.GetStats(ctx) //nolint:wrapcheck
+			}),
+			method: "SzEngine.GetStats",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.GetVirtualEntityByRecordID(ctx, args.string("record-keys"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzVirtualEntityDefaultFlags",
+			method:       "SzEngine.GetVirtualEntityByRecordID",
+			parameters:   []parameter{recordKeys},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.HowEntityByEntityID(ctx, args.int64("entity-id"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzHowEntityDefaultFlags",
+			method:       "SzEngine.HowEntityByEntityID",
+			parameters:   []parameter{entityID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, _ *arguments) (any, error) {
+				return nil, szEngine.PrimeEngine(ctx) //nolint:wrapcheck
+			}),
+			method: "SzEngine.PrimeEngine",
+		}, //exhaustruct:ignore
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.ProcessRedoRecord(ctx, args.string("redo-record"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzRedoDefaultFlags",
+			method:       "SzEngine.ProcessRedoRecord",
+			parameters:   []parameter{required(kindInput, "redo-record", "Redo record, as from get-redo-record")},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.ReevaluateEntity(ctx, args.int64("entity-id"), args.flags) //nolint:wrapcheck
+			}),
+			defaultFlags: "SzReevaluateEntityDefaultFlags",
+			method:       "SzEngine.ReevaluateEntity",
+			parameters:   []parameter{entityID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.ReevaluateRecord(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzReevaluateRecordDefaultFlags",
+			method:       "SzEngine.ReevaluateRecord",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.SearchByAttributes(ctx, //nolint:wrapcheck
+					args.string("attributes"), args.string("search-profile"), args.flags)
+			}),
+			defaultFlags: "SzSearchByAttributesDefaultFlags",
+			method:       "SzEngine.SearchByAttributes",
+			parameters:   []parameter{attributes, searchProfile},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.WhyEntities(ctx, //nolint:wrapcheck
+					args.int64("entity-id-1"), args.int64("entity-id-2"), args.flags)
+			}),
+			defaultFlags: "SzWhyEntitiesDefaultFlags",
+			method:       "SzEngine.WhyEntities",
+			parameters: []parameter{
+				required(kindInt64, "entity-id-1", "First entity ID"),
+				required(kindInt64, "entity-id-2", "Second entity ID"),
+			},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.WhyRecordInEntity(ctx, //nolint:wrapcheck
+					args.string("data-source"), args.string("record-id"), args.flags)
+			}),
+			defaultFlags: "SzWhyRecordInEntityDefaultFlags",
+			method:       "SzEngine.WhyRecordInEntity",
+			parameters:   []parameter{dataSource("data-source"), recordID},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.WhyRecords(ctx, //nolint:wrapcheck
+					args.string("data-source-1"), args.string("record-id-1"),
+					args.string("data-source-2"), args.string("record-id-2"), args.flags)
+			}),
+			defaultFlags: "SzWhyRecordsDefaultFlags",
+			method:       "SzEngine.WhyRecords",
+			parameters: []parameter{
+				dataSource("data-source-1"),
+				required(kindString, "record-id-1", "First record ID"),
+				dataSource("data-source-2"),
+				required(kindString, "record-id-2", "Second record ID"),
+			},
+		},
+		{
+			call: withEngine(func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error) {
+				return szEngine.WhySearch(ctx, //nolint:wrapcheck
+					args.string("attributes"), args.int64("entity-id"), args.string("search-profile"), args.flags)
+			}),
+			defaultFlags: "SzWhySearchDefaultFlags",
+			method:       "SzEngine.WhySearch",
+			parameters:   []parameter{attributes, entityID, searchProfile},
+		},
+	}
+}
+
+func getProductCommands() []command {
+	return []command{
+		{
+			call: withProduct(func(ctx context.Context, szProduct senzing.SzProduct) (any, error) {
+				return szProduct.GetLicense(ctx) //nolint:wrapcheck
+			}),
+			method: "SzProduct.GetLicense",
+		}, //exhaustruct:ignore
+		{
+			call: withProduct(func(ctx context.Context, szProduct senzing.SzProduct) (any, error) {
+				return szProduct.GetVersion(ctx) //nolint:wrapcheck
+			}),
+			method: "SzProduct.GetVersion",
+		}, //exhaustruct:ignore
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func dataSource(name string) parameter {
+	return required(kindString, name, "Data source code")
+}
+
+func optional(kind parameterKind, name string, defaultValue string, usage string) parameter {
+	return parameter{defaultValue: defaultValue, kind: kind, name: name, optional: true, usage: usage}
+}
+
+func required(kind parameterKind, name string, usage string) parameter {
+	return parameter{defaultValue: "", kind: kind, name: name, optional: false, usage: usage}
+}
+
+/*
+The data source and record ID of add-record: the flags, or else the DATA_SOURCE and RECORD_ID of the record.
+*/
+func recordKeyOf(args *arguments) (string, string, error) {
+	dataSourceCode := args.string("data-source")
+	recordID := args.string("record-id")
+
+	if len(dataSourceCode) > 0 && len(recordID) > 0 {
+		return dataSourceCode, recordID, nil
+	}
+
+	record := struct {
+		DataSource string `json:"DATA_SOURCE"`
+		RecordID   string `json:"RECORD_ID"`
+	}{}
+
+	err := json.Unmarshal([]byte(args.string("record-definition")), &record)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "-record-definition")
+	}
+
+	if len(dataSourceCode) == 0 {
+		dataSourceCode = record.DataSource
+	}
+
+	if len(recordID) == 0 {
+		recordID = record.RecordID
+	}
+
+	if len(dataSourceCode) == 0 || len(recordID) == 0 {
+		return "", "", wraperror.Errorf(errForPackage, "-data-source and -record-id are required "+
+			"when the record has no DATA_SOURCE and RECORD_ID")
+	}
+
+	return dataSourceCode, recordID, nil
+}
+
+/*
+The definition of a configuration created by an SzConfigManager method.
+*/
+func export(ctx context.Context, szConfig senzing.SzConfig, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return szConfig.Export(ctx) //nolint:wrapcheck
+}
+
+/*
+Read an export report with FetchNext, as a stream of fragments, and close it.
+*/
+func fetchExport(ctx context.Context, szEngine senzing.SzEngine, exportHandle uintptr) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment)
+
+	go func() {
+		defer close(result)
+		defer func() { _ = szEngine.CloseExportReport(context.WithoutCancel(ctx), exportHandle) }()
+
+		for {
+			fragment, err := szEngine.FetchNext(ctx, exportHandle)
+			if err != nil {
+				result <- senzing.StringFragment{Error: wraperror.Errorf(err, "FetchNext"), Value: ""}
+
+				return
+			}
+
+			if len(fragment) == 0 {
+				return
+			}
+
+			result <- senzing.StringFragment{Error: nil, Value: fragment}
+		}
+	}()
+
+	return result
+}
+
+/*
+Whether the server of the factory has a capability.
+Factories that cannot tell are assumed to have it.
+*/
+func supports(
+	ctx context.Context,
+	factory senzing.SzAbstractFactory,
+	capability szabstractfactory.Capability,
+) (bool, error) {
+	checker, isOK := factory.(interface {
+		Supports(ctx context.Context, capability szabstractfactory.Capability) (bool, error)
+	})
+	if !isOK {
+		return true, nil
+	}
+
+	result, err := checker.Supports(ctx, capability)
+
+	return result, wraperror.Errorf(err, "Supports: %s", capability)
+}
+
+/*
+The configuration of -config-definition, or of -config-id, or the default configuration.
+*/
+func loadConfig(
+	ctx context.Context,
+	szConfigManager senzing.SzConfigManager,
+	args *arguments,
+) (senzing.SzConfig, error) {
+	if configDefinition := args.string("config-definition"); len(configDefinition) > 0 {
+		return szConfigManager.CreateConfigFromString(ctx, configDefinition) //nolint:wrapcheck
+	}
+
+	configID := args.int64("config-id")
+	if configID == 0 {
+		var err error
+
+		configID, err = szConfigManager.GetDefaultConfigID(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "GetDefaultConfigID")
+		}
+	}
+
+	return szConfigManager.CreateConfigFromConfigID(ctx, configID) //nolint:wrapcheck
+}
+
+func withConfig(
+	call func(ctx context.Context, szConfig senzing.SzConfig, args *arguments) (any, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return withConfigManager(
+		func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+			szConfig, err := loadConfig(ctx, szConfigManager, args)
+			if err != nil {
+				return nil, err
+			}
+
+			return call(ctx, szConfig, args)
+		})
+}
+
+/*
+Change a configuration, and with -save make it the default configuration.
+*/
+func withSavedConfig(
+	subcommand string,
+	call func(ctx context.Context, szConfig senzing.SzConfig, args *arguments) (string, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return withConfigManager(
+		func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error) {
+			szConfig, err := loadConfig(ctx, szConfigManager, args)
+			if err != nil {
+				return nil, err
+			}
+
+			result, err := call(ctx, szConfig, args)
+			if err != nil || !args.bool("save") {
+				return result, err
+			}
+
+			configDefinition, err := szConfig.Export(ctx)
+			if err != nil {
+				return nil, wraperror.Errorf(err, "Export")
+			}
+
+			comment := args.string("comment")
+			if len(comment) == 0 {
+				comment = fmt.Sprintf("sz config %s %s", subcommand, args.string("data-source"))
+			}
+
+			_, err = szConfigManager.SetDefaultConfig(ctx, configDefinition, comment)
+			if err != nil {
+				return nil, wraperror.Errorf(err, "SetDefaultConfig")
+			}
+
+			return result, nil
+		})
+}
+
+func withConfigManager(
+	call func(ctx context.Context, szConfigManager senzing.SzConfigManager, args *arguments) (any, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+		szConfigManager, err := factory.CreateConfigManager(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "CreateConfigManager")
+		}
+
+		return call(ctx, szConfigManager, args)
+	}
+}
+
+func withDiagnostic(
+	call func(ctx context.Context, szDiagnostic senzing.SzDiagnostic, args *arguments) (any, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+		szDiagnostic, err := factory.CreateDiagnostic(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "CreateDiagnostic")
+		}
+
+		return call(ctx, szDiagnostic, args)
+	}
+}
+
+func withEngine(
+	call func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (any, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+		szEngine, err := factory.CreateEngine(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "CreateEngine")
+		}
+
+		return call(ctx, szEngine, args)
+	}
+}
+
+/*
+An export subcommand: stream reads the report with a streaming export if the server has the capability;
+otherwise open starts an export read with the export handle methods.
+*/
+func withExport(
+	capability szabstractfactory.Capability,
+	stream func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) chan senzing.StringFragment,
+	open func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (uintptr, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+		isStreaming, err := supports(ctx, factory, capability)
+		if err != nil {
+			return nil, err
+		}
+
+		szEngine, err := factory.CreateEngine(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "CreateEngine")
+		}
+
+		if isStreaming {
+			return stream(ctx, szEngine, args), nil
+		}
+
+		exportHandle, err := open(ctx, szEngine, args)
+		if err != nil {
+			return nil, err
+		}
+
+		return fetchExport(ctx, szEngine, exportHandle), nil
+	}
+}
+
+func withProduct(
+	call func(ctx context.Context, szProduct senzing.SzProduct) (any, error),
+) func(ctx context.Context, factory senzing.SzAbstractFactory, args *arguments) (any, error) {
+	return func(ctx context.Context, factory senzing.SzAbstractFactory, _ *arguments) (any, error) {
+		szProduct, err := factory.CreateProduct(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "CreateProduct")
+		}
+
+		return call(ctx, szProduct)
+	}
+}
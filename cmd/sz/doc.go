@@ -0,0 +1,52 @@
+/*
+Command sz calls the methods of SzConfig, SzConfigManager, SzDiagnostic, SzEngine and SzProduct
+on a Senzing gRPC server, one subcommand per method, and prints the results.
+
+Usage:
+
+	go run ./cmd/sz [options] <object> <subcommand> [flags]
+
+The objects are config, configmanager, diagnostic, engine and product.
+A subcommand is the method name in kebab case, such as get-entity-by-entity-id for SzEngine.GetEntityByEntityID.
+Each parameter of the method is a named flag. Examples:
+
+	go run ./cmd/sz engine get-entity-by-entity-id -entity-id 1 -flags SZ_ENTITY_DEFAULT_FLAGS
+	go run ./cmd/sz engine get-entity-by-record-id -data-source CUSTOMERS -record-id 1001
+	go run ./cmd/sz engine add-record -record-definition @record.json
+	go run ./cmd/sz engine find-network-by-record-id -record-keys CUSTOMERS:1001,WATCHLIST:1007
+	cat attributes.json | go run ./cmd/sz -compact engine search-by-attributes -attributes -
+	go run ./cmd/sz config register-data-source -data-source CUSTOMERS -save
+
+Flags:
+
+  - Record definitions, search attributes and other large inputs are the value itself, "@path" to read a file,
+    or "-" to read stdin.
+  - Entity IDs, record keys (DATA_SOURCE:RECORD_ID) and data sources are lists separated by commas.
+    A JSON document, such as {"ENTITIES": [...]}, is used as is.
+  - -flags takes senzing.Sz* flags separated by "|", by name in either form, such as SzEntityDefaultFlags or
+    SZ_ENTITY_DEFAULT_FLAGS, or by number. It defaults to the method's default flags.
+  - add-record takes -data-source and -record-id from the DATA_SOURCE and RECORD_ID of the record if not set.
+  - config subcommands work on the default configuration, or -config-id or -config-definition.
+    With -save, a changed configuration becomes the default configuration.
+
+JSON results are indented; -compact prints them on one line.
+The export-csv-entity-report-iterator and export-json-entity-report-iterator subcommands stream the whole
+report, in place of the export handle methods; servers without the streaming export are read with the
+export handle methods. SzEngine.Destroy and the other lifecycle methods are not subcommands.
+
+The server is at -grpc-url, or SENZING_TOOLS_GRPC_URL, such as "grpc://localhost:8261".
+TLS is configured by the same SENZING_TOOLS_* environment variables as helper.GetGrpcTransportCredentials.
+Destructive subcommands, such as diagnostic purge-repository, need SENZING_TOOLS_CONFIRM_REPOSITORY
+set to the repository identity named in their error; see szabstractfactory.Protection.
+With -read-only, subcommands that change the repository are refused.
+Run "go run ./cmd/sz -help" for all subcommands, and "go run ./cmd/sz <object> <subcommand> -help" for their flags.
+
+# Related information
+
+  - [Senzing gRPC server]
+  - [Senzing Go SDK API definitions]
+
+[Senzing gRPC server]: https://github.com/senzing-garage/serve-grpc
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package main
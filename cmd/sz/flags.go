@@ -0,0 +1,145 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+The senzing.Sz* flags, by name.
+*/
+var szFlags = map[string]int64{
+	"SzAddRecordDefaultFlags":                 senzing.SzAddRecordDefaultFlags,
+	"SzDeleteRecordDefaultFlags":              senzing.SzDeleteRecordDefaultFlags,
+	"SzEntityBriefDefaultFlags":               senzing.SzEntityBriefDefaultFlags,
+	"SzEntityCoreFlags":                       senzing.SzEntityCoreFlags,
+	"SzEntityDefaultFlags":                    senzing.SzEntityDefaultFlags,
+	"SzEntityIncludeAllFeatures":              senzing.SzEntityIncludeAllFeatures,
+	"SzEntityIncludeAllRelations":             senzing.SzEntityIncludeAllRelations,
+	"SzEntityIncludeDisclosedRelations":       senzing.SzEntityIncludeDisclosedRelations,
+	"SzEntityIncludeEntityName":               senzing.SzEntityIncludeEntityName,
+	"SzEntityIncludeFeatureStats":             senzing.SzEntityIncludeFeatureStats,
+	"SzEntityIncludeInternalFeatures":         senzing.SzEntityIncludeInternalFeatures,
+	"SzEntityIncludeNameOnlyRelations":        senzing.SzEntityIncludeNameOnlyRelations,
+	"SzEntityIncludePossiblyRelatedRelations": senzing.SzEntityIncludePossiblyRelatedRelations,
+	"SzEntityIncludePossiblySameRelations":    senzing.SzEntityIncludePossiblySameRelations,
+	"SzEntityIncludeRecordData":               senzing.SzEntityIncludeRecordData,
+	"SzEntityIncludeRecordDates":              senzing.SzEntityIncludeRecordDates,
+	"SzEntityIncludeRecordFeatureDetails":     senzing.SzEntityIncludeRecordFeatureDetails,
+	"SzEntityIncludeRecordFeatureStats":       senzing.SzEntityIncludeRecordFeatureStats,
+	"SzEntityIncludeRecordFeatures":           senzing.SzEntityIncludeRecordFeatures,
+	"SzEntityIncludeRecordJSONData":           senzing.SzEntityIncludeRecordJSONData,
+	"SzEntityIncludeRecordMatchingInfo":       senzing.SzEntityIncludeRecordMatchingInfo,
+	"SzEntityIncludeRecordSummary":            senzing.SzEntityIncludeRecordSummary,
+	"SzEntityIncludeRecordTypes":              senzing.SzEntityIncludeRecordTypes,
+	"SzEntityIncludeRecordUnmappedData":       senzing.SzEntityIncludeRecordUnmappedData,
+	"SzEntityIncludeRelatedEntityName":        senzing.SzEntityIncludeRelatedEntityName,
+	"SzEntityIncludeRelatedMatchingInfo":      senzing.SzEntityIncludeRelatedMatchingInfo,
+	"SzEntityIncludeRelatedRecordData":        senzing.SzEntityIncludeRelatedRecordData,
+	"SzEntityIncludeRelatedRecordSummary":     senzing.SzEntityIncludeRelatedRecordSummary,
+	"SzEntityIncludeRelatedRecordTypes":       senzing.SzEntityIncludeRelatedRecordTypes,
+	"SzEntityIncludeRepresentativeFeatures":   senzing.SzEntityIncludeRepresentativeFeatures,
+	"SzExportDefaultFlags":                    senzing.SzExportDefaultFlags,
+	"SzExportIncludeAllEntities":              senzing.SzExportIncludeAllEntities,
+	"SzExportIncludeAllHavingRelationships":   senzing.SzExportIncludeAllHavingRelationships,
+	"SzExportIncludeDisclosed":                senzing.SzExportIncludeDisclosed,
+	"SzExportIncludeMultiRecordEntities":      senzing.SzExportIncludeMultiRecordEntities,
+	"SzExportIncludeNameOnly":                 senzing.SzExportIncludeNameOnly,
+	"SzExportIncludePossiblyRelated":          senzing.SzExportIncludePossiblyRelated,
+	"SzExportIncludePossiblySame":             senzing.SzExportIncludePossiblySame,
+	"SzExportIncludeSingleRecordEntities":     senzing.SzExportIncludeSingleRecordEntities,
+	"SzFindInterestingEntitiesDefaultFlags":   senzing.SzFindInterestingEntitiesDefaultFlags,
+	"SzFindNetworkDefaultFlags":               senzing.SzFindNetworkDefaultFlags,
+	"SzFindNetworkIncludeMatchingInfo":        senzing.SzFindNetworkIncludeMatchingInfo,
+	"SzFindPathDefaultFlags":                  senzing.SzFindPathDefaultFlags,
+	"SzFindPathIncludeMatchingInfo":           senzing.SzFindPathIncludeMatchingInfo,
+	"SzFindPathStrictAvoid":                   senzing.SzFindPathStrictAvoid,
+	"SzHowEntityDefaultFlags":                 senzing.SzHowEntityDefaultFlags,
+	"SzIncludeFeatureHashes":                  senzing.SzIncludeFeatureHashes,
+	"SzIncludeFeatureScores":                  senzing.SzIncludeFeatureScores,
+	"SzIncludeMatchKeyDetails":                senzing.SzIncludeMatchKeyDetails,
+	"SzNoFlags":                               senzing.SzNoFlags,
+	"SzRecordDefaultFlags":                    senzing.SzRecordDefaultFlags,
+	"SzRecordPreviewDefaultFlags":             senzing.SzRecordPreviewDefaultFlags,
+	"SzRedoDefaultFlags":                      senzing.SzRedoDefaultFlags,
+	"SzReevaluateEntityDefaultFlags":          senzing.SzReevaluateEntityDefaultFlags,
+	"SzReevaluateRecordDefaultFlags":          senzing.SzReevaluateRecordDefaultFlags,
+	"SzSearchByAttributesAll":                 senzing.SzSearchByAttributesAll,
+	"SzSearchByAttributesDefaultFlags":        senzing.SzSearchByAttributesDefaultFlags,
+	"SzSearchByAttributesMinimalAll":          senzing.SzSearchByAttributesMinimalAll,
+	"SzSearchByAttributesMinimalStrong":       senzing.SzSearchByAttributesMinimalStrong,
+	"SzSearchByAttributesStrong":              senzing.SzSearchByAttributesStrong,
+	"SzSearchIncludeAllCandidates":            senzing.SzSearchIncludeAllCandidates,
+	"SzSearchIncludeAllEntities":              senzing.SzSearchIncludeAllEntities,
+	"SzSearchIncludeNameOnly":                 senzing.SzSearchIncludeNameOnly,
+	"SzSearchIncludePossiblyRelated":          senzing.SzSearchIncludePossiblyRelated,
+	"SzSearchIncludePossiblySame":             senzing.SzSearchIncludePossiblySame,
+	"SzSearchIncludeRequest":                  senzing.SzSearchIncludeRequest,
+	"SzSearchIncludeRequestDetails":           senzing.SzSearchIncludeRequestDetails,
+	"SzSearchIncludeResolved":                 senzing.SzSearchIncludeResolved,
+	"SzSearchIncludeStats":                    senzing.SzSearchIncludeStats,
+	"SzVirtualEntityDefaultFlags":             senzing.SzVirtualEntityDefaultFlags,
+	"SzWhyEntitiesDefaultFlags":               senzing.SzWhyEntitiesDefaultFlags,
+	"SzWhyRecordInEntityDefaultFlags":         senzing.SzWhyRecordInEntityDefaultFlags,
+	"SzWhyRecordsDefaultFlags":                senzing.SzWhyRecordsDefaultFlags,
+	"SzWhySearchDefaultFlags":                 senzing.SzWhySearchDefaultFlags,
+	"SzWithInfo":                              senzing.SzWithInfo,
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+/*
+Decode flags such as "SZ_ENTITY_DEFAULT_FLAGS|SZ_INCLUDE_FEATURE_SCORES" into a senzing.Sz* value.
+Flags are separated by "|" or ",", and are each a name, with or without underscores and the "Sz" prefix
+and in any case, or a number.
+*/
+func parseSzFlags(text string) (int64, error) {
+	var result int64
+
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == '|' || r == ',' }) {
+		name := strings.TrimSpace(field)
+
+		value, err := strconv.ParseInt(name, 0, 64)
+		if err == nil {
+			result |= value
+
+			continue
+		}
+
+		value, isOK := szFlagsByKey[flagKey(name)]
+		if !isOK {
+			return 0, wraperror.Errorf(errForPackage, "unknown flag %q", name)
+		}
+
+		result |= value
+	}
+
+	return result, nil
+}
+
+/*
+The flag name, reduced so that "SZ_ENTITY_DEFAULT_FLAGS", "SzEntityDefaultFlags" and "entitydefaultflags" match.
+*/
+func flagKey(name string) string {
+	result := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+
+	return strings.TrimPrefix(result, "sz")
+}
+
+func getSzFlagsByKey() map[string]int64 {
+	result := make(map[string]int64, len(szFlags))
+	for name, value := range szFlags {
+		result[flagKey(name)] = value
+	}
+
+	return result
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"unicode"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Options that apply to every subcommand.
+*/
+type options struct {
+	compact  bool
+	grpcURL  string
+	readOnly bool
+}
+
+/*
+Makes the factory a subcommand calls, and a function that releases it.
+*/
+type factoryMaker func(ctx context.Context, anOptions options) (senzing.SzAbstractFactory, func(), error)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const jsonIndent = "  "
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("sz")
+
+var szFlagsByKey = getSzFlagsByKey()
+
+// ----------------------------------------------------------------------------
+// Main
+// ----------------------------------------------------------------------------
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr, newSzAbstractFactory)
+
+	stop()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err) //nolint
+
+		os.Exit(1)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+/*
+Run "sz [options] <object> <subcommand> [flags]".
+*/
+func run(
+	ctx context.Context,
+	commandLine []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	makeFactory factoryMaker,
+) error {
+	anOptions := options{} //exhaustruct:ignore
+	flagSet := flag.NewFlagSet("sz", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	flagSet.BoolVar(&anOptions.compact, "compact", false, "Print JSON on one line")
+	flagSet.StringVar(&anOptions.grpcURL, "grpc-url", helper.GetGrpcURL(),
+		"URL of the Senzing gRPC server. Also "+helper.GrpcURLEnvVar)
+	flagSet.BoolVar(&anOptions.readOnly, "read-only", false, "Refuse subcommands that change the repository")
+	flagSet.Usage = func() { printUsage(flagSet) }
+
+	err := flagSet.Parse(commandLine)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return wraperror.Errorf(err, "flags")
+	}
+
+	if flagSet.NArg() < 2 { //nolint:mnd
+		flagSet.Usage()
+
+		return wraperror.Errorf(errForPackage, "an object and a subcommand are required")
+	}
+
+	aCommand, err := findCommand(flagSet.Arg(0), flagSet.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	commandFlagSet := flag.NewFlagSet("sz "+flagSet.Arg(0)+" "+flagSet.Arg(1), flag.ContinueOnError)
+	commandFlagSet.SetOutput(stderr)
+	commandFlagSet.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s [flags]\n\nCalls %s.\n\n", commandFlagSet.Name(), aCommand.method)
+		commandFlagSet.PrintDefaults()
+	}
+
+	args, err := parseArguments(aCommand, commandFlagSet, flagSet.Args()[2:], stdin)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	factory, release, err := makeFactory(ctx, anOptions)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	result, err := aCommand.call(ctx, factory, args)
+	if err != nil {
+		return wraperror.Errorf(err, "%s", aCommand.method)
+	}
+
+	return printResult(stdout, result, anOptions.compact)
+}
+
+/*
+The name of the subcommand of a method: "SzEngine.GetEntityByEntityID" is "get-entity-by-entity-id".
+*/
+func commandName(method string) string {
+	_, name, _ := strings.Cut(method, ".")
+	runes := []rune(name)
+
+	var builder strings.Builder
+
+	for index, aRune := range runes {
+		if index > 0 && unicode.IsUpper(aRune) {
+			previousIsLower := unicode.IsLower(runes[index-1])
+			endsAcronym := unicode.IsUpper(runes[index-1]) && index+1 < len(runes) && unicode.IsLower(runes[index+1])
+
+			if previousIsLower || endsAcronym {
+				builder.WriteRune('-')
+			}
+		}
+
+		builder.WriteRune(unicode.ToLower(aRune))
+	}
+
+	return builder.String()
+}
+
+func findCommand(objectName string, name string) (*command, error) {
+	for _, anObject := range getObjects() {
+		if anObject.name != objectName {
+			continue
+		}
+
+		for index := range anObject.commands {
+			if commandName(anObject.commands[index].method) == name {
+				return &anObject.commands[index], nil
+			}
+		}
+
+		return nil, wraperror.Errorf(errForPackage, "unknown subcommand %q of %s; run \"sz -help\" for the list",
+			name, objectName)
+	}
+
+	return nil, wraperror.Errorf(errForPackage, "unknown object %q; run \"sz -help\" for the list", objectName)
+}
+
+/*
+A factory for the server at -grpc-url, with TLS from the SENZING_TOOLS_* environment variables read by
+helper.GetGrpcTransportCredentials. Destructive operations need confirmation; see szabstractfactory.Protection.
+*/
+func newSzAbstractFactory(ctx context.Context, anOptions options) (senzing.SzAbstractFactory, func(), error) {
+	grpcConnection, err := helper.GetGrpcConnection(ctx, anOptions.grpcURL)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	result := &szabstractfactory.Szabstractfactory{
+		GrpcConnection: grpcConnection,
+		Protection:     &szabstractfactory.Protection{}, //exhaustruct:ignore
+		ReadOnly:       anOptions.readOnly,
+	} //exhaustruct:ignore
+
+	return result, func() { _ = grpcConnection.Close() }, nil
+}
+
+/*
+Print a result: JSON indented unless compact, other text as is, and streams line by line.
+*/
+func printResult(writer io.Writer, result any, compact bool) error {
+	var err error
+
+	switch value := result.(type) {
+	case nil:
+		return nil
+	case int64:
+		_, err = fmt.Fprintln(writer, value)
+	case string:
+		if len(value) == 0 {
+			return nil
+		}
+
+		_, err = fmt.Fprintln(writer, formatJSON(value, compact))
+	case chan senzing.StringFragment:
+		for fragment := range value {
+			if fragment.Error != nil {
+				return wraperror.Errorf(fragment.Error, "export")
+			}
+
+			_, err = fmt.Fprint(writer, fragment.Value)
+			if err == nil && !strings.HasSuffix(fragment.Value, "\n") {
+				_, err = fmt.Fprintln(writer)
+			}
+
+			if err != nil {
+				break
+			}
+		}
+	default:
+		err = wraperror.Errorf(errForPackage, "cannot print a %T", result)
+	}
+
+	return wraperror.Errorf(err, "print")
+}
+
+/*
+Indent a JSON document, or compact it. Text that is not JSON is returned as is.
+*/
+func formatJSON(text string, compact bool) string {
+	var buffer bytes.Buffer
+
+	var err error
+	if compact {
+		err = json.Compact(&buffer, []byte(text))
+	} else {
+		err = json.Indent(&buffer, []byte(text), "", jsonIndent)
+	}
+
+	if err != nil {
+		return strings.TrimRight(text, "\n")
+	}
+
+	return buffer.String()
+}
+
+func printUsage(flagSet *flag.FlagSet) {
+	output := flagSet.Output()
+
+	fmt.Fprintf(output, "Usage: sz [options] <object> <subcommand> [flags]\n\n")
+	fmt.Fprintf(output, "Run \"sz <object> <subcommand> -help\" for the flags of a subcommand.\n\nOptions:\n")
+	flagSet.PrintDefaults()
+
+	for _, anObject := range getObjects() {
+		fmt.Fprintf(output, "\n%s:\n", anObject.name)
+
+		for _, aCommand := range anObject.commands {
+			fmt.Fprintf(output, "  %s\n", commandName(aCommand.method))
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRun_GetEntityByEntityID(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	output, err := runSz(test, fixture, "", "engine", "get-entity-by-entity-id", "-entity-id", "7")
+	require.NoError(test, err)
+	require.Equal(test, fmt.Sprintf("{\n  \"ENTITY_ID\": 7,\n  \"FLAGS\": %d\n}\n", senzing.SzEntityDefaultFlags), output)
+	require.Equal(test, senzing.SzEntityDefaultFlags, fixture.szEngine.flags)
+}
+
+func TestRun_GetEntityByEntityID_flags(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	output, err := runSz(test, fixture, "", "-compact", "engine", "get-entity-by-entity-id", "-entity-id", "7",
+		"-flags", "SZ_ENTITY_INCLUDE_ENTITY_NAME|SzEntityIncludeRecordData")
+	require.NoError(test, err)
+	require.Equal(test, fmt.Sprintf("{\"ENTITY_ID\":7,\"FLAGS\":%d}\n",
+		senzing.SzEntityIncludeEntityName|senzing.SzEntityIncludeRecordData), output)
+}
+
+func TestRun_AddRecord_stdin(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	record := `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith"}`
+	_, err := runSz(test, fixture, record, "engine", "add-record", "-record-definition", "-")
+	require.NoError(test, err)
+	require.Equal(test, "CUSTOMERS:1001", fixture.szEngine.recordKey)
+	require.Equal(test, record, fixture.szEngine.recordDefinition)
+	require.Equal(test, senzing.SzAddRecordDefaultFlags, fixture.szEngine.flags)
+}
+
+func TestRun_AddRecord_file(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	path := filepath.Join(test.TempDir(), "record.json")
+	require.NoError(test, os.WriteFile(path, []byte(`{"NAME_FULL": "Robert Smith"}`+"\n"), 0o600))
+	_, err := runSz(test, fixture, "", "engine", "add-record", "-data-source", "WATCHLIST", "-record-id", "1007",
+		"-record-definition", "@"+path)
+	require.NoError(test, err)
+	require.Equal(test, "WATCHLIST:1007", fixture.szEngine.recordKey)
+	require.JSONEq(test, `{"NAME_FULL": "Robert Smith"}`, fixture.szEngine.recordDefinition)
+}
+
+func TestRun_AddRecord_noRecordKey(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	_, err := runSz(test, fixture, "", "engine", "add-record", "-record-definition", `{"NAME_FULL": "Robert Smith"}`)
+	require.ErrorContains(test, err, "-data-source and -record-id are required")
+}
+
+func TestRun_FindNetworkByRecordID(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	_, err := runSz(test, fixture, "", "engine", "find-network-by-record-id",
+		"-record-keys", "CUSTOMERS:1001,WATCHLIST:1007")
+	require.NoError(test, err)
+	require.JSONEq(test,
+		`{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"},`+
+			`{"DATA_SOURCE": "WATCHLIST", "RECORD_ID": "1007"}]}`,
+		fixture.szEngine.recordKeys)
+	require.Equal(test, senzing.SzFindNetworkDefaultFlags, fixture.szEngine.flags)
+}
+
+func TestRun_GetActiveConfigID(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	output, err := runSz(test, fixture, "", "engine", "get-active-config-id")
+	require.NoError(test, err)
+	require.Equal(test, "4019066234\n", output)
+}
+
+func TestRun_ExportJSONEntityReportIterator(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	output, err := runSz(test, fixture, "", "engine", "export-json-entity-report-iterator")
+	require.NoError(test, err)
+	require.Equal(test, "{\"ENTITY_ID\":1}\n{\"ENTITY_ID\":2}\n", output)
+}
+
+func TestRun_ExportJSONEntityReportIterator_withoutStreaming(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	fixture.withoutStreaming = true
+	output, err := runSz(test, fixture, "", "engine", "export-json-entity-report-iterator")
+	require.NoError(test, err)
+	require.Equal(test, "{\"ENTITY_ID\":1}\n{\"ENTITY_ID\":2}\n", output)
+	require.True(test, fixture.szEngine.exportClosed)
+}
+
+func TestRun_GetVersion(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	output, err := runSz(test, fixture, "", "-compact", "product", "get-version")
+	require.NoError(test, err)
+	require.Equal(test, "{\"VERSION\":\"4.0.0\"}\n", output)
+}
+
+func TestRun_RegisterDataSource_save(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	_, err := runSz(test, fixture, "", "config", "register-data-source", "-data-source", "CUSTOMERS", "-save")
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS"}, fixture.szConfigManager.szConfig.dataSources)
+	require.Equal(test, "sz config register-data-source CUSTOMERS", fixture.szConfigManager.comment)
+	require.JSONEq(test, `{"DATA_SOURCES": ["CUSTOMERS"]}`, fixture.szConfigManager.configDefinition)
+}
+
+func TestRun_RegisterDataSource_noSave(test *testing.T) {
+	fixture := newFakeSzAbstractFactory()
+	_, err := runSz(test, fixture, "", "config", "register-data-source", "-data-source", "CUSTOMERS")
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS"}, fixture.szConfigManager.szConfig.dataSources)
+	require.Empty(test, fixture.szConfigManager.configDefinition)
+}
+
+func TestRun_errors(test *testing.T) {
+	testCases := []struct {
+		name        string
+		commandLine []string
+		expected    string
+	}{
+		{name: "no subcommand", commandLine: []string{"engine"}, expected: "an object and a subcommand are required"},
+		{name: "unknown object", commandLine: []string{"engines", "get-stats"}, expected: `unknown object "engines"`},
+		{
+			name:        "unknown subcommand",
+			commandLine: []string{"engine", "get-entity"},
+			expected:    `unknown subcommand "get-entity" of engine`,
+		},
+		{
+			name:        "required flag",
+			commandLine: []string{"engine", "get-entity-by-entity-id"},
+			expected:    "-entity-id is required",
+		},
+		{
+			name:        "not a number",
+			commandLine: []string{"engine", "get-entity-by-entity-id", "-entity-id", "one"},
+			expected:    `-entity-id is not a number: "one"`,
+		},
+		{
+			name:        "unknown flag",
+			commandLine: []string{"engine", "get-entity-by-entity-id", "-entity-id", "1", "-flags", "SZ_NO_SUCH_FLAG"},
+			expected:    `unknown flag "SZ_NO_SUCH_FLAG"`,
+		},
+		{
+			name:        "unexpected argument",
+			commandLine: []string{"engine", "get-stats", "extra"},
+			expected:    `unexpected argument "extra"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			_, err := runSz(test, newFakeSzAbstractFactory(), "", testCase.commandLine...)
+			require.ErrorContains(test, err, testCase.expected)
+		})
+	}
+}
+
+func TestRun_help(test *testing.T) {
+	var stderr bytes.Buffer
+
+	err := run(test.Context(), []string{"-help"}, strings.NewReader(""), &bytes.Buffer{}, &stderr, nil)
+	require.NoError(test, err)
+	require.Contains(test, stderr.String(), "get-entity-by-entity-id")
+	require.Contains(test, stderr.String(), "register-data-source")
+}
+
+func TestCommandName(test *testing.T) {
+	testCases := map[string]string{
+		"SzConfigManager.SetDefaultConfigID":      "set-default-config-id",
+		"SzEngine.ExportCsvEntityReportIterator":  "export-csv-entity-report-iterator",
+		"SzEngine.ExportJSONEntityReportIterator": "export-json-entity-report-iterator",
+		"SzEngine.GetEntityByEntityID":            "get-entity-by-entity-id",
+		"SzEngine.WhyEntities":                    "why-entities",
+	}
+
+	for method, expected := range testCases {
+		require.Equal(test, expected, commandName(method))
+	}
+}
+
+func TestParseSzFlags(test *testing.T) {
+	testCases := map[string]int64{
+		"":                           0,
+		"0x10":                       0x10,
+		"SZ_ENTITY_DEFAULT_FLAGS":    senzing.SzEntityDefaultFlags,
+		"SzEntityDefaultFlags":       senzing.SzEntityDefaultFlags,
+		"SZ_WITH_INFO | SZ_NO_FLAGS": senzing.SzWithInfo,
+		"SzWithInfo,3":               senzing.SzWithInfo | 3,
+	}
+
+	for text, expected := range testCases {
+		result, err := parseSzFlags(text)
+		require.NoError(test, err, text)
+		require.Equal(test, expected, result, text)
+	}
+}
+
+func TestBuildJSON(test *testing.T) {
+	result, err := buildJSON(kindEntityIDs, "1, 2")
+	require.NoError(test, err)
+	require.JSONEq(test, `{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 2}]}`, result)
+
+	result, err = buildJSON(kindDataSources, "CUSTOMERS,WATCHLIST")
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DATA_SOURCES": ["CUSTOMERS", "WATCHLIST"]}`, result)
+
+	result, err = buildJSON(kindEntityIDs, `{"ENTITIES": []}`)
+	require.NoError(test, err)
+	require.Equal(test, `{"ENTITIES": []}`, result)
+
+	_, err = buildJSON(kindEntityIDs, "1,two")
+	require.ErrorContains(test, err, `entity ID is not a number: "two"`)
+
+	_, err = buildJSON(kindRecordKeys, "CUSTOMERS")
+	require.ErrorContains(test, err, `record key is not DATA_SOURCE:RECORD_ID: "CUSTOMERS"`)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func runSz(test *testing.T, factory *fakeSzAbstractFactory, stdin string, commandLine ...string) (string, error) {
+	test.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	err := run(test.Context(), commandLine, strings.NewReader(stdin), &stdout, &stderr,
+		func(context.Context, options) (senzing.SzAbstractFactory, func(), error) {
+			return factory, func() {}, nil
+		})
+
+	return stdout.String(), err
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type fakeSzAbstractFactory struct {
+	senzing.SzAbstractFactory
+
+	szConfigManager  *fakeSzConfigManager
+	szEngine         *fakeSzEngine
+	withoutStreaming bool
+}
+
+func newFakeSzAbstractFactory() *fakeSzAbstractFactory {
+	return &fakeSzAbstractFactory{
+		szConfigManager: &fakeSzConfigManager{szConfig: &fakeSzConfig{}}, //exhaustruct:ignore
+		szEngine:        &fakeSzEngine{},                                 //exhaustruct:ignore
+	} //exhaustruct:ignore
+}
+
+func (factory *fakeSzAbstractFactory) CreateConfigManager(_ context.Context) (senzing.SzConfigManager, error) {
+	return factory.szConfigManager, nil
+}
+
+func (factory *fakeSzAbstractFactory) CreateEngine(_ context.Context) (senzing.SzEngine, error) {
+	return factory.szEngine, nil
+}
+
+func (factory *fakeSzAbstractFactory) CreateProduct(_ context.Context) (senzing.SzProduct, error) {
+	return &fakeSzProduct{}, nil //exhaustruct:ignore
+}
+
+func (factory *fakeSzAbstractFactory) Supports(_ context.Context, _ szabstractfactory.Capability) (bool, error) {
+	return !factory.withoutStreaming, nil
+}
+
+type fakeSzConfig struct {
+	senzing.SzConfig
+
+	dataSources []string
+}
+
+func (szConfig *fakeSzConfig) Export(_ context.Context) (string, error) {
+	return marshal(map[string]any{"DATA_SOURCES": szConfig.dataSources}), nil
+}
+
+func (szConfig *fakeSzConfig) RegisterDataSource(_ context.Context, dataSourceCode string) (string, error) {
+	szConfig.dataSources = append(szConfig.dataSources, dataSourceCode)
+
+	return `{"DSRC_ID": 1001}`, nil
+}
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	comment          string
+	configDefinition string
+	szConfig         *fakeSzConfig
+}
+
+func (szConfigManager *fakeSzConfigManager) CreateConfigFromConfigID(
+	_ context.Context,
+	_ int64,
+) (senzing.SzConfig, error) {
+	return szConfigManager.szConfig, nil
+}
+
+func (szConfigManager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	return 1, nil
+}
+
+func (szConfigManager *fakeSzConfigManager) SetDefaultConfig(
+	_ context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	szConfigManager.configDefinition = configDefinition
+	szConfigManager.comment = configComment
+
+	return 2, nil
+}
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	exportClosed     bool
+	exportFragments  []string
+	flags            int64
+	recordDefinition string
+	recordKey        string
+	recordKeys       string
+}
+
+func (szEngine *fakeSzEngine) AddRecord(
+	_ context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (string, error) {
+	szEngine.recordKey = dataSourceCode + ":" + recordID
+	szEngine.recordDefinition = recordDefinition
+	szEngine.flags = flags
+
+	return "", nil
+}
+
+func (szEngine *fakeSzEngine) CloseExportReport(_ context.Context, _ uintptr) error {
+	szEngine.exportClosed = true
+
+	return nil
+}
+
+func (szEngine *fakeSzEngine) ExportJSONEntityReport(_ context.Context, _ int64) (uintptr, error) {
+	szEngine.exportFragments = []string{`{"ENTITY_ID":1}` + "\n", `{"ENTITY_ID":2}`}
+
+	return 1, nil
+}
+
+func (szEngine *fakeSzEngine) ExportJSONEntityReportIterator(
+	_ context.Context,
+	_ int64,
+) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment, 2) //nolint:mnd
+	result <- senzing.StringFragment{Error: nil, Value: `{"ENTITY_ID":1}` + "\n"}
+	result <- senzing.StringFragment{Error: nil, Value: `{"ENTITY_ID":2}`}
+
+	close(result)
+
+	return result
+}
+
+func (szEngine *fakeSzEngine) FetchNext(_ context.Context, _ uintptr) (string, error) {
+	if len(szEngine.exportFragments) == 0 {
+		return "", nil
+	}
+
+	result := szEngine.exportFragments[0]
+	szEngine.exportFragments = szEngine.exportFragments[1:]
+
+	return result, nil
+}
+
+func (szEngine *fakeSzEngine) FindNetworkByRecordID(
+	_ context.Context,
+	recordKeys string,
+	_ int64,
+	_ int64,
+	_ int64,
+	flags int64,
+) (string, error) {
+	szEngine.recordKeys = recordKeys
+	szEngine.flags = flags
+
+	return "{}", nil
+}
+
+func (szEngine *fakeSzEngine) GetActiveConfigID(_ context.Context) (int64, error) {
+	return 4019066234, nil //nolint:mnd
+}
+
+func (szEngine *fakeSzEngine) GetEntityByEntityID(_ context.Context, entityID int64, flags int64) (string, error) {
+	szEngine.flags = flags
+
+	return fmt.Sprintf(`{"ENTITY_ID": %d, "FLAGS": %d}`, entityID, flags), nil
+}
+
+type fakeSzProduct struct {
+	senzing.SzProduct
+}
+
+func (szProduct *fakeSzProduct) GetVersion(_ context.Context) (string, error) {
+	return `{"VERSION": "4.0.0"}`, nil
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+How the value of a parameter is read.
+*/
+type parameterKind int
+
+const (
+	kindString      parameterKind = iota // Text.
+	kindBool                             // A switch, such as -save.
+	kindInt64                            // A number, such as an entity ID.
+	kindInput                            // Text that may be large: the value, "@path" for a file or "-" for stdin.
+	kindEntityIDs                        // "1,2" as {"ENTITIES": [...]}, or the JSON itself.
+	kindRecordKeys                       // "CUSTOMERS:1001,WATCHLIST:1007" as {"RECORDS": [...]}, or the JSON itself.
+	kindDataSources                      // "CUSTOMERS,WATCHLIST" as {"DATA_SOURCES": [...]}, or the JSON itself.
+)
+
+/*
+A named parameter of a subcommand, given as a command-line flag.
+*/
+type parameter struct {
+	defaultValue string
+	kind         parameterKind
+	name         string
+	optional     bool
+	usage        string
+}
+
+/*
+The values of the parameters of a subcommand, after reading inputs and building JSON.
+*/
+type arguments struct {
+	bools   map[string]bool
+	flags   int64
+	int64s  map[string]int64
+	strings map[string]string
+}
+
+/*
+Reads the values of input parameters. Stdin can be read once.
+*/
+type input struct {
+	stdin     io.Reader
+	stdinRead bool
+}
+
+// ----------------------------------------------------------------------------
+// arguments methods
+// ----------------------------------------------------------------------------
+
+func (args *arguments) bool(name string) bool {
+	return args.bools[name]
+}
+
+func (args *arguments) int64(name string) int64 {
+	return args.int64s[name]
+}
+
+func (args *arguments) string(name string) string {
+	return args.strings[name]
+}
+
+/*
+Convert the text of a parameter to its value.
+*/
+func (args *arguments) set(aParameter parameter, text string, inputReader *input) error {
+	if len(text) == 0 {
+		if !aParameter.optional {
+			return wraperror.Errorf(errForPackage, "-%s is required", aParameter.name)
+		}
+
+		if aParameter.kind == kindInt64 {
+			args.int64s[aParameter.name] = 0
+		} else {
+			args.strings[aParameter.name] = ""
+		}
+
+		return nil
+	}
+
+	switch aParameter.kind {
+	case kindInt64:
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return wraperror.Errorf(errForPackage, "-%s is not a number: %q", aParameter.name, text)
+		}
+
+		args.int64s[aParameter.name] = value
+
+		return nil
+	case kindInput, kindEntityIDs, kindRecordKeys, kindDataSources:
+		value, err := inputReader.read(text)
+		if err != nil {
+			return wraperror.Errorf(err, "-%s", aParameter.name)
+		}
+
+		value, err = buildJSON(aParameter.kind, value)
+		if err != nil {
+			return wraperror.Errorf(err, "-%s", aParameter.name)
+		}
+
+		args.strings[aParameter.name] = value
+
+		return nil
+	default:
+		args.strings[aParameter.name] = text
+
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// input methods
+// ----------------------------------------------------------------------------
+
+/*
+Read "-" from stdin, "@path" from a file, and anything else as is.
+*/
+func (inputReader *input) read(text string) (string, error) {
+	switch {
+	case text == "-":
+		if inputReader.stdinRead {
+			return "", wraperror.Errorf(errForPackage, "stdin is already read by another parameter")
+		}
+
+		inputReader.stdinRead = true
+
+		result, err := io.ReadAll(inputReader.stdin)
+		if err != nil {
+			return "", wraperror.Errorf(err, "read stdin")
+		}
+
+		return strings.TrimSpace(string(result)), nil
+	case strings.HasPrefix(text, "@"):
+		result, err := os.ReadFile(text[1:])
+		if err != nil {
+			return "", wraperror.Errorf(err, "ReadFile")
+		}
+
+		return strings.TrimSpace(string(result)), nil
+	default:
+		return text, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+/*
+Parse the command-line flags of a subcommand into arguments.
+*/
+func parseArguments(
+	aCommand *command,
+	flagSet *flag.FlagSet,
+	commandLine []string,
+	stdin io.Reader,
+) (*arguments, error) {
+	texts := map[string]*string{}
+	bools := map[string]*bool{}
+
+	for _, aParameter := range aCommand.parameters {
+		if aParameter.kind == kindBool {
+			bools[aParameter.name] = flagSet.Bool(aParameter.name, false, aParameter.usage)
+
+			continue
+		}
+
+		texts[aParameter.name] = flagSet.String(aParameter.name, aParameter.defaultValue, aParameter.usage)
+	}
+
+	var flagsText *string
+	if len(aCommand.defaultFlags) > 0 {
+		flagsText = flagSet.String("flags", aCommand.defaultFlags,
+			`senzing.Sz* flags, by name or number, separated by "|"`)
+	}
+
+	err := flagSet.Parse(commandLine)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if flagSet.NArg() > 0 {
+		return nil, wraperror.Errorf(errForPackage, "unexpected argument %q", flagSet.Arg(0))
+	}
+
+	result := &arguments{
+		bools:   map[string]bool{},
+		flags:   0,
+		int64s:  map[string]int64{},
+		strings: map[string]string{},
+	}
+
+	for name, value := range bools {
+		result.bools[name] = *value
+	}
+
+	if flagsText != nil {
+		result.flags, err = parseSzFlags(*flagsText)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputReader := &input{stdin: stdin, stdinRead: false}
+
+	for _, aParameter := range aCommand.parameters {
+		if aParameter.kind == kindBool {
+			continue
+		}
+
+		err = result.set(aParameter, *texts[aParameter.name], inputReader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Build the JSON document of a list parameter. Text that is already a JSON object is kept.
+*/
+func buildJSON(kind parameterKind, text string) (string, error) {
+	if kind == kindInput || strings.HasPrefix(strings.TrimSpace(text), "{") {
+		return text, nil
+	}
+
+	values := splitList(text)
+
+	switch kind {
+	case kindEntityIDs:
+		entities := []map[string]int64{}
+
+		for _, value := range values {
+			entityID, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return "", wraperror.Errorf(errForPackage, "entity ID is not a number: %q", value)
+			}
+
+			entities = append(entities, map[string]int64{"ENTITY_ID": entityID})
+		}
+
+		return marshal(map[string]any{"ENTITIES": entities}), nil
+	case kindRecordKeys:
+		records := []map[string]string{}
+
+		for _, value := range values {
+			dataSourceCode, recordID, isOK := strings.Cut(value, ":")
+			if !isOK {
+				return "", wraperror.Errorf(errForPackage, "record key is not DATA_SOURCE:RECORD_ID: %q", value)
+			}
+
+			records = append(records, map[string]string{"DATA_SOURCE": dataSourceCode, "RECORD_ID": recordID})
+		}
+
+		return marshal(map[string]any{"RECORDS": records}), nil
+	default:
+		return marshal(map[string]any{"DATA_SOURCES": values}), nil
+	}
+}
+
+func marshal(value any) string {
+	result, _ := json.Marshal(value) //nolint:errchkjson
+
+	return string(result)
+}
+
+func splitList(text string) []string {
+	result := []string{}
+
+	for field := range strings.SplitSeq(text, ",") {
+		if value := strings.TrimSpace(field); len(value) > 0 {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,38 @@
+package coalesce_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/coalesce"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSzengine_GetEntityByEntityID() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/coalesce/coalesce_test.go
+	ctx := context.TODO()
+	szEngine := &coalesce.Szengine{SzEngine: getSzEngine(ctx)} //exhaustruct:ignore
+
+	result, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(result)
+	// Output: {"RESOLVED_ENTITY":{"ENTITY_ID":100}}
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzEngine(_ context.Context) senzing.SzEngine {
+	result := newFakeSzEngine()
+	close(result.release)
+
+	return result
+}
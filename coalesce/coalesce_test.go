@@ -0,0 +1,328 @@
+package coalesce_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/coalesce"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+const concurrentCallers = 10
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzengine_GetEntityByEntityID(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+	results := make(chan string, concurrentCallers)
+
+	var waitGroup sync.WaitGroup
+
+	for range concurrentCallers {
+		waitGroup.Go(func() {
+			actual, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+			assertNoError(test, err)
+
+			results <- actual
+		})
+	}
+
+	waitForCoalesced(test, szEngine.Stats, concurrentCallers-1)
+	close(fake.release)
+	waitGroup.Wait()
+	close(results)
+
+	for actual := range results {
+		printDebug(test, nil, actual)
+		require.JSONEq(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":100}}`, actual)
+	}
+
+	require.Equal(test, int64(1), fake.calls.Load())
+	require.Equal(test, coalesce.Stats{Calls: 1, Coalesced: concurrentCallers - 1}, szEngine.Stats())
+}
+
+func TestSzengine_GetEntityByEntityID_differentArguments(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	close(fake.release)
+
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+	_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByEntityID(ctx, 100, senzing.SzEntityIncludeEntityName)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByEntityID(ctx, 101, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, int64(3), fake.calls.Load())
+}
+
+func TestSzengine_GetEntityByEntityID_error(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	close(fake.release)
+
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+	_, err := szEngine.GetEntityByEntityID(ctx, -1, senzing.SzNoFlags)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+}
+
+func TestSzengine_GetActiveConfigID(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	var waitGroup sync.WaitGroup
+
+	for range concurrentCallers {
+		waitGroup.Go(func() {
+			actual, err := szEngine.GetActiveConfigID(ctx)
+			assertNoError(test, err)
+
+			if actual != 4 {
+				test.Errorf("GetActiveConfigID: got %d", actual)
+			}
+		})
+	}
+
+	waitForCoalesced(test, szEngine.Stats, concurrentCallers-1)
+	close(fake.release)
+	waitGroup.Wait()
+	require.Equal(test, int64(1), fake.calls.Load())
+}
+
+func TestSzengine_cancel(test *testing.T) {
+	fake := newFakeSzEngine()
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+	firstCtx, cancelFirst := context.WithCancel(test.Context())
+	firstErr := make(chan error, 1)
+	secondResult := make(chan string, 1)
+
+	go func() {
+		_, err := szEngine.GetStats(firstCtx)
+		firstErr <- err
+	}()
+
+	waitForCalls(test, fake, 1)
+
+	go func() {
+		actual, err := szEngine.GetStats(test.Context())
+		assertNoError(test, err)
+
+		secondResult <- actual
+	}()
+
+	waitForCoalesced(test, szEngine.Stats, 1)
+
+	// The first caller gives up; the shared call continues for the second caller.
+
+	cancelFirst()
+	require.ErrorIs(test, <-firstErr, context.Canceled)
+	close(fake.release)
+	require.JSONEq(test, `{"workload":{}}`, <-secondResult)
+	require.False(test, fake.canceled.Load())
+}
+
+func TestSzengine_cancelAll(test *testing.T) {
+	fake := newFakeSzEngine()
+	szEngine := &coalesce.Szengine{SzEngine: fake} //exhaustruct:ignore
+	ctx, cancel := context.WithCancel(test.Context())
+	errs := make(chan error, 1)
+
+	go func() {
+		_, err := szEngine.GetStats(ctx)
+		errs <- err
+	}()
+
+	waitForCalls(test, fake, 1)
+	cancel()
+	require.ErrorIs(test, <-errs, context.Canceled)
+
+	// With no callers waiting, the shared call is canceled.
+
+	require.Eventually(test, func() bool { return fake.canceled.Load() }, time.Second, time.Millisecond)
+}
+
+func TestSzdiagnostic_GetRepositoryInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := &fakeSzDiagnostic{release: make(chan struct{})}    //exhaustruct:ignore
+	szDiagnostic := &coalesce.Szdiagnostic{SzDiagnostic: fake} //exhaustruct:ignore
+
+	var waitGroup sync.WaitGroup
+
+	for range concurrentCallers {
+		waitGroup.Go(func() {
+			_, err := szDiagnostic.GetRepositoryInfo(ctx)
+			assertNoError(test, err)
+		})
+	}
+
+	waitForCoalesced(test, szDiagnostic.Stats, concurrentCallers-1)
+	close(fake.release)
+	waitGroup.Wait()
+	require.Equal(test, int64(1), fake.calls.Load())
+
+	_, err := szDiagnostic.GetFeature(ctx, 1)
+	require.NoError(test, err)
+}
+
+func TestSzproduct_GetVersion(test *testing.T) {
+	ctx := test.Context()
+	fake := &fakeSzProduct{release: make(chan struct{})} //exhaustruct:ignore
+	szProduct := &coalesce.Szproduct{SzProduct: fake}    //exhaustruct:ignore
+
+	var waitGroup sync.WaitGroup
+
+	for range concurrentCallers {
+		waitGroup.Go(func() {
+			_, err := szProduct.GetVersion(ctx)
+			assertNoError(test, err)
+		})
+	}
+
+	waitForCoalesced(test, szProduct.Stats, concurrentCallers-1)
+	close(fake.release)
+	waitGroup.Wait()
+	require.Equal(test, int64(1), fake.calls.Load())
+
+	_, err := szProduct.GetLicense(ctx)
+	require.NoError(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	calls    atomic.Int64
+	canceled atomic.Bool
+	release  chan struct{}
+}
+
+func newFakeSzEngine() *fakeSzEngine {
+	return &fakeSzEngine{ //exhaustruct:ignore
+		release: make(chan struct{}),
+	}
+}
+
+func (engine *fakeSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	engine.wait(ctx)
+
+	return 4, nil
+}
+
+func (engine *fakeSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, _ int64) (string, error) {
+	engine.wait(ctx)
+
+	if entityID < 0 {
+		return "", szerror.New(37, fmt.Sprintf(`{"reason":"SENZ0037|Unknown resolved entity value '%d'"}`, entityID))
+	}
+
+	return fmt.Sprintf(`{"RESOLVED_ENTITY":{"ENTITY_ID":%d}}`, entityID), nil
+}
+
+func (engine *fakeSzEngine) GetStats(ctx context.Context) (string, error) {
+	engine.wait(ctx)
+
+	return `{"workload":{}}`, nil
+}
+
+func (engine *fakeSzEngine) wait(ctx context.Context) {
+	engine.calls.Add(1)
+
+	select {
+	case <-engine.release:
+	case <-ctx.Done():
+		engine.canceled.Store(true)
+	}
+}
+
+type fakeSzDiagnostic struct {
+	senzing.SzDiagnostic
+
+	calls   atomic.Int64
+	release chan struct{}
+}
+
+func (diagnostic *fakeSzDiagnostic) GetFeature(_ context.Context, _ int64) (string, error) {
+	return `{"FTYPE_CODE":"NAME"}`, nil
+}
+
+func (diagnostic *fakeSzDiagnostic) GetRepositoryInfo(_ context.Context) (string, error) {
+	diagnostic.calls.Add(1)
+	<-diagnostic.release
+
+	return `{"dataStores":[]}`, nil
+}
+
+type fakeSzProduct struct {
+	senzing.SzProduct
+
+	calls   atomic.Int64
+	release chan struct{}
+}
+
+func (product *fakeSzProduct) GetLicense(_ context.Context) (string, error) {
+	return `{"licenseType":"EVAL"}`, nil
+}
+
+func (product *fakeSzProduct) GetVersion(_ context.Context) (string, error) {
+	product.calls.Add(1)
+	<-product.release
+
+	return `{"VERSION":"4.1.1"}`, nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func waitForCalls(t *testing.T, fake *fakeSzEngine, calls int64) {
+	t.Helper()
+	require.Eventually(t, func() bool { return fake.calls.Load() >= calls }, time.Second, time.Millisecond)
+}
+
+func waitForCoalesced(t *testing.T, stats func() coalesce.Stats, coalesced int64) {
+	t.Helper()
+	require.Eventually(t, func() bool { return stats().Coalesced >= coalesced }, time.Second, time.Millisecond)
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
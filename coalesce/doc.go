@@ -0,0 +1,18 @@
+/*
+Package coalesce collapses identical, concurrent read-only requests into a single request.
+
+The [Szengine], [Szdiagnostic] and [Szproduct] types decorate the corresponding senzing interfaces.
+When a read-only method is called while an identical call (same method, same arguments) is in flight,
+the caller waits for the in-flight call and receives its result instead of making another round trip.
+Methods that change the repository are passed through unchanged.
+
+The shared call is not canceled when the caller that started it gives up;
+it is canceled only when every caller waiting on it has given up.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package coalesce
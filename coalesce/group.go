@@ -0,0 +1,154 @@
+package coalesce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Stats holds request coalescing statistics.
+*/
+type Stats struct {
+	Calls     int64 // Calls made to the wrapped object.
+	Coalesced int64 // Calls answered by sharing the result of an in-flight call.
+}
+
+type call struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+	value   any
+	waiters int
+}
+
+type group struct {
+	calls map[string]*call
+	mutex sync.Mutex
+	stats Stats
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (group *group) do(ctx context.Context, key string, method func(ctx context.Context) (any, error)) (any, error) {
+	group.mutex.Lock()
+
+	if group.calls == nil {
+		group.calls = map[string]*call{}
+	}
+
+	inFlight, isOK := group.calls[key]
+	if isOK {
+		inFlight.waiters++
+		group.stats.Coalesced++
+	} else {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		inFlight = &call{
+			cancel:  cancel,
+			done:    make(chan struct{}),
+			err:     nil,
+			value:   nil,
+			waiters: 1,
+		}
+		group.calls[key] = inFlight
+		group.stats.Calls++
+
+		go func() {
+			inFlight.value, inFlight.err = method(callCtx)
+
+			group.forget(key, inFlight)
+			cancel()
+			close(inFlight.done)
+		}()
+	}
+
+	group.mutex.Unlock()
+
+	select {
+	case <-inFlight.done:
+		return inFlight.value, inFlight.err
+	case <-ctx.Done():
+		group.mutex.Lock()
+
+		inFlight.waiters--
+		if inFlight.waiters == 0 {
+			inFlight.cancel()
+
+			if group.calls[key] == inFlight {
+				delete(group.calls, key)
+			}
+		}
+
+		group.mutex.Unlock()
+
+		return nil, ctx.Err() //nolint:wrapcheck // Preserve errors.Is(err, context.Canceled)
+	}
+}
+
+func (group *group) forget(key string, inFlight *call) {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	if group.calls[key] == inFlight {
+		delete(group.calls, key)
+	}
+}
+
+func (group *group) getStats() Stats {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	return group.stats
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func doString(
+	ctx context.Context,
+	group *group,
+	key string,
+	method func(ctx context.Context) (string, error),
+) (string, error) {
+	value, err := group.do(ctx, key, func(ctx context.Context) (any, error) {
+		return method(ctx)
+	})
+	result, _ := value.(string)
+
+	return result, err
+}
+
+func doInt64(
+	ctx context.Context,
+	group *group,
+	key string,
+	method func(ctx context.Context) (int64, error),
+) (int64, error) {
+	value, err := group.do(ctx, key, func(ctx context.Context) (any, error) {
+		return method(ctx)
+	})
+	result, _ := value.(int64)
+
+	return result, err
+}
+
+func makeKey(method string, arguments ...any) string {
+	var builder strings.Builder
+
+	builder.WriteString(method)
+
+	for _, argument := range arguments {
+		builder.WriteString("\x00")
+		builder.WriteString(fmt.Sprint(argument))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,59 @@
+package coalesce
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Szdiagnostic decorates a senzing.SzDiagnostic, coalescing identical concurrent calls to read-only methods.
+Methods that are not overridden are passed through to the wrapped SzDiagnostic.
+*/
+type Szdiagnostic struct {
+	senzing.SzDiagnostic
+
+	group group
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method GetFeature coalesces identical concurrent calls to SzDiagnostic.GetFeature.
+*/
+func (client *Szdiagnostic) GetFeature(ctx context.Context, featureID int64) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetFeature", featureID),
+		func(ctx context.Context) (string, error) {
+			return client.SzDiagnostic.GetFeature(ctx, featureID)
+		})
+}
+
+/*
+Method GetRepositoryInfo coalesces identical concurrent calls to SzDiagnostic.GetRepositoryInfo.
+*/
+func (client *Szdiagnostic) GetRepositoryInfo(ctx context.Context) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetRepositoryInfo"),
+		func(ctx context.Context) (string, error) {
+			return client.SzDiagnostic.GetRepositoryInfo(ctx)
+		})
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Stats returns a snapshot of the coalescing statistics.
+
+Output
+  - Coalescing statistics.
+*/
+func (client *Szdiagnostic) Stats() Stats {
+	return client.group.getStats()
+}
@@ -0,0 +1,366 @@
+package coalesce
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Szengine decorates a senzing.SzEngine, coalescing identical concurrent calls to read-only methods.
+Methods that are not overridden are passed through to the wrapped SzEngine.
+*/
+type Szengine struct {
+	senzing.SzEngine
+
+	group group
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method CountRedoRecords coalesces identical concurrent calls to SzEngine.CountRedoRecords.
+*/
+func (client *Szengine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return doInt64(ctx, &client.group, makeKey("CountRedoRecords"),
+		func(ctx context.Context) (int64, error) {
+			return client.SzEngine.CountRedoRecords(ctx)
+		})
+}
+
+/*
+Method FindInterestingEntitiesByEntityID coalesces identical concurrent calls to
+SzEngine.FindInterestingEntitiesByEntityID.
+*/
+func (client *Szengine) FindInterestingEntitiesByEntityID(
+	ctx context.Context,
+	entityID int64,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("FindInterestingEntitiesByEntityID", entityID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+		})
+}
+
+/*
+Method FindInterestingEntitiesByRecordID coalesces identical concurrent calls to
+SzEngine.FindInterestingEntitiesByRecordID.
+*/
+func (client *Szengine) FindInterestingEntitiesByRecordID(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("FindInterestingEntitiesByRecordID", dataSourceCode, recordID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+		})
+}
+
+/*
+Method FindNetworkByEntityID coalesces identical concurrent calls to SzEngine.FindNetworkByEntityID.
+*/
+func (client *Szengine) FindNetworkByEntityID(
+	ctx context.Context,
+	entityIDs string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (string, error) {
+	key := makeKey("FindNetworkByEntityID", entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+
+	return doString(ctx, &client.group, key,
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindNetworkByEntityID(
+				ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		})
+}
+
+/*
+Method FindNetworkByRecordID coalesces identical concurrent calls to SzEngine.FindNetworkByRecordID.
+*/
+func (client *Szengine) FindNetworkByRecordID(
+	ctx context.Context,
+	recordKeys string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (string, error) {
+	key := makeKey("FindNetworkByRecordID", recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+
+	return doString(ctx, &client.group, key,
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindNetworkByRecordID(
+				ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		})
+}
+
+/*
+Method FindPathByEntityID coalesces identical concurrent calls to SzEngine.FindPathByEntityID.
+*/
+func (client *Szengine) FindPathByEntityID(
+	ctx context.Context,
+	startEntityID int64,
+	endEntityID int64,
+	maxDegrees int64,
+	avoidEntityIDs string,
+	requiredDataSources string,
+	flags int64,
+) (string, error) {
+	key := makeKey(
+		"FindPathByEntityID",
+		startEntityID,
+		endEntityID,
+		maxDegrees,
+		avoidEntityIDs,
+		requiredDataSources,
+		flags,
+	)
+
+	return doString(ctx, &client.group, key,
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindPathByEntityID(
+				ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+		})
+}
+
+/*
+Method FindPathByRecordID coalesces identical concurrent calls to SzEngine.FindPathByRecordID.
+*/
+func (client *Szengine) FindPathByRecordID(
+	ctx context.Context,
+	startDataSourceCode string,
+	startRecordID string,
+	endDataSourceCode string,
+	endRecordID string,
+	maxDegrees int64,
+	avoidRecordKeys string,
+	requiredDataSources string,
+	flags int64,
+) (string, error) {
+	key := makeKey(
+		"FindPathByRecordID",
+		startDataSourceCode,
+		startRecordID,
+		endDataSourceCode,
+		endRecordID,
+		maxDegrees,
+		avoidRecordKeys,
+		requiredDataSources,
+		flags,
+	)
+
+	return doString(ctx, &client.group, key,
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.FindPathByRecordID(
+				ctx,
+				startDataSourceCode,
+				startRecordID,
+				endDataSourceCode,
+				endRecordID,
+				maxDegrees,
+				avoidRecordKeys,
+				requiredDataSources,
+				flags,
+			)
+		})
+}
+
+/*
+Method GetActiveConfigID coalesces identical concurrent calls to SzEngine.GetActiveConfigID.
+*/
+func (client *Szengine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return doInt64(ctx, &client.group, makeKey("GetActiveConfigID"),
+		func(ctx context.Context) (int64, error) {
+			return client.SzEngine.GetActiveConfigID(ctx)
+		})
+}
+
+/*
+Method GetEntityByEntityID coalesces identical concurrent calls to SzEngine.GetEntityByEntityID.
+*/
+func (client *Szengine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetEntityByEntityID", entityID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetEntityByEntityID(ctx, entityID, flags)
+		})
+}
+
+/*
+Method GetEntityByRecordID coalesces identical concurrent calls to SzEngine.GetEntityByRecordID.
+*/
+func (client *Szengine) GetEntityByRecordID(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetEntityByRecordID", dataSourceCode, recordID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+		})
+}
+
+/*
+Method GetRecord coalesces identical concurrent calls to SzEngine.GetRecord.
+*/
+func (client *Szengine) GetRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetRecord", dataSourceCode, recordID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+		})
+}
+
+/*
+Method GetRecordPreview coalesces identical concurrent calls to SzEngine.GetRecordPreview.
+*/
+func (client *Szengine) GetRecordPreview(ctx context.Context, recordDefinition string, flags int64) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetRecordPreview", recordDefinition, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetRecordPreview(ctx, recordDefinition, flags)
+		})
+}
+
+/*
+Method GetStats coalesces identical concurrent calls to SzEngine.GetStats.
+Because SzEngine.GetStats resets the statistics, callers sharing a call receive the same statistics.
+*/
+func (client *Szengine) GetStats(ctx context.Context) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetStats"),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetStats(ctx)
+		})
+}
+
+/*
+Method GetVirtualEntityByRecordID coalesces identical concurrent calls to SzEngine.GetVirtualEntityByRecordID.
+*/
+func (client *Szengine) GetVirtualEntityByRecordID(
+	ctx context.Context,
+	recordKeys string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetVirtualEntityByRecordID", recordKeys, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.GetVirtualEntityByRecordID(ctx, recordKeys, flags)
+		})
+}
+
+/*
+Method HowEntityByEntityID coalesces identical concurrent calls to SzEngine.HowEntityByEntityID.
+*/
+func (client *Szengine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return doString(ctx, &client.group, makeKey("HowEntityByEntityID", entityID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.HowEntityByEntityID(ctx, entityID, flags)
+		})
+}
+
+/*
+Method SearchByAttributes coalesces identical concurrent calls to SzEngine.SearchByAttributes.
+*/
+func (client *Szengine) SearchByAttributes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("SearchByAttributes", attributes, searchProfile, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+		})
+}
+
+/*
+Method WhyEntities coalesces identical concurrent calls to SzEngine.WhyEntities.
+*/
+func (client *Szengine) WhyEntities(
+	ctx context.Context,
+	entityID1 int64,
+	entityID2 int64,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("WhyEntities", entityID1, entityID2, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+		})
+}
+
+/*
+Method WhyRecordInEntity coalesces identical concurrent calls to SzEngine.WhyRecordInEntity.
+*/
+func (client *Szengine) WhyRecordInEntity(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("WhyRecordInEntity", dataSourceCode, recordID, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+		})
+}
+
+/*
+Method WhyRecords coalesces identical concurrent calls to SzEngine.WhyRecords.
+*/
+func (client *Szengine) WhyRecords(
+	ctx context.Context,
+	dataSourceCode1 string,
+	recordID1 string,
+	dataSourceCode2 string,
+	recordID2 string,
+	flags int64,
+) (string, error) {
+	key := makeKey("WhyRecords", dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+
+	return doString(ctx, &client.group, key,
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+		})
+}
+
+/*
+Method WhySearch coalesces identical concurrent calls to SzEngine.WhySearch.
+*/
+func (client *Szengine) WhySearch(
+	ctx context.Context,
+	attributes string,
+	entityID int64,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	return doString(ctx, &client.group, makeKey("WhySearch", attributes, entityID, searchProfile, flags),
+		func(ctx context.Context) (string, error) {
+			return client.SzEngine.WhySearch(ctx, attributes, entityID, searchProfile, flags)
+		})
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Stats returns a snapshot of the coalescing statistics.
+
+Output
+  - Coalescing statistics.
+*/
+func (client *Szengine) Stats() Stats {
+	return client.group.getStats()
+}
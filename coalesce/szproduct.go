@@ -0,0 +1,59 @@
+package coalesce
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Szproduct decorates a senzing.SzProduct, coalescing identical concurrent calls to read-only methods.
+Methods that are not overridden are passed through to the wrapped SzProduct.
+*/
+type Szproduct struct {
+	senzing.SzProduct
+
+	group group
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method GetLicense coalesces identical concurrent calls to SzProduct.GetLicense.
+*/
+func (client *Szproduct) GetLicense(ctx context.Context) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetLicense"),
+		func(ctx context.Context) (string, error) {
+			return client.SzProduct.GetLicense(ctx)
+		})
+}
+
+/*
+Method GetVersion coalesces identical concurrent calls to SzProduct.GetVersion.
+*/
+func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
+	return doString(ctx, &client.group, makeKey("GetVersion"),
+		func(ctx context.Context) (string, error) {
+			return client.SzProduct.GetVersion(ctx)
+		})
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Stats returns a snapshot of the coalescing statistics.
+
+Output
+  - Coalescing statistics.
+*/
+func (client *Szproduct) Stats() Stats {
+	return client.group.getStats()
+}
@@ -0,0 +1,331 @@
+package configbackup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Manifest describes the contents of an archive directory.
+*/
+type Manifest struct {
+	Configs         []ManifestConfig `json:"CONFIGS"`
+	CreatedAt       time.Time        `json:"CREATED_AT"`
+	DefaultConfigID int64            `json:"DEFAULT_CONFIG_ID"` // 0 if the repository had no default configuration.
+	FormatVersion   int              `json:"FORMAT_VERSION"`
+}
+
+/*
+ManifestConfig describes one archived configuration.
+*/
+type ManifestConfig struct {
+	ConfigComments string `json:"CONFIG_COMMENTS"`
+	ConfigID       int64  `json:"CONFIG_ID"`
+	File           string `json:"FILE"` // Relative to the archive directory.
+	SHA256         string `json:"SHA256"`
+	SysCreateDt    string `json:"SYS_CREATE_DT"`
+}
+
+/*
+RestoreResult describes the outcome of [Restore].
+*/
+type RestoreResult struct {
+	ConfigIDs       map[int64]int64 // Archived configuration ID to newly registered configuration ID.
+	DefaultConfigID int64           // The new default configuration ID. 0 if the archive had none.
+}
+
+type configRegistry struct {
+	Configs []struct {
+		ConfigComments string `json:"CONFIG_COMMENTS"`
+		ConfigID       int64  `json:"CONFIG_ID"`
+		SysCreateDt    string `json:"SYS_CREATE_DT"`
+	} `json:"CONFIGS"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Backup function writes every registered configuration and the default configuration ID to an archive directory.
+The directory is created if needed; it must not already contain a manifest.
+Configurations registered while Backup runs may be left out, but the archived default is always in the archive.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szConfigManager: The configuration manager of the repository to back up.
+  - directory: The archive directory.
+
+Output
+  - The manifest written to the archive directory.
+*/
+func Backup(ctx context.Context, szConfigManager senzing.SzConfigManager, directory string) (*Manifest, error) {
+	manifestPath := filepath.Join(directory, ManifestFileName)
+
+	_, err := os.Stat(manifestPath)
+	if err == nil {
+		return nil, wraperror.Errorf(errForPackage, "archive already exists: %s", manifestPath)
+	}
+
+	err = os.MkdirAll(directory, directoryPermissions)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.MkdirAll: %s", directory)
+	}
+
+	// The default is read first: a configuration is registered before it is promoted,
+	// so a default promoted after this read cannot be missing from the registry read below.
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	registry, err := getConfigRegistry(ctx, szConfigManager)
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultConfigID != 0 && !registry.contains(defaultConfigID) {
+		return nil, wraperror.Errorf(errForPackage, "default configuration %d is not in the configuration registry",
+			defaultConfigID)
+	}
+
+	result := &Manifest{
+		Configs:         make([]ManifestConfig, 0, len(registry.Configs)),
+		CreatedAt:       time.Now().UTC(),
+		DefaultConfigID: defaultConfigID,
+		FormatVersion:   FormatVersion,
+	}
+
+	for _, config := range registry.Configs {
+		manifestConfig, err := backupConfig(ctx, szConfigManager, directory, config.ConfigID)
+		if err != nil {
+			return nil, err
+		}
+
+		manifestConfig.ConfigComments = config.ConfigComments
+		manifestConfig.SysCreateDt = config.SysCreateDt
+		result.Configs = append(result.Configs, *manifestConfig)
+	}
+
+	manifestBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.MarshalIndent")
+	}
+
+	err = os.WriteFile(manifestPath, manifestBytes, filePermissions)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.WriteFile: %s", manifestPath)
+	}
+
+	return result, nil
+}
+
+/*
+The LoadManifest function reads and validates the manifest of an archive directory.
+
+Input
+  - directory: The archive directory.
+
+Output
+  - The manifest.
+*/
+func LoadManifest(directory string) (*Manifest, error) {
+	manifestPath := filepath.Join(directory, ManifestFileName)
+
+	manifestBytes, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile: %s", manifestPath)
+	}
+
+	result := &Manifest{} //exhaustruct:ignore
+
+	err = json.Unmarshal(manifestBytes, result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal: %s", manifestPath)
+	}
+
+	if result.FormatVersion < 1 || result.FormatVersion > FormatVersion {
+		return nil, wraperror.Errorf(errForPackage, "unsupported archive format version %d", result.FormatVersion)
+	}
+
+	if result.DefaultConfigID != 0 && result.find(result.DefaultConfigID) == nil {
+		return nil, wraperror.Errorf(errForPackage, "default configuration %d is not in the archive", result.DefaultConfigID)
+	}
+
+	return result, nil
+}
+
+/*
+The Restore function registers the configurations of an archive directory and sets the default configuration.
+Configurations are registered in archive order with their original comments.
+Every file is checked against its manifest checksum before anything is registered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szConfigManager: The configuration manager of the repository to restore into.
+  - directory: The archive directory.
+
+Output
+  - The mapping of archived to new configuration IDs, and the new default configuration ID.
+*/
+func Restore(ctx context.Context, szConfigManager senzing.SzConfigManager, directory string) (*RestoreResult, error) {
+	manifest, err := LoadManifest(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	configDefinitions := make([]string, len(manifest.Configs))
+
+	for index, config := range manifest.Configs {
+		configDefinitions[index], err = readConfig(directory, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &RestoreResult{
+		ConfigIDs:       make(map[int64]int64, len(manifest.Configs)),
+		DefaultConfigID: 0,
+	}
+
+	for index, config := range manifest.Configs {
+		configID, err := szConfigManager.RegisterConfig(ctx, configDefinitions[index], config.ConfigComments)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "RegisterConfig: %d", config.ConfigID)
+		}
+
+		result.ConfigIDs[config.ConfigID] = configID
+	}
+
+	if manifest.DefaultConfigID == 0 {
+		return result, nil
+	}
+
+	result.DefaultConfigID = result.ConfigIDs[manifest.DefaultConfigID]
+
+	err = szConfigManager.SetDefaultConfigID(ctx, result.DefaultConfigID)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "SetDefaultConfigID: %d", result.DefaultConfigID)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (registry *configRegistry) contains(configID int64) bool {
+	for _, config := range registry.Configs {
+		if config.ConfigID == configID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (manifest *Manifest) find(configID int64) *ManifestConfig {
+	for index := range manifest.Configs {
+		if manifest.Configs[index].ConfigID == configID {
+			return &manifest.Configs[index]
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func backupConfig(
+	ctx context.Context,
+	szConfigManager senzing.SzConfigManager,
+	directory string,
+	configID int64,
+) (*ManifestConfig, error) {
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "Export: %d", configID)
+	}
+
+	fileName := fmt.Sprintf("config-%d.json", configID)
+
+	err = os.WriteFile(filepath.Join(directory, fileName), []byte(configDefinition), filePermissions)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.WriteFile: %s", fileName)
+	}
+
+	return &ManifestConfig{
+		ConfigComments: "",
+		ConfigID:       configID,
+		File:           fileName,
+		SHA256:         checksum([]byte(configDefinition)),
+		SysCreateDt:    "",
+	}, nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func getConfigRegistry(ctx context.Context, szConfigManager senzing.SzConfigManager) (*configRegistry, error) {
+	registryJSON, err := szConfigManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetConfigRegistry")
+	}
+
+	result := &configRegistry{} //exhaustruct:ignore
+
+	err = json.Unmarshal([]byte(registryJSON), result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	return result, nil
+}
+
+func readConfig(directory string, config ManifestConfig) (string, error) {
+	if !filepath.IsLocal(config.File) {
+		return "", wraperror.Errorf(errForPackage, "configuration %d: file %q is outside the archive", config.ConfigID, config.File)
+	}
+
+	data, err := os.ReadFile(filepath.Join(directory, config.File))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", wraperror.Errorf(errForPackage, "configuration %d: missing file %s", config.ConfigID, config.File)
+		}
+
+		return "", wraperror.Errorf(err, "os.ReadFile: %s", config.File)
+	}
+
+	if checksum(data) != config.SHA256 {
+		return "", wraperror.Errorf(errForPackage, "configuration %d: checksum mismatch in %s", config.ConfigID, config.File)
+	}
+
+	return string(data), nil
+}
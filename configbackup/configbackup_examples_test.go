@@ -0,0 +1,76 @@
+package configbackup_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/configbackup"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleBackup() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configbackup/configbackup_test.go
+	ctx := context.TODO()
+	szConfigManager := getSzConfigManager(ctx)
+
+	directory, err := os.MkdirTemp("", "configbackup")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	defer func() { _ = os.RemoveAll(directory) }()
+
+	manifest, err := configbackup.Backup(ctx, szConfigManager, filepath.Join(directory, "2026-01-01"))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%d configurations, default %d\n", len(manifest.Configs), manifest.DefaultConfigID)
+	// Output: 1 configurations, default 1001
+}
+
+func ExampleRestore() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configbackup/configbackup_test.go
+	ctx := context.TODO()
+
+	directory, err := os.MkdirTemp("", "configbackup")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	defer func() { _ = os.RemoveAll(directory) }()
+
+	_, err = configbackup.Backup(ctx, getSzConfigManager(ctx), directory)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	result, err := configbackup.Restore(ctx, getRestoreSzConfigManager(ctx), directory)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("config IDs %v, default %d\n", result.ConfigIDs, result.DefaultConfigID)
+	// Output: config IDs map[1001:5001], default 5001
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzConfigManager(_ context.Context) senzing.SzConfigManager {
+	result := newFakeSzConfigManager(1000)
+	result.defaultConfigID = result.addConfig(`{"G2_CONFIG":{}}`, "initial")
+
+	return result
+}
+
+func getRestoreSzConfigManager(_ context.Context) senzing.SzConfigManager {
+	return newFakeSzConfigManager(5000)
+}
@@ -0,0 +1,317 @@
+package configbackup_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/configbackup"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBackup(test *testing.T) {
+	ctx := test.Context()
+	directory := filepath.Join(test.TempDir(), "archive")
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[]}}`, "initial")
+	source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"CUSTOMERS"}]}}`, "add CUSTOMERS")
+	source.defaultConfigID = 1002
+
+	manifest, err := configbackup.Backup(ctx, source, directory)
+	printDebug(test, err, manifest)
+	require.NoError(test, err)
+	require.Equal(test, configbackup.FormatVersion, manifest.FormatVersion)
+	require.Equal(test, int64(1002), manifest.DefaultConfigID)
+	require.Len(test, manifest.Configs, 2)
+	require.Equal(test, "add CUSTOMERS", manifest.Configs[1].ConfigComments)
+	require.FileExists(test, filepath.Join(directory, configbackup.ManifestFileName))
+
+	configBytes, err := os.ReadFile(filepath.Join(directory, manifest.Configs[1].File))
+	require.NoError(test, err)
+	require.Equal(test, source.configs[1002], string(configBytes))
+
+	loaded, err := configbackup.LoadManifest(directory)
+	require.NoError(test, err)
+	require.Equal(test, manifest.Configs, loaded.Configs)
+
+	// An existing archive is not overwritten.
+
+	_, err = configbackup.Backup(ctx, source, directory)
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestBackup_error(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{}}`, "initial")
+	source.registry = `{"CONFIGS":[{"CONFIG_ID":99}]}`
+
+	_, err := configbackup.Backup(ctx, source, directory)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	require.NoFileExists(test, filepath.Join(directory, configbackup.ManifestFileName))
+}
+
+func TestBackup_concurrentPromotion(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.defaultConfigID = source.addConfig(`{"G2_CONFIG":{}}`, "initial")
+
+	// Another process registers and promotes a configuration while the backup runs.
+
+	source.afterRegistry = func() {
+		source.afterRegistry = nil
+		source.defaultConfigID = source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[]}}`, "concurrent")
+	}
+
+	manifest, err := configbackup.Backup(ctx, source, directory)
+	printDebug(test, err, manifest)
+	require.NoError(test, err)
+	require.Equal(test, int64(1001), manifest.DefaultConfigID)
+
+	_, err = configbackup.LoadManifest(directory)
+	require.NoError(test, err)
+}
+
+func TestBackup_defaultNotRegistered(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{}}`, "initial")
+	source.defaultConfigID = 999
+
+	_, err := configbackup.Backup(ctx, source, directory)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "default configuration 999 is not in the configuration registry")
+	require.NoFileExists(test, filepath.Join(directory, configbackup.ManifestFileName))
+}
+
+func TestRestore(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[]}}`, "initial")
+	source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"CUSTOMERS"}]}}`, "add CUSTOMERS")
+	source.addConfig(`{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"WATCHLIST"}]}}`, "add WATCHLIST")
+	source.defaultConfigID = 1002
+
+	_, err := configbackup.Backup(ctx, source, directory)
+	require.NoError(test, err)
+
+	target := newFakeSzConfigManager(5000)
+
+	result, err := configbackup.Restore(ctx, target, directory)
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, map[int64]int64{1001: 5001, 1002: 5002, 1003: 5003}, result.ConfigIDs)
+	require.Equal(test, int64(5002), result.DefaultConfigID)
+	require.Equal(test, int64(5002), target.defaultConfigID)
+	require.Equal(test, source.configs[1003], target.configs[5003])
+	require.Equal(test, "add WATCHLIST", target.comments[5003])
+}
+
+func TestRestore_noDefault(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{}}`, "initial")
+
+	_, err := configbackup.Backup(ctx, source, directory)
+	require.NoError(test, err)
+
+	target := newFakeSzConfigManager(5000)
+	target.defaultConfigID = -1
+
+	result, err := configbackup.Restore(ctx, target, directory)
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, int64(0), result.DefaultConfigID)
+	require.Equal(test, int64(-1), target.defaultConfigID)
+}
+
+func TestRestore_checksumMismatch(test *testing.T) {
+	ctx := test.Context()
+	directory := test.TempDir()
+	source := newFakeSzConfigManager(1000)
+	source.addConfig(`{"G2_CONFIG":{}}`, "initial")
+	source.defaultConfigID = 1001
+
+	manifest, err := configbackup.Backup(ctx, source, directory)
+	require.NoError(test, err)
+	require.NoError(test, os.WriteFile(filepath.Join(directory, manifest.Configs[0].File), []byte("{}"), 0o600))
+
+	target := newFakeSzConfigManager(5000)
+
+	_, err = configbackup.Restore(ctx, target, directory)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "checksum mismatch")
+	require.Empty(test, target.configs)
+}
+
+func TestLoadManifest_badInput(test *testing.T) {
+	tests := map[string]configbackup.Manifest{
+		"future version": { //exhaustruct:ignore
+			FormatVersion: configbackup.FormatVersion + 1,
+		},
+		"missing default": { //exhaustruct:ignore
+			DefaultConfigID: 1001,
+			FormatVersion:   configbackup.FormatVersion,
+		},
+	}
+
+	for name, manifest := range tests {
+		test.Run(name, func(test *testing.T) {
+			directory := test.TempDir()
+			manifestBytes, err := json.Marshal(manifest)
+			require.NoError(test, err)
+			require.NoError(test, os.WriteFile(filepath.Join(directory, configbackup.ManifestFileName), manifestBytes, 0o600))
+
+			_, err = configbackup.LoadManifest(directory)
+			printDebug(test, err)
+			require.Error(test, err)
+		})
+	}
+
+	_, err := configbackup.LoadManifest(test.TempDir())
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	afterRegistry   func()
+	comments        map[int64]string
+	configIDs       []int64
+	configs         map[int64]string
+	defaultConfigID int64
+	nextConfigID    int64
+	registry        string
+}
+
+func newFakeSzConfigManager(firstConfigID int64) *fakeSzConfigManager {
+	return &fakeSzConfigManager{ //exhaustruct:ignore
+		comments:     map[int64]string{},
+		configs:      map[int64]string{},
+		nextConfigID: firstConfigID + 1,
+	}
+}
+
+func (manager *fakeSzConfigManager) CreateConfigFromConfigID(
+	_ context.Context,
+	configID int64,
+) (senzing.SzConfig, error) {
+	configDefinition, isOK := manager.configs[configID]
+	if !isOK {
+		return nil, szerror.New(
+			7221,
+			fmt.Sprintf(`{"reason":"SENZ7221|No engine configuration registered with data ID [%d]."}`, configID),
+		)
+	}
+
+	return &fakeSzConfig{configDefinition: configDefinition}, nil //exhaustruct:ignore
+}
+
+func (manager *fakeSzConfigManager) GetConfigRegistry(_ context.Context) (string, error) {
+	if manager.afterRegistry != nil {
+		defer manager.afterRegistry()
+	}
+
+	if len(manager.registry) > 0 {
+		return manager.registry, nil
+	}
+
+	result := `{"CONFIGS":[`
+
+	for index, configID := range manager.configIDs {
+		if index > 0 {
+			result += ","
+		}
+
+		result += fmt.Sprintf(
+			`{"CONFIG_COMMENTS":%q,"CONFIG_ID":%d,"SYS_CREATE_DT":"2026-01-0%dT00:00:00Z"}`,
+			manager.comments[configID],
+			configID,
+			index+1,
+		)
+	}
+
+	return result + "]}", nil
+}
+
+func (manager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	return manager.defaultConfigID, nil
+}
+
+func (manager *fakeSzConfigManager) RegisterConfig(
+	_ context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	return manager.addConfig(configDefinition, configComment), nil
+}
+
+func (manager *fakeSzConfigManager) SetDefaultConfigID(_ context.Context, configID int64) error {
+	manager.defaultConfigID = configID
+
+	return nil
+}
+
+func (manager *fakeSzConfigManager) addConfig(configDefinition string, configComment string) int64 {
+	configID := manager.nextConfigID
+	manager.nextConfigID++
+	manager.configIDs = append(manager.configIDs, configID)
+	manager.configs[configID] = configDefinition
+	manager.comments[configID] = configComment
+
+	return configID
+}
+
+type fakeSzConfig struct {
+	senzing.SzConfig
+
+	configDefinition string
+}
+
+func (config *fakeSzConfig) Export(_ context.Context) (string, error) {
+	return config.configDefinition, nil
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
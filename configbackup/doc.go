@@ -0,0 +1,19 @@
+/*
+Package configbackup backs up and restores the Senzing configurations registered in a repository.
+
+[Backup] writes every configuration returned by SzConfigManager.GetConfigRegistry into an archive directory,
+one file per configuration, plus a [Manifest] recording the archive format version, the default configuration ID,
+and the comment and creation time of each configuration.
+The manifest is written last, so a directory without a manifest is an incomplete backup.
+
+[Restore] registers the archived configurations in another (or the same) repository with SzConfigManager.RegisterConfig,
+sets the default configuration with SzConfigManager.SetDefaultConfigID,
+and reports how the archived configuration IDs map to the newly registered ones.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package configbackup
@@ -0,0 +1,31 @@
+package configbackup
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+FormatVersion is the version of the archive layout written by Backup.
+Restore accepts archives up to this version.
+*/
+const FormatVersion = 1
+
+/*
+ManifestFileName is the name of the manifest within an archive directory.
+*/
+const ManifestFileName = "manifest.json"
+
+const (
+	directoryPermissions = 0o750
+	filePermissions      = 0o600
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("configbackup")
@@ -0,0 +1,502 @@
+package configdiff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Diff is the difference between two Senzing configurations.
+Only sections with differences are listed, sorted by section name.
+*/
+type Diff struct {
+	Sections []SectionDiff
+}
+
+/*
+SectionDiff is the difference within one configuration section, such as "CFG_DSRC".
+Sections that are JSON objects rather than lists, such as "SETTINGS", report a single changed row with an empty key.
+*/
+type SectionDiff struct {
+	Added   []Row
+	Changed []RowChange
+	Removed []Row
+	Section string
+	Title   string
+}
+
+/*
+Row is a row of a configuration section, identified by its key.
+*/
+type Row struct {
+	Key    string
+	Values map[string]any
+}
+
+/*
+RowChange lists the fields that differ in a row present in both configurations.
+*/
+type RowChange struct {
+	Fields []FieldChange
+	Key    string
+}
+
+/*
+FieldChange is a changed field. Old or New is nil when the field is missing from that configuration.
+*/
+type FieldChange struct {
+	Field string
+	New   any
+	Old   any
+}
+
+/*
+Differ compares configurations registered in the Senzing repository.
+*/
+type Differ struct {
+	SzConfigManager senzing.SzConfigManager
+}
+
+type configDocument struct {
+	G2Config map[string]json.RawMessage `json:"G2_CONFIG"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compare function compares two Senzing configuration JSON documents.
+
+Input
+  - oldConfigDefinition: The configuration to compare from, as returned by SzConfig.Export.
+  - newConfigDefinition: The configuration to compare to, as returned by SzConfig.Export.
+
+Output
+  - The differences.
+*/
+func Compare(oldConfigDefinition string, newConfigDefinition string) (*Diff, error) {
+	oldSections, err := parseConfig(oldConfigDefinition)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "old configuration")
+	}
+
+	newSections, err := parseConfig(newConfigDefinition)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "new configuration")
+	}
+
+	sectionNames := map[string]bool{}
+	for name := range oldSections {
+		sectionNames[name] = true
+	}
+
+	for name := range newSections {
+		sectionNames[name] = true
+	}
+
+	oldFeatures := featureCodes(oldSections)
+	newFeatures := featureCodes(newSections)
+	result := &Diff{Sections: []SectionDiff{}}
+
+	for _, name := range sortedKeys(sectionNames) {
+		sectionDiff := compareSection(name, oldSections[name], newSections[name])
+		if sectionDiff.isEmpty() {
+			continue
+		}
+
+		labelRows(sectionDiff.Removed, oldFeatures)
+		labelRows(sectionDiff.Added, newFeatures)
+		result.Sections = append(result.Sections, sectionDiff)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Differ methods
+// ----------------------------------------------------------------------------
+
+/*
+Method CompareConfigIDs compares two configurations registered in the Senzing repository.
+
+Input
+  - ctx: A context to control lifecycle.
+  - oldConfigID: The configuration to compare from.
+  - newConfigID: The configuration to compare to.
+
+Output
+  - The differences.
+*/
+func (differ *Differ) CompareConfigIDs(ctx context.Context, oldConfigID int64, newConfigID int64) (*Diff, error) {
+	oldConfigDefinition, err := differ.export(ctx, oldConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	newConfigDefinition, err := differ.export(ctx, newConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Compare(oldConfigDefinition, newConfigDefinition)
+}
+
+/*
+Method CompareWithDefault compares a registered configuration with the current default configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The configuration to compare to.
+
+Output
+  - The differences from the default configuration to configID.
+*/
+func (differ *Differ) CompareWithDefault(ctx context.Context, configID int64) (*Diff, error) {
+	defaultConfigID, err := differ.SzConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	return differ.CompareConfigIDs(ctx, defaultConfigID, configID)
+}
+
+// ----------------------------------------------------------------------------
+// Diff methods
+// ----------------------------------------------------------------------------
+
+/*
+Method IsEmpty reports whether the configurations are equivalent.
+
+Output
+  - True if no section differs.
+*/
+func (diff *Diff) IsEmpty() bool {
+	return len(diff.Sections) == 0
+}
+
+/*
+Method Section returns the difference for a section.
+
+Input
+  - section: A section name, such as "CFG_DSRC".
+
+Output
+  - The difference, or nil if the section does not differ.
+*/
+func (diff *Diff) Section(section string) *SectionDiff {
+	for index := range diff.Sections {
+		if diff.Sections[index].Section == section {
+			return &diff.Sections[index]
+		}
+	}
+
+	return nil
+}
+
+/*
+Method String renders the difference as text.
+Added rows are marked "+", removed rows "-", and changed rows "~" followed by their changed fields.
+
+Output
+  - A multi-line, human-readable description.
+*/
+func (diff *Diff) String() string {
+	if diff.IsEmpty() {
+		return "No differences.\n"
+	}
+
+	var builder strings.Builder
+
+	for index, section := range diff.Sections {
+		if index > 0 {
+			builder.WriteString("\n")
+		}
+
+		fmt.Fprintf(&builder, "%s (%s): %d added, %d removed, %d changed\n",
+			section.Title, section.Section, len(section.Added), len(section.Removed), len(section.Changed))
+
+		for _, row := range section.Added {
+			fmt.Fprintf(&builder, "  + %s\n", row.Key)
+		}
+
+		for _, row := range section.Removed {
+			fmt.Fprintf(&builder, "  - %s\n", row.Key)
+		}
+
+		for _, change := range section.Changed {
+			if len(change.Key) > 0 {
+				fmt.Fprintf(&builder, "  ~ %s\n", change.Key)
+			}
+
+			for _, field := range change.Fields {
+				fmt.Fprintf(&builder, "      %s: %s -> %s\n", field.Field, formatValue(field.Old), formatValue(field.New))
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (differ *Differ) export(ctx context.Context, configID int64) (string, error) {
+	szConfig, err := differ.SzConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
+
+	result, err := szConfig.Export(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "Export: %d", configID)
+	}
+
+	return result, nil
+}
+
+func (sectionDiff *SectionDiff) isEmpty() bool {
+	return len(sectionDiff.Added) == 0 && len(sectionDiff.Removed) == 0 && len(sectionDiff.Changed) == 0
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func compareFields(oldValues map[string]any, newValues map[string]any, prefix string) []FieldChange {
+	result := []FieldChange{}
+	fieldNames := map[string]bool{}
+
+	for name := range oldValues {
+		fieldNames[name] = true
+	}
+
+	for name := range newValues {
+		fieldNames[name] = true
+	}
+
+	for _, name := range sortedKeys(fieldNames) {
+		oldValue, newValue := oldValues[name], newValues[name]
+
+		oldObject, oldIsObject := oldValue.(map[string]any)
+		newObject, newIsObject := newValue.(map[string]any)
+
+		if oldIsObject && newIsObject {
+			result = append(result, compareFields(oldObject, newObject, prefix+name+".")...)
+
+			continue
+		}
+
+		if !reflect.DeepEqual(oldValue, newValue) {
+			result = append(result, FieldChange{Field: prefix + name, New: newValue, Old: oldValue})
+		}
+	}
+
+	return result
+}
+
+func compareSection(name string, oldSection json.RawMessage, newSection json.RawMessage) SectionDiff {
+	result := SectionDiff{
+		Added:   []Row{},
+		Changed: []RowChange{},
+		Removed: []Row{},
+		Section: name,
+		Title:   sectionTitle(name),
+	}
+
+	oldValue, newValue := decode(oldSection), decode(newSection)
+
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+
+	if oldIsObject || newIsObject {
+		if !oldIsObject {
+			oldObject = map[string]any{}
+		}
+
+		if !newIsObject {
+			newObject = map[string]any{}
+		}
+
+		fields := compareFields(oldObject, newObject, "")
+		if len(fields) > 0 {
+			result.Changed = append(result.Changed, RowChange{Fields: fields, Key: ""})
+		}
+
+		return result
+	}
+
+	oldRows := indexRows(name, oldValue)
+	newRows := indexRows(name, newValue)
+
+	for _, key := range sortedKeys(newRows) {
+		oldRow, isOK := oldRows[key]
+		if !isOK {
+			result.Added = append(result.Added, Row{Key: key, Values: newRows[key]})
+
+			continue
+		}
+
+		fields := compareFields(oldRow, newRows[key], "")
+		if len(fields) > 0 {
+			result.Changed = append(result.Changed, RowChange{Fields: fields, Key: key})
+		}
+	}
+
+	for _, key := range sortedKeys(oldRows) {
+		_, isOK := newRows[key]
+		if !isOK {
+			result.Removed = append(result.Removed, Row{Key: key, Values: oldRows[key]})
+		}
+	}
+
+	return result
+}
+
+func decode(raw json.RawMessage) any {
+	var result any
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	_ = decoder.Decode(&result) // Already validated by parseConfig.
+
+	return result
+}
+
+func featureCodes(sections map[string]json.RawMessage) map[string]string {
+	result := map[string]string{}
+
+	rows, _ := decode(sections["CFG_FTYPE"]).([]any)
+	for _, row := range rows {
+		values, _ := row.(map[string]any)
+		code, _ := values["FTYPE_CODE"].(string)
+		result[fmt.Sprint(values["FTYPE_ID"])] = code
+	}
+
+	return result
+}
+
+func formatValue(value any) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(result)
+}
+
+func indexRows(section string, value any) map[string]map[string]any {
+	result := map[string]map[string]any{}
+	rows, _ := value.([]any)
+	keyFields := sectionKeys[section]
+
+	for _, row := range rows {
+		values, isOK := row.(map[string]any)
+		if !isOK {
+			values = map[string]any{"VALUE": row}
+		}
+
+		result[rowKey(keyFields, values)] = values
+	}
+
+	return result
+}
+
+/*
+Append the feature code to keys of removed and added rows that refer to a feature by FTYPE_ID,
+so that "CFRTN_ID=12" reads "CFRTN_ID=12 [NAME]".
+*/
+func labelRows(rows []Row, features map[string]string) {
+	for index := range rows {
+		ftypeID, isOK := rows[index].Values["FTYPE_ID"]
+		if !isOK {
+			continue
+		}
+
+		code := features[fmt.Sprint(ftypeID)]
+		if len(code) > 0 && !strings.Contains(rows[index].Key, code) {
+			rows[index].Key += " [" + code + "]"
+		}
+	}
+}
+
+func parseConfig(configDefinition string) (map[string]json.RawMessage, error) {
+	document := configDocument{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(configDefinition), &document)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	if document.G2Config == nil {
+		return nil, wraperror.Errorf(errForPackage, "missing G2_CONFIG")
+	}
+
+	return document.G2Config, nil
+}
+
+func rowKey(keyFields []string, values map[string]any) string {
+	parts := make([]string, 0, len(keyFields))
+
+	for _, field := range keyFields {
+		value, isOK := values[field]
+		if !isOK {
+			parts = nil
+
+			break
+		}
+
+		if len(keyFields) == 1 && strings.HasSuffix(field, "_CODE") {
+			parts = append(parts, fmt.Sprint(value))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s=%v", field, value))
+		}
+	}
+
+	if len(parts) == 0 {
+		return formatValue(values)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func sectionTitle(section string) string {
+	result, isOK := sectionTitles[section]
+	if !isOK {
+		return section
+	}
+
+	return result
+}
+
+func sortedKeys[V any](aMap map[string]V) []string {
+	result := make([]string, 0, len(aMap))
+	for key := range aMap {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
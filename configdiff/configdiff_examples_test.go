@@ -0,0 +1,45 @@
+package configdiff_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/configdiff"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleCompare() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configdiff/configdiff_test.go
+	oldConfigDefinition := `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST"}]}}`
+	newConfigDefinition := `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST"}, {"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"}]}}`
+
+	diff, err := configdiff.Compare(oldConfigDefinition, newConfigDefinition)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(diff)
+	// Output:
+	// Data sources (CFG_DSRC): 1 added, 0 removed, 0 changed
+	//   + CUSTOMERS
+}
+
+func ExampleDiff_Section() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configdiff/configdiff_test.go
+	oldConfigDefinition := `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST", "DSRC_DESC": "Test"}]}}`
+	newConfigDefinition := `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST", "DSRC_DESC": "Testing"}]}}`
+
+	diff, err := configdiff.Compare(oldConfigDefinition, newConfigDefinition)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	for _, change := range diff.Section("CFG_DSRC").Changed {
+		for _, field := range change.Fields {
+			fmt.Printf("%s %s: %v -> %v\n", change.Key, field.Field, field.Old, field.New)
+		}
+	}
+	// Output: TEST DSRC_DESC: Test -> Testing
+}
@@ -0,0 +1,220 @@
+package configdiff_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/configdiff"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCompare(test *testing.T) {
+	diff, err := configdiff.Compare(oldConfig, newConfig)
+	printDebug(test, err, diff)
+	require.NoError(test, err)
+	require.False(test, diff.IsEmpty())
+
+	dataSources := diff.Section("CFG_DSRC")
+	require.NotNil(test, dataSources)
+	require.Equal(test, "Data sources", dataSources.Title)
+	require.Len(test, dataSources.Added, 1)
+	require.Equal(test, "CUSTOMERS", dataSources.Added[0].Key)
+	require.Len(test, dataSources.Removed, 1)
+	require.Equal(test, "OLD", dataSources.Removed[0].Key)
+	require.Empty(test, dataSources.Changed)
+
+	thresholds := diff.Section("CFG_CFRTN")
+	require.NotNil(test, thresholds)
+	require.Len(test, thresholds.Changed, 1)
+	require.Equal(test, "CFRTN_ID=1", thresholds.Changed[0].Key)
+	require.Equal(test, "CLOSE_SCORE", thresholds.Changed[0].Fields[0].Field)
+	require.Equal(test, "90", fmt.Sprint(thresholds.Changed[0].Fields[0].Old))
+	require.Equal(test, "92", fmt.Sprint(thresholds.Changed[0].Fields[0].New))
+	require.Len(test, thresholds.Added, 1)
+	require.Equal(test, "CFRTN_ID=2 [PHONE]", thresholds.Added[0].Key)
+
+	settings := diff.Section("SETTINGS")
+	require.NotNil(test, settings)
+	require.Len(test, settings.Changed, 1)
+	require.Equal(test, "METAPHONE_VERSION", settings.Changed[0].Fields[0].Field)
+
+	require.Nil(test, diff.Section("CFG_FTYPE"))
+}
+
+func TestCompare_identical(test *testing.T) {
+	diff, err := configdiff.Compare(oldConfig, oldConfig)
+	printDebug(test, err, diff)
+	require.NoError(test, err)
+	require.True(test, diff.IsEmpty())
+	require.Equal(test, "No differences.\n", diff.String())
+}
+
+func TestCompare_missingSection(test *testing.T) {
+	diff, err := configdiff.Compare(`{"G2_CONFIG":{}}`, `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"}]}}`)
+	printDebug(test, err, diff)
+	require.NoError(test, err)
+	require.Len(test, diff.Section("CFG_DSRC").Added, 1)
+}
+
+func TestCompare_badInput(test *testing.T) {
+	_, err := configdiff.Compare("{", newConfig)
+	printDebug(test, err)
+	require.Error(test, err)
+
+	_, err = configdiff.Compare(oldConfig, `{"CFG_DSRC":[]}`)
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestDiff_String(test *testing.T) {
+	diff, err := configdiff.Compare(oldConfig, newConfig)
+	require.NoError(test, err)
+
+	actual := diff.String()
+	printDebug(test, nil, actual)
+	require.Contains(test, actual, "Data sources (CFG_DSRC): 1 added, 1 removed, 0 changed\n")
+	require.Contains(test, actual, "  + CUSTOMERS\n")
+	require.Contains(test, actual, "  - OLD\n")
+	require.Contains(test, actual, "  ~ CFRTN_ID=1\n      CLOSE_SCORE: 90 -> 92\n")
+	require.Contains(test, actual, "      METAPHONE_VERSION: 2 -> 3\n")
+}
+
+func TestDiffer_CompareConfigIDs(test *testing.T) {
+	ctx := test.Context()
+	differ := &configdiff.Differ{SzConfigManager: newFakeSzConfigManager()}
+
+	diff, err := differ.CompareConfigIDs(ctx, 1, 2)
+	printDebug(test, err, diff)
+	require.NoError(test, err)
+	require.NotNil(test, diff.Section("CFG_DSRC"))
+
+	_, err = differ.CompareConfigIDs(ctx, 1, 99)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+}
+
+func TestDiffer_CompareWithDefault(test *testing.T) {
+	ctx := test.Context()
+	differ := &configdiff.Differ{SzConfigManager: newFakeSzConfigManager()}
+
+	diff, err := differ.CompareWithDefault(ctx, 1)
+	printDebug(test, err, diff)
+	require.NoError(test, err)
+	require.Len(test, diff.Section("CFG_DSRC").Removed, 1)
+	require.Equal(test, "CUSTOMERS", diff.Section("CFG_DSRC").Removed[0].Key)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	configs map[int64]string
+}
+
+func newFakeSzConfigManager() *fakeSzConfigManager {
+	return &fakeSzConfigManager{ //exhaustruct:ignore
+		configs: map[int64]string{1: oldConfig, 2: newConfig},
+	}
+}
+
+func (manager *fakeSzConfigManager) CreateConfigFromConfigID(
+	_ context.Context,
+	configID int64,
+) (senzing.SzConfig, error) {
+	configDefinition, isOK := manager.configs[configID]
+	if !isOK {
+		return nil, szerror.New(
+			7221,
+			fmt.Sprintf(`{"reason":"SENZ7221|No engine configuration registered with data ID [%d]."}`, configID),
+		)
+	}
+
+	return &fakeSzConfig{configDefinition: configDefinition}, nil //exhaustruct:ignore
+}
+
+func (manager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	return 2, nil
+}
+
+type fakeSzConfig struct {
+	senzing.SzConfig
+
+	configDefinition string
+}
+
+func (config *fakeSzConfig) Export(_ context.Context) (string, error) {
+	return config.configDefinition, nil
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test data
+// ----------------------------------------------------------------------------
+
+const oldConfig = `{
+  "G2_CONFIG": {
+    "CFG_DSRC": [
+      {"DSRC_ID": 1, "DSRC_CODE": "TEST", "DSRC_DESC": "Test"},
+      {"DSRC_ID": 1001, "DSRC_CODE": "OLD", "DSRC_DESC": "Old"}
+    ],
+    "CFG_FTYPE": [
+      {"FTYPE_ID": 1, "FTYPE_CODE": "NAME"},
+      {"FTYPE_ID": 2, "FTYPE_CODE": "PHONE"}
+    ],
+    "CFG_CFRTN": [
+      {"CFRTN_ID": 1, "FTYPE_ID": 1, "SAME_SCORE": 100, "CLOSE_SCORE": 90}
+    ],
+    "SETTINGS": {"METAPHONE_VERSION": 2}
+  }
+}`
+
+const newConfig = `{
+  "G2_CONFIG": {
+    "CFG_DSRC": [
+      {"DSRC_ID": 1, "DSRC_CODE": "TEST", "DSRC_DESC": "Test"},
+      {"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS", "DSRC_DESC": "Customers"}
+    ],
+    "CFG_FTYPE": [
+      {"FTYPE_ID": 1, "FTYPE_CODE": "NAME"},
+      {"FTYPE_ID": 2, "FTYPE_CODE": "PHONE"}
+    ],
+    "CFG_CFRTN": [
+      {"CFRTN_ID": 1, "FTYPE_ID": 1, "SAME_SCORE": 100, "CLOSE_SCORE": 92},
+      {"CFRTN_ID": 2, "FTYPE_ID": 2, "SAME_SCORE": 100, "CLOSE_SCORE": 80}
+    ],
+    "SETTINGS": {"METAPHONE_VERSION": 3}
+  }
+}`
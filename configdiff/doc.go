@@ -0,0 +1,16 @@
+/*
+Package configdiff reports the differences between two Senzing configurations.
+
+Configurations can be compared by configuration ID, using an SzConfigManager,
+or as JSON documents returned by SzConfig.Export.
+Each section of the configuration (data sources, features, attributes, comparison thresholds, rules, ...)
+is compared row by row; rows are matched by their natural key, such as DSRC_CODE for data sources.
+The result is a structured [Diff] that can also be rendered as text.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package configdiff
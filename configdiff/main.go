@@ -0,0 +1,79 @@
+package configdiff
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("configdiff")
+
+/*
+Fields that identify a row within each configuration section.
+Sections not listed are compared by whole rows.
+*/
+var sectionKeys = map[string][]string{
+	"CFG_ATTR":              {"ATTR_CODE"},
+	"CFG_CFBOM":             {"CFCALL_ID", "FELEM_ID"},
+	"CFG_CFCALL":            {"CFCALL_ID"},
+	"CFG_CFRTN":             {"CFRTN_ID"},
+	"CFG_CFUNC":             {"CFUNC_CODE"},
+	"CFG_DFBOM":             {"DFCALL_ID", "FELEM_ID"},
+	"CFG_DFCALL":            {"DFCALL_ID"},
+	"CFG_DFUNC":             {"DFUNC_CODE"},
+	"CFG_DSRC":              {"DSRC_CODE"},
+	"CFG_EFBOM":             {"EFCALL_ID", "FELEM_ID"},
+	"CFG_EFCALL":            {"EFCALL_ID"},
+	"CFG_EFUNC":             {"EFUNC_CODE"},
+	"CFG_ERFRAG":            {"ERFRAG_CODE"},
+	"CFG_ERRULE":            {"ERRULE_CODE"},
+	"CFG_FBOM":              {"FTYPE_ID", "FELEM_ID"},
+	"CFG_FBOVR":             {"FTYPE_ID", "UTYPE_CODE"},
+	"CFG_FCLASS":            {"FCLASS_CODE"},
+	"CFG_FELEM":             {"FELEM_CODE"},
+	"CFG_FTYPE":             {"FTYPE_CODE"},
+	"CFG_GENERIC_THRESHOLD": {"GPLAN_ID", "BEHAVIOR", "FTYPE_ID"},
+	"CFG_GPLAN":             {"GPLAN_CODE"},
+	"CFG_RCLASS":            {"RCLASS_CODE"},
+	"CFG_RTYPE":             {"RTYPE_CODE"},
+	"CFG_SFCALL":            {"SFCALL_ID"},
+	"CFG_SFUNC":             {"SFUNC_CODE"},
+	"SYS_OOM":               {"FTYPE_ID", "OOM_TYPE", "OOM_LEVEL"},
+}
+
+/*
+Human-readable names of configuration sections.
+*/
+var sectionTitles = map[string]string{
+	"CFG_ATTR":              "Attributes",
+	"CFG_CFBOM":             "Comparison function elements",
+	"CFG_CFCALL":            "Comparison calls",
+	"CFG_CFRTN":             "Comparison thresholds",
+	"CFG_CFUNC":             "Comparison functions",
+	"CFG_DFBOM":             "Distinct function elements",
+	"CFG_DFCALL":            "Distinct calls",
+	"CFG_DFUNC":             "Distinct functions",
+	"CFG_DSRC":              "Data sources",
+	"CFG_DSRC_INTEREST":     "Data source interest",
+	"CFG_EFBOM":             "Expression function elements",
+	"CFG_EFCALL":            "Expression calls",
+	"CFG_EFUNC":             "Expression functions",
+	"CFG_ERFRAG":            "Rule fragments",
+	"CFG_ERRULE":            "Resolution rules",
+	"CFG_FBOM":              "Feature elements",
+	"CFG_FBOVR":             "Feature behavior overrides",
+	"CFG_FCLASS":            "Feature classes",
+	"CFG_FELEM":             "Elements",
+	"CFG_FTYPE":             "Features",
+	"CFG_GENERIC_THRESHOLD": "Generic thresholds",
+	"CFG_GPLAN":             "Generic plans",
+	"CFG_RCLASS":            "Relationship classes",
+	"CFG_RTYPE":             "Relationship types",
+	"CFG_SFCALL":            "Standardization calls",
+	"CFG_SFUNC":             "Standardization functions",
+	"CONFIG_BASE_VERSION":   "Base version",
+	"SETTINGS":              "Settings",
+	"SYS_OOM":               "Out-of-memory thresholds",
+}
@@ -0,0 +1,352 @@
+package configdocument
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+ConfigVerifier verifies a Senzing configuration JSON document.
+It is satisfied by the szconfig.Szconfig type of this module.
+*/
+type ConfigVerifier interface {
+	VerifyConfigDefinition(ctx context.Context, configDefinition string) error
+}
+
+/*
+DataSource is a row of the CFG_DSRC section.
+*/
+type DataSource struct {
+	DsrcCode string `json:"DSRC_CODE"`
+	DsrcID   int64  `json:"DSRC_ID"`
+}
+
+/*
+Document is a Senzing configuration JSON document held in memory.
+A Document is not safe for concurrent use.
+*/
+type Document struct {
+	dataSources []map[string]any           // Decoded CFG_DSRC rows, in document order.
+	root        map[string]json.RawMessage // Top-level keys other than G2_CONFIG.
+	sections    map[string]json.RawMessage // G2_CONFIG sections other than CFG_DSRC.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Parse function reads a Senzing configuration JSON document, such as the result of SzConfig.Export.
+
+Input
+  - configDefinition: The Senzing configuration JSON document.
+
+Output
+  - The document.
+*/
+func Parse(configDefinition string) (*Document, error) {
+	result := &Document{
+		dataSources: []map[string]any{},
+		root:        map[string]json.RawMessage{},
+		sections:    map[string]json.RawMessage{},
+	}
+
+	err := json.Unmarshal([]byte(configDefinition), &result.root)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	g2Config, isOK := result.root[rootKey]
+	if !isOK {
+		return nil, wraperror.Errorf(errForPackage, "missing %s", rootKey)
+	}
+
+	delete(result.root, rootKey)
+
+	err = json.Unmarshal(g2Config, &result.sections)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal: %s", rootKey)
+	}
+
+	dataSources, isOK := result.sections[dataSourceSection]
+	if isOK {
+		delete(result.sections, dataSourceSection)
+
+		decoder := json.NewDecoder(bytes.NewReader(dataSources))
+		decoder.UseNumber()
+
+		err = decoder.Decode(&result.dataSources)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "json.Decode: %s", dataSourceSection)
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Document methods
+// ----------------------------------------------------------------------------
+
+/*
+Method DataSources lists the data sources of the document.
+
+Output
+  - The data sources, in document order.
+*/
+func (document *Document) DataSources() []DataSource {
+	result := make([]DataSource, 0, len(document.dataSources))
+
+	for _, row := range document.dataSources {
+		result = append(result, DataSource{
+			DsrcCode: stringValue(row["DSRC_CODE"]),
+			DsrcID:   int64Value(row["DSRC_ID"]),
+		})
+	}
+
+	return result
+}
+
+/*
+Method Export returns the document as a Senzing configuration JSON document.
+
+Output
+  - The Senzing configuration JSON document.
+*/
+func (document *Document) Export() (string, error) {
+	sections := make(map[string]any, len(document.sections)+1)
+	for name, section := range document.sections {
+		sections[name] = section
+	}
+
+	sections[dataSourceSection] = document.dataSources
+
+	root := make(map[string]any, len(document.root)+1)
+	for name, value := range document.root {
+		root[name] = value
+	}
+
+	root[rootKey] = sections
+
+	result, err := json.Marshal(root)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+/*
+Method GetDataSourceRegistry returns the data sources in the format of SzConfig.GetDataSourceRegistry.
+
+Output
+  - A JSON document listing the data sources.
+*/
+func (document *Document) GetDataSourceRegistry() (string, error) {
+	registry := struct {
+		DataSources []DataSource `json:"DATA_SOURCES"`
+	}{
+		DataSources: document.DataSources(),
+	}
+
+	result, err := json.Marshal(registry)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+/*
+Method RegisterDataSource adds a data source to the document.
+The code is upper-cased; the new DSRC_ID is one more than the largest in use, and at least MinUserDataSourceID.
+
+Input
+  - dataSourceCode: Unique identifier of the data source (e.g. "TEST_DATASOURCE").
+
+Output
+  - A JSON document in the format of SzConfig.RegisterDataSource, e.g. {"DSRC_ID":1001}.
+*/
+func (document *Document) RegisterDataSource(dataSourceCode string) (string, error) {
+	code, err := normalizeCode(dataSourceCode)
+	if err != nil {
+		return "", err
+	}
+
+	if document.findDataSource(code) >= 0 {
+		return "", wraperror.Errorf(errForPackage, "data source %s is already registered", code)
+	}
+
+	dsrcID := int64(MinUserDataSourceID)
+	for _, dataSource := range document.DataSources() {
+		dsrcID = max(dsrcID, dataSource.DsrcID+1)
+	}
+
+	document.dataSources = append(document.dataSources, map[string]any{
+		"DSRC_CODE":       code,
+		"DSRC_DESC":       code,
+		"DSRC_ID":         dsrcID,
+		"RETENTION_LEVEL": DefaultRetentionLevel,
+	})
+
+	return fmt.Sprintf(`{"DSRC_ID":%d}`, dsrcID), nil
+}
+
+/*
+Method Section returns a section of the configuration, such as "CFG_FTYPE".
+
+Input
+  - name: The section name.
+
+Output
+  - The section as JSON, and whether the section exists.
+*/
+func (document *Document) Section(name string) (json.RawMessage, bool) {
+	if name == dataSourceSection {
+		result, err := json.Marshal(document.dataSources)
+
+		return result, err == nil
+	}
+
+	result, isOK := document.sections[name]
+
+	return result, isOK
+}
+
+/*
+Method SectionNames lists the sections of the configuration.
+
+Output
+  - The section names, sorted.
+*/
+func (document *Document) SectionNames() []string {
+	result := make([]string, 0, len(document.sections)+1)
+	for name := range document.sections {
+		result = append(result, name)
+	}
+
+	result = append(result, dataSourceSection)
+	sort.Strings(result)
+
+	return result
+}
+
+/*
+Method UnmarshalSection decodes a section of the configuration into a Go value.
+
+Input
+  - name: The section name, such as "CFG_FTYPE".
+  - value: A pointer to the value to fill, as for json.Unmarshal.
+*/
+func (document *Document) UnmarshalSection(name string, value any) error {
+	section, isOK := document.Section(name)
+	if !isOK {
+		return wraperror.Errorf(errForPackage, "no section %s", name)
+	}
+
+	err := json.Unmarshal(section, value)
+
+	return wraperror.Errorf(err, "json.Unmarshal: %s", name)
+}
+
+/*
+Method UnregisterDataSource removes a data source from the document.
+
+Input
+  - dataSourceCode: Unique identifier of the data source (e.g. "TEST_DATASOURCE").
+*/
+func (document *Document) UnregisterDataSource(dataSourceCode string) error {
+	code, err := normalizeCode(dataSourceCode)
+	if err != nil {
+		return err
+	}
+
+	index := document.findDataSource(code)
+	if index < 0 {
+		return wraperror.Errorf(errForPackage, "data source %s is not registered", code)
+	}
+
+	document.dataSources = append(document.dataSources[:index], document.dataSources[index+1:]...)
+
+	return nil
+}
+
+/*
+Method Verify exports the document and checks it with Senzing.
+
+Input
+  - ctx: A context to control lifecycle.
+  - verifier: Usually an szconfig.Szconfig.
+
+Output
+  - The verified Senzing configuration JSON document, ready for SzConfigManager.RegisterConfig.
+*/
+func (document *Document) Verify(ctx context.Context, verifier ConfigVerifier) (string, error) {
+	result, err := document.Export()
+	if err != nil {
+		return "", err
+	}
+
+	err = verifier.VerifyConfigDefinition(ctx, result)
+	if err != nil {
+		return "", wraperror.Errorf(err, "VerifyConfigDefinition")
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (document *Document) findDataSource(code string) int {
+	for index, row := range document.dataSources {
+		if strings.EqualFold(stringValue(row["DSRC_CODE"]), code) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func int64Value(value any) int64 {
+	switch typedValue := value.(type) {
+	case json.Number:
+		result, _ := typedValue.Int64()
+
+		return result
+	case int64:
+		return typedValue
+	default:
+		return 0
+	}
+}
+
+func normalizeCode(dataSourceCode string) (string, error) {
+	result := strings.ToUpper(strings.TrimSpace(dataSourceCode))
+	if len(result) == 0 {
+		return "", wraperror.Errorf(errForPackage, "empty data source code")
+	}
+
+	return result, nil
+}
+
+func stringValue(value any) string {
+	result, _ := value.(string)
+
+	return result
+}
@@ -0,0 +1,65 @@
+package configdocument_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/configdocument"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleDocument_RegisterDataSource() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configdocument/configdocument_test.go
+	document, err := configdocument.Parse(`{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_ID":1}]}}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	for _, dataSourceCode := range []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"} {
+		result, err := document.RegisterDataSource(dataSourceCode)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		fmt.Println(result)
+	}
+	// Output:
+	// {"DSRC_ID":1001}
+	// {"DSRC_ID":1002}
+	// {"DSRC_ID":1003}
+}
+
+func ExampleDocument_Verify() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configdocument/configdocument_test.go
+	ctx := context.TODO()
+	szConfig := getSzConfig(ctx)
+
+	document, err := configdocument.Parse(`{"G2_CONFIG":{"CFG_DSRC":[]}}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	_, err = document.RegisterDataSource("CUSTOMERS")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	configDefinition, err := document.Verify(ctx, szConfig)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(configDefinition)
+	// Output: {"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"CUSTOMERS","DSRC_DESC":"CUSTOMERS","DSRC_ID":1001,"RETENTION_LEVEL":"Remember"}]}}
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzConfig(_ context.Context) configdocument.ConfigVerifier {
+	return &fakeVerifier{} //exhaustruct:ignore
+}
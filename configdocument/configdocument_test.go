@@ -0,0 +1,226 @@
+package configdocument_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/configdocument"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szconfig"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParse(test *testing.T) {
+	document, err := configdocument.Parse(templateConfig)
+	printDebug(test, err, document)
+	require.NoError(test, err)
+	require.Equal(test, []configdocument.DataSource{{DsrcCode: "TEST", DsrcID: 1}, {DsrcCode: "SEARCH", DsrcID: 2}},
+		document.DataSources())
+	require.Equal(test, []string{"CFG_DSRC", "CFG_FTYPE", "SETTINGS"}, document.SectionNames())
+}
+
+func TestParse_badInput(test *testing.T) {
+	for _, configDefinition := range []string{"{", `{"CFG_DSRC":[]}`, `{"G2_CONFIG":[]}`, `{"G2_CONFIG":{"CFG_DSRC":{}}}`} {
+		_, err := configdocument.Parse(configDefinition)
+		printDebug(test, err)
+		require.Error(test, err, configDefinition)
+	}
+}
+
+func TestDocument_RegisterDataSource(test *testing.T) {
+	document := parse(test, templateConfig)
+
+	actual, err := document.RegisterDataSource("customers")
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DSRC_ID":1001}`, actual)
+
+	actual, err = document.RegisterDataSource(" Watchlist ")
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DSRC_ID":1002}`, actual)
+
+	registry, err := document.GetDataSourceRegistry()
+	printDebug(test, err, registry)
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DATA_SOURCES":[{"DSRC_CODE":"TEST","DSRC_ID":1},{"DSRC_CODE":"SEARCH","DSRC_ID":2},`+
+		`{"DSRC_CODE":"CUSTOMERS","DSRC_ID":1001},{"DSRC_CODE":"WATCHLIST","DSRC_ID":1002}]}`, registry)
+
+	_, err = document.RegisterDataSource("CUSTOMERS")
+	printDebug(test, err)
+	require.Error(test, err)
+
+	_, err = document.RegisterDataSource("  ")
+	require.Error(test, err)
+}
+
+func TestDocument_RegisterDataSource_afterHighID(test *testing.T) {
+	document := parse(test, `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"OLD","DSRC_ID":1500}]}}`)
+
+	actual, err := document.RegisterDataSource("NEW")
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DSRC_ID":1501}`, actual)
+}
+
+func TestDocument_UnregisterDataSource(test *testing.T) {
+	document := parse(test, templateConfig)
+	_, err := document.RegisterDataSource("CUSTOMERS")
+	require.NoError(test, err)
+
+	err = document.UnregisterDataSource("customers")
+	printDebug(test, err)
+	require.NoError(test, err)
+	require.Len(test, document.DataSources(), 2)
+
+	err = document.UnregisterDataSource("CUSTOMERS")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestDocument_Export(test *testing.T) {
+	document := parse(test, templateConfig)
+	_, err := document.RegisterDataSource("CUSTOMERS")
+	require.NoError(test, err)
+
+	actual, err := document.Export()
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+
+	// Other sections and fields are preserved, including large numbers.
+
+	reparsed := parse(test, actual)
+	require.Len(test, reparsed.DataSources(), 3)
+
+	dataSources, isOK := reparsed.Section("CFG_DSRC")
+	require.True(test, isOK)
+	require.Contains(test, string(dataSources), `"RETENTION_LEVEL":"Forget"`)
+	require.Contains(test, string(dataSources), `{"DSRC_CODE":"CUSTOMERS","DSRC_DESC":"CUSTOMERS","DSRC_ID":1001,`+
+		`"RETENTION_LEVEL":"Remember"}`)
+
+	var expected, exported map[string]any
+
+	require.NoError(test, json.Unmarshal([]byte(templateConfig), &expected))
+	require.NoError(test, json.Unmarshal([]byte(actual), &exported))
+	require.Equal(test, expected["G2_CONFIG"].(map[string]any)["CFG_FTYPE"], exported["G2_CONFIG"].(map[string]any)["CFG_FTYPE"])
+	require.Equal(test, expected["G2_CONFIG"].(map[string]any)["SETTINGS"], exported["G2_CONFIG"].(map[string]any)["SETTINGS"])
+	require.Contains(test, actual, `"DERIVATION_ID":123456789012345678`)
+}
+
+func TestDocument_UnmarshalSection(test *testing.T) {
+	document := parse(test, templateConfig)
+
+	var features []struct {
+		FtypeCode string `json:"FTYPE_CODE"`
+		FtypeID   int64  `json:"FTYPE_ID"`
+	}
+
+	err := document.UnmarshalSection("CFG_FTYPE", &features)
+	printDebug(test, err, features)
+	require.NoError(test, err)
+	require.Len(test, features, 2)
+	require.Equal(test, "NAME", features[0].FtypeCode)
+
+	err = document.UnmarshalSection("CFG_MISSING", &features)
+	require.Error(test, err)
+
+	_, isOK := document.Section("CFG_MISSING")
+	require.False(test, isOK)
+}
+
+func TestDocument_Verify(test *testing.T) {
+	ctx := test.Context()
+	document := parse(test, templateConfig)
+	_, err := document.RegisterDataSource("CUSTOMERS")
+	require.NoError(test, err)
+
+	verifier := &fakeVerifier{} //exhaustruct:ignore
+
+	actual, err := document.Verify(ctx, verifier)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, actual, verifier.configDefinition)
+	require.Contains(test, actual, "CUSTOMERS")
+
+	verifier.err = szerror.New(7223, `{"reason":"SENZ7223|Invalid version number for datastore schema [version '9.9']"}`)
+
+	_, err = document.Verify(ctx, verifier)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+}
+
+func TestConfigVerifier_szconfig(test *testing.T) {
+	var verifier configdocument.ConfigVerifier = &szconfig.Szconfig{} //exhaustruct:ignore
+
+	require.NotNil(test, verifier)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeVerifier struct {
+	configDefinition string
+	err              error
+}
+
+func (verifier *fakeVerifier) VerifyConfigDefinition(_ context.Context, configDefinition string) error {
+	verifier.configDefinition = configDefinition
+
+	return verifier.err
+}
+
+func parse(t *testing.T, configDefinition string) *configdocument.Document {
+	t.Helper()
+
+	result, err := configdocument.Parse(configDefinition)
+	require.NoError(t, err)
+
+	return result
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test data
+// ----------------------------------------------------------------------------
+
+const templateConfig = `{
+  "G2_CONFIG": {
+    "CFG_DSRC": [
+      {"DSRC_CODE": "TEST", "DSRC_DESC": "Test", "DSRC_ID": 1, "RETENTION_LEVEL": "Remember"},
+      {"DSRC_CODE": "SEARCH", "DSRC_DESC": "Search", "DSRC_ID": 2, "RETENTION_LEVEL": "Forget"}
+    ],
+    "CFG_FTYPE": [
+      {"FTYPE_CODE": "NAME", "FTYPE_ID": 1, "DERIVATION_ID": 123456789012345678},
+      {"FTYPE_CODE": "PHONE", "FTYPE_ID": 2}
+    ],
+    "SETTINGS": {"METAPHONE_VERSION": 3}
+  }
+}`
@@ -0,0 +1,19 @@
+/*
+Package configdocument edits Senzing configuration JSON documents in-process.
+
+SzConfig.RegisterDataSource, UnregisterDataSource and GetDataSourceRegistry send the whole configuration
+to the Senzing gRPC server on every call.
+A [Document] holds the configuration locally, so data sources can be listed, added and removed,
+and other sections read, without a server round trip per change, or while the server is unavailable.
+
+Local edits are not checked by Senzing.
+Before registering an edited configuration, call [Document.Verify], which sends the configuration
+to SzConfig.VerifyConfigDefinition once and returns the verified configuration definition.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package configdocument
@@ -0,0 +1,31 @@
+package configdocument
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+MinUserDataSourceID is the smallest DSRC_ID assigned to a registered data source.
+Lower identifiers are reserved for data sources in the Senzing configuration template.
+*/
+const MinUserDataSourceID = 1001
+
+/*
+DefaultRetentionLevel is the RETENTION_LEVEL of a registered data source.
+*/
+const DefaultRetentionLevel = "Remember"
+
+const (
+	dataSourceSection = "CFG_DSRC"
+	rootKey           = "G2_CONFIG"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("configdocument")
@@ -0,0 +1,362 @@
+package configwatcher
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Change describes a divergence between the default configuration and the engine's active configuration.
+*/
+type Change struct {
+	ActiveConfigID  int64
+	DefaultConfigID int64
+	DetectedAt      time.Time
+}
+
+/*
+Watcher reinitializes Senzing clients when the default configuration changes.
+
+SzConfigManager and SzEngine are polled.
+When a change is applied, OnChange is called if set; otherwise SzAbstractFactory.Reinitialize is called.
+*/
+type Watcher struct {
+	SzAbstractFactory senzing.SzAbstractFactory
+	SzConfigManager   senzing.SzConfigManager
+	SzEngine          senzing.SzEngine
+
+	// Optional. Called instead of SzAbstractFactory.Reinitialize when a change is applied.
+	OnChange func(ctx context.Context, change Change) error
+
+	// Optional. How long a divergence must persist before it is applied.
+	// If 0, a change is applied when it is first detected.
+	Debounce time.Duration
+
+	// Optional. If 0, DefaultPollInterval is used.
+	PollInterval time.Duration
+
+	checkMutex     sync.Mutex   // Serializes Check.
+	mutex          sync.Mutex   // Guards paused, pending and wake.
+	observerMutex  sync.RWMutex // Guards observerOrigin and observers.
+	observerOrigin string
+	observers      subject.Subject
+	paused         bool
+	pending        *Change
+	wake           chan struct{}
+}
+
+// ----------------------------------------------------------------------------
+// Watcher methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Check polls once and, if the default configuration has changed, applies the change
+unless the watcher is paused or the change is still within the debounce period.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The change that was applied, or nil if nothing was applied.
+*/
+func (watcher *Watcher) Check(ctx context.Context) (*Change, error) {
+	watcher.checkMutex.Lock()
+	defer watcher.checkMutex.Unlock()
+
+	defaultConfigID, err := watcher.SzConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		watcher.notify(ctx, MessagePollError, err, nil)
+
+		return nil, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	activeConfigID, err := watcher.SzEngine.GetActiveConfigID(ctx)
+	if err != nil {
+		watcher.notify(ctx, MessagePollError, err, nil)
+
+		return nil, wraperror.Errorf(err, "GetActiveConfigID")
+	}
+
+	change, isReady := watcher.track(ctx, activeConfigID, defaultConfigID)
+	if !isReady {
+		return nil, nil
+	}
+
+	err = watcher.apply(ctx, *change)
+	if err != nil {
+		watcher.notify(ctx, MessageReinitializeFailed, err, change.details())
+
+		return nil, wraperror.Errorf(err, "reinitialize with config %d", change.DefaultConfigID)
+	}
+
+	watcher.mutex.Lock()
+	watcher.pending = nil
+	watcher.mutex.Unlock()
+
+	watcher.notify(ctx, MessageReinitialized, nil, change.details())
+
+	return change, nil
+}
+
+/*
+Method GetObserverOrigin returns the "origin" value of past Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The value sent in the Observer's "origin" key/value pair.
+*/
+func (watcher *Watcher) GetObserverOrigin(ctx context.Context) string {
+	_ = ctx
+
+	watcher.observerMutex.RLock()
+	defer watcher.observerMutex.RUnlock()
+
+	return watcher.observerOrigin
+}
+
+/*
+Method IsPaused reports whether reinitialization is paused.
+
+Output
+  - True between Pause and Resume.
+*/
+func (watcher *Watcher) IsPaused() bool {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	return watcher.paused
+}
+
+/*
+Method Pause stops changes from being applied, for example during a bulk load.
+Polling continues; changes detected while paused are reported to observers and applied after Resume.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (watcher *Watcher) Pause(ctx context.Context) {
+	watcher.mutex.Lock()
+	watcher.paused = true
+	watcher.mutex.Unlock()
+
+	watcher.notify(ctx, MessagePaused, nil, nil)
+}
+
+/*
+Method RegisterObserver adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (watcher *Watcher) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	watcher.observerMutex.Lock()
+	defer watcher.observerMutex.Unlock()
+
+	if watcher.observers == nil {
+		watcher.observers = &subject.SimpleSubject{}
+	}
+
+	err := watcher.observers.RegisterObserver(ctx, observer)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method Resume allows changes to be applied again.
+If Run is active, it polls immediately.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (watcher *Watcher) Resume(ctx context.Context) {
+	watcher.mutex.Lock()
+	watcher.paused = false
+	wake := watcher.getWake()
+	watcher.mutex.Unlock()
+
+	watcher.notify(ctx, MessageResumed, nil, nil)
+
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+/*
+Method Run polls every PollInterval until the context is canceled.
+Errors are reported to observers and do not stop Run.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (watcher *Watcher) Run(ctx context.Context) {
+	pollInterval := watcher.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	watcher.mutex.Lock()
+	wake := watcher.getWake()
+	watcher.mutex.Unlock()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = watcher.Check(ctx) // Errors are reported to observers.
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wake:
+		}
+	}
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (watcher *Watcher) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+
+	watcher.observerMutex.Lock()
+	defer watcher.observerMutex.Unlock()
+
+	watcher.observerOrigin = origin
+}
+
+/*
+Method UnregisterObserver removes the observer from the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (watcher *Watcher) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	// Waits for notifications in flight, as SimpleSubject does not guard its observer list while notifying.
+
+	watcher.observerMutex.Lock()
+	defer watcher.observerMutex.Unlock()
+
+	if watcher.observers != nil {
+		err = watcher.observers.UnregisterObserver(ctx, observer)
+
+		if !watcher.observers.HasObservers(ctx) {
+			watcher.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (watcher *Watcher) apply(ctx context.Context, change Change) error {
+	if watcher.OnChange != nil {
+		return watcher.OnChange(ctx, change)
+	}
+
+	if watcher.SzAbstractFactory == nil {
+		return wraperror.Errorf(errForPackage, "neither OnChange nor SzAbstractFactory is set")
+	}
+
+	return wraperror.Errorf(watcher.SzAbstractFactory.Reinitialize(ctx, change.DefaultConfigID), wraperror.NoMessage)
+}
+
+func (change *Change) details() map[string]string {
+	return map[string]string{
+		"activeConfigID":  strconv.FormatInt(change.ActiveConfigID, 10),
+		"defaultConfigID": strconv.FormatInt(change.DefaultConfigID, 10),
+	}
+}
+
+// Requires watcher.mutex.
+func (watcher *Watcher) getWake() chan struct{} {
+	if watcher.wake == nil {
+		watcher.wake = make(chan struct{}, 1)
+	}
+
+	return watcher.wake
+}
+
+func (watcher *Watcher) notify(ctx context.Context, messageID int, err error, details map[string]string) {
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	go func() {
+		watcher.observerMutex.RLock()
+		defer watcher.observerMutex.RUnlock()
+
+		notifier.Notify(ctx, watcher.observers, watcher.observerOrigin, ComponentID, messageID, err, details)
+	}()
+}
+
+/*
+Record the observed configuration IDs and report whether a change is ready to be applied.
+A new divergence restarts the debounce period; so does a further change of the default configuration.
+*/
+func (watcher *Watcher) track(ctx context.Context, activeConfigID int64, defaultConfigID int64) (*Change, bool) {
+	watcher.mutex.Lock()
+
+	if defaultConfigID == activeConfigID {
+		watcher.pending = nil
+		watcher.mutex.Unlock()
+
+		return nil, false
+	}
+
+	isNew := watcher.pending == nil || watcher.pending.DefaultConfigID != defaultConfigID
+	if isNew {
+		watcher.pending = &Change{
+			ActiveConfigID:  activeConfigID,
+			DefaultConfigID: defaultConfigID,
+			DetectedAt:      time.Now(),
+		}
+	}
+
+	change := *watcher.pending
+	paused := watcher.paused
+	watcher.mutex.Unlock()
+
+	if isNew {
+		watcher.notify(ctx, MessageChangeDetected, nil, change.details())
+	}
+
+	switch {
+	case paused:
+		if isNew {
+			watcher.notify(ctx, MessageChangeDeferred, nil, change.details())
+		}
+
+		return nil, false
+	case time.Since(change.DetectedAt) < watcher.Debounce:
+		return nil, false
+	default:
+		return &change, true
+	}
+}
@@ -0,0 +1,66 @@
+package configwatcher_test
+
+import (
+	"context"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleWatcher_Check() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configwatcher/configwatcher_test.go
+	ctx := context.TODO()
+	repository := getRepository(ctx)
+	watcher := repository.newWatcher()
+
+	// Another deployer promotes configuration 1002.
+
+	repository.defaultConfigID.Store(1002)
+
+	change, err := watcher.Check(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("reinitialized from %d to %d\n", change.ActiveConfigID, change.DefaultConfigID)
+	// Output: reinitialized from 1001 to 1002
+}
+
+func ExampleWatcher_Pause() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/configwatcher/configwatcher_test.go
+	ctx := context.TODO()
+	repository := getRepository(ctx)
+	watcher := repository.newWatcher()
+
+	watcher.Pause(ctx)
+	repository.defaultConfigID.Store(1002)
+
+	change, err := watcher.Check(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(change == nil)
+
+	watcher.Resume(ctx)
+
+	change, err = watcher.Check(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(change.DefaultConfigID)
+	// Output:
+	// true
+	// 1002
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getRepository(_ context.Context) *fakeRepository {
+	return newFakeRepository(1001)
+}
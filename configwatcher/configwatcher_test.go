@@ -0,0 +1,364 @@
+package configwatcher_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/configwatcher"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWatcher_Check(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+
+	change, err := watcher.Check(ctx)
+	printDebug(test, err, change)
+	require.NoError(test, err)
+	require.Nil(test, change)
+
+	repository.defaultConfigID.Store(1002)
+
+	change, err = watcher.Check(ctx)
+	printDebug(test, err, change)
+	require.NoError(test, err)
+	require.NotNil(test, change)
+	require.Equal(test, int64(1001), change.ActiveConfigID)
+	require.Equal(test, int64(1002), change.DefaultConfigID)
+	require.Equal(test, []int64{1002}, repository.reinitializations())
+	require.Equal(test, int64(1002), repository.activeConfigID.Load())
+
+	change, err = watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Nil(test, change)
+}
+
+func TestWatcher_Check_debounce(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+	watcher.Debounce = 50 * time.Millisecond
+
+	repository.defaultConfigID.Store(1002)
+
+	change, err := watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Nil(test, change)
+
+	// A second promotion restarts the debounce period.
+
+	repository.defaultConfigID.Store(1003)
+	time.Sleep(30 * time.Millisecond)
+
+	change, err = watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Nil(test, change)
+
+	time.Sleep(30 * time.Millisecond)
+
+	change, err = watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Nil(test, change)
+
+	time.Sleep(30 * time.Millisecond)
+
+	change, err = watcher.Check(ctx)
+	printDebug(test, err, change)
+	require.NoError(test, err)
+	require.NotNil(test, change)
+	require.Equal(test, []int64{1003}, repository.reinitializations())
+}
+
+func TestWatcher_Check_onChange(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+
+	var changes []configwatcher.Change
+
+	watcher.OnChange = func(_ context.Context, change configwatcher.Change) error {
+		changes = append(changes, change)
+		repository.activeConfigID.Store(change.DefaultConfigID)
+
+		return nil
+	}
+
+	repository.defaultConfigID.Store(1002)
+
+	_, err := watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Len(test, changes, 1)
+	require.Empty(test, repository.reinitializations())
+}
+
+func TestWatcher_Check_error(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+	observer := newTestObserver()
+	require.NoError(test, watcher.RegisterObserver(ctx, observer))
+
+	repository.reinitializeErr = szerror.New(
+		7221,
+		`{"reason":"SENZ7221|No engine configuration registered with data ID [1002]."}`,
+	)
+	repository.defaultConfigID.Store(1002)
+
+	_, err := watcher.Check(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	observer.waitFor(test, configwatcher.MessageReinitializeFailed)
+
+	// The change is retried on the next poll.
+
+	repository.reinitializeErr = nil
+
+	change, err := watcher.Check(ctx)
+	require.NoError(test, err)
+	require.NotNil(test, change)
+}
+
+func TestWatcher_Check_noReinitializer(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+	watcher.SzAbstractFactory = nil
+
+	repository.defaultConfigID.Store(1002)
+
+	_, err := watcher.Check(ctx)
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestWatcher_Pause(test *testing.T) {
+	ctx := test.Context()
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+	observer := newTestObserver()
+	require.NoError(test, watcher.RegisterObserver(ctx, observer))
+
+	watcher.Pause(ctx)
+	require.True(test, watcher.IsPaused())
+	repository.defaultConfigID.Store(1002)
+
+	change, err := watcher.Check(ctx)
+	require.NoError(test, err)
+	require.Nil(test, change)
+	require.Empty(test, repository.reinitializations())
+	observer.waitFor(test, configwatcher.MessageChangeDeferred)
+
+	watcher.Resume(ctx)
+	require.False(test, watcher.IsPaused())
+
+	change, err = watcher.Check(ctx)
+	require.NoError(test, err)
+	require.NotNil(test, change)
+	require.Equal(test, []int64{1002}, repository.reinitializations())
+	observer.waitFor(test, configwatcher.MessageReinitialized)
+}
+
+func TestWatcher_Run(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	repository := newFakeRepository(1001)
+	watcher := repository.newWatcher()
+	watcher.PollInterval = time.Hour
+	observer := newTestObserver()
+	require.NoError(test, watcher.RegisterObserver(ctx, observer))
+	watcher.SetObserverOrigin(ctx, "worker-1")
+	require.Equal(test, "worker-1", watcher.GetObserverOrigin(ctx))
+	watcher.Pause(ctx)
+
+	done := make(chan struct{})
+
+	go func() {
+		watcher.Run(ctx)
+		close(done)
+	}()
+
+	repository.defaultConfigID.Store(1002)
+
+	// Resume wakes Run without waiting for the poll interval.
+
+	watcher.Resume(ctx)
+	require.Eventually(test, func() bool { return len(repository.reinitializations()) == 1 }, time.Second, time.Millisecond)
+
+	message := observer.waitFor(test, configwatcher.MessageReinitialized)
+	require.Equal(test, "worker-1", message["origin"])
+	require.Equal(test, "1002", message["defaultConfigID"])
+
+	cancel()
+	<-done
+	require.NoError(test, watcher.UnregisterObserver(ctx, observer))
+}
+
+func TestWatcher_Run_pollError(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	repository := newFakeRepository(1001)
+	repository.pollErr = szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)
+	watcher := repository.newWatcher()
+	watcher.PollInterval = time.Millisecond
+	observer := newTestObserver()
+	require.NoError(test, watcher.RegisterObserver(ctx, observer))
+
+	go watcher.Run(ctx)
+
+	message := observer.waitFor(test, configwatcher.MessagePollError)
+	printDebug(test, nil, message)
+	require.Contains(test, message["error"], "SENZ0010")
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeRepository struct {
+	activeConfigID  atomic.Int64
+	defaultConfigID atomic.Int64
+	mutex           sync.Mutex
+	pollErr         error
+	reinitialized   []int64
+	reinitializeErr error
+}
+
+func newFakeRepository(configID int64) *fakeRepository {
+	result := &fakeRepository{} //exhaustruct:ignore
+	result.activeConfigID.Store(configID)
+	result.defaultConfigID.Store(configID)
+
+	return result
+}
+
+func (repository *fakeRepository) newWatcher() *configwatcher.Watcher {
+	return &configwatcher.Watcher{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{repository: repository}, //exhaustruct:ignore
+		SzConfigManager:   &fakeSzConfigManager{repository: repository},   //exhaustruct:ignore
+		SzEngine:          &fakeSzEngine{repository: repository},          //exhaustruct:ignore
+	}
+}
+
+func (repository *fakeRepository) reinitializations() []int64 {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	return append([]int64{}, repository.reinitialized...)
+}
+
+type fakeSzAbstractFactory struct {
+	senzing.SzAbstractFactory
+
+	repository *fakeRepository
+}
+
+func (factory *fakeSzAbstractFactory) Reinitialize(_ context.Context, configID int64) error {
+	if factory.repository.reinitializeErr != nil {
+		return factory.repository.reinitializeErr
+	}
+
+	factory.repository.mutex.Lock()
+	defer factory.repository.mutex.Unlock()
+
+	factory.repository.reinitialized = append(factory.repository.reinitialized, configID)
+	factory.repository.activeConfigID.Store(configID)
+
+	return nil
+}
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	repository *fakeRepository
+}
+
+func (manager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	if manager.repository.pollErr != nil {
+		return 0, manager.repository.pollErr
+	}
+
+	return manager.repository.defaultConfigID.Load(), nil
+}
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	repository *fakeRepository
+}
+
+func (engine *fakeSzEngine) GetActiveConfigID(_ context.Context) (int64, error) {
+	return engine.repository.activeConfigID.Load(), nil
+}
+
+type testObserver struct {
+	messages chan map[string]string
+}
+
+func newTestObserver() *testObserver {
+	return &testObserver{messages: make(chan map[string]string, 100)}
+}
+
+func (observer *testObserver) GetObserverID(_ context.Context) string {
+	return "testObserver"
+}
+
+func (observer *testObserver) UpdateObserver(_ context.Context, message string) {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.messages <- details
+}
+
+func (observer *testObserver) waitFor(t *testing.T, messageID int) map[string]string {
+	t.Helper()
+
+	timeout := time.After(time.Second)
+
+	for {
+		select {
+		case message := <-observer.messages:
+			if message["messageId"] == fmt.Sprint(messageID) {
+				return message
+			}
+		case <-timeout:
+			t.Fatalf("no message %d", messageID)
+
+			return nil
+		}
+	}
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package configwatcher keeps long-running Senzing clients on the current default configuration.
+
+A [Watcher] polls SzConfigManager.GetDefaultConfigID and SzEngine.GetActiveConfigID.
+When another deployer promotes a new default configuration, the two diverge;
+once the divergence has lasted for the debounce period, the Watcher calls SzAbstractFactory.Reinitialize
+(or its OnChange callback) with the new default configuration ID.
+
+Reinitialization can be paused, for example during a bulk load, with [Watcher.Pause].
+A change detected while paused is applied after [Watcher.Resume].
+
+Observers registered with [Watcher.RegisterObserver] are notified when a change is detected,
+deferred, applied, or fails.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package configwatcher
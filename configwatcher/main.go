@@ -0,0 +1,38 @@
+package configwatcher
+
+import (
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ComponentID is the identifier of the configwatcher package.
+Package configwatcher messages will have the format "SZSDK6027eeee" where "eeee" is the error identifier.
+*/
+const ComponentID = 6027
+
+/*
+DefaultPollInterval is the time between polls when Watcher.PollInterval is not set.
+*/
+const DefaultPollInterval = 30 * time.Second
+
+// Observer message identifiers.
+const (
+	MessageChangeDetected     = 8001
+	MessageChangeDeferred     = 8002
+	MessageReinitialized      = 8003
+	MessageReinitializeFailed = 8004
+	MessagePollError          = 8005
+	MessagePaused             = 8006
+	MessageResumed            = 8007
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("configwatcher")
@@ -0,0 +1,1328 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/record"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Suite is the conformance test suite for the senzing.SzEngine objects of a factory.
+*/
+type Suite struct {
+	Factory senzing.SzAbstractFactory
+
+	// Optional. If true, methods that change the repository must fail with szabstractfactory.ErrReadOnly,
+	// as with Szabstractfactory.ReadOnly.
+	ReadOnly bool
+
+	// Optional. The factory that adds and deletes the test records. If nil, Factory is used.
+	// Required when ReadOnly is true.
+	SeedFactory senzing.SzAbstractFactory
+}
+
+type fixture struct {
+	entityID   int64 // The entity of the seed records, which resolve together.
+	seedEngine senzing.SzEngine
+}
+
+type testCase struct {
+	call        func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error)
+	check       func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture, actual string) // Optional. Run on success.
+	expectedErr error
+	name        string
+}
+
+type testGroup struct {
+	changesRepository bool
+	method            string
+	testCases         []testCase
+}
+
+// ----------------------------------------------------------------------------
+// Suite methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Run adds the truthset seed records and runs a subtest for each senzing.SzEngine method.
+The records are deleted when the test ends.
+
+Input
+  - t: The test to run the suite in.
+*/
+func (suite *Suite) Run(t *testing.T) {
+	t.Helper()
+	ctx := t.Context()
+
+	szEngine, err := suite.Factory.CreateEngine(ctx)
+	require.NoError(t, err)
+
+	seedFactory := suite.SeedFactory
+	if seedFactory == nil {
+		seedFactory = suite.Factory
+	}
+
+	seedEngine, err := seedFactory.CreateEngine(ctx)
+	require.NoError(t, err)
+
+	fixture := &fixture{seedEngine: seedEngine} //exhaustruct:ignore
+	fixture.add(t, seedRecords...)
+	fixture.entityID = getEntityID(t, seedEngine, record1001)
+
+	for _, group := range getTestGroups() {
+		t.Run(group.method, func(t *testing.T) {
+			for _, testCase := range group.testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					actual, err := testCase.call(t, szEngine, fixture)
+
+					switch {
+					case suite.ReadOnly && group.changesRepository:
+						require.ErrorIs(t, err, szabstractfactory.ErrReadOnly, "%s changed a read-only repository", group.method)
+					case testCase.expectedErr != nil:
+						require.ErrorIs(t, err, testCase.expectedErr)
+					default:
+						require.NoError(t, err)
+
+						if testCase.check != nil {
+							testCase.check(t, szEngine, fixture, actual)
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Add records with the seed engine, and delete them when the test ends.
+*/
+func (fixture *fixture) add(t *testing.T, records ...record.Record) {
+	t.Helper()
+	ctx := t.Context()
+
+	for _, aRecord := range records {
+		fixture.deleteOnCleanup(t, aRecord)
+		_, err := fixture.seedEngine.AddRecord(ctx, aRecord.DataSource, aRecord.ID, aRecord.JSON, senzing.SzWithoutInfo)
+		require.NoError(t, err)
+	}
+}
+
+/*
+Delete a record with the seed engine when the test ends. Deleting a record that does not exist is not an error.
+*/
+func (fixture *fixture) deleteOnCleanup(t *testing.T, aRecord record.Record) {
+	t.Helper()
+	ctx := context.WithoutCancel(t.Context())
+
+	t.Cleanup(func() {
+		_, err := fixture.seedEngine.DeleteRecord(ctx, aRecord.DataSource, aRecord.ID, senzing.SzWithoutInfo)
+		if err != nil {
+			t.Errorf("cannot delete %s %s: %v", aRecord.DataSource, aRecord.ID, err)
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Test data
+// ----------------------------------------------------------------------------
+
+func getTestGroups() []testGroup {
+	return []testGroup{
+		getTestGroupForAddRecord(),
+		getTestGroupForCountRedoRecords(),
+		getTestGroupForDeleteRecord(),
+		getTestGroupForExportCsvEntityReport(),
+		getTestGroupForExportCsvEntityReportIterator(),
+		getTestGroupForExportJSONEntityReport(),
+		getTestGroupForExportJSONEntityReportIterator(),
+		getTestGroupForFindInterestingEntitiesByEntityID(),
+		getTestGroupForFindInterestingEntitiesByRecordID(),
+		getTestGroupForFindNetworkByEntityID(),
+		getTestGroupForFindNetworkByRecordID(),
+		getTestGroupForFindPathByEntityID(),
+		getTestGroupForFindPathByRecordID(),
+		getTestGroupForGetActiveConfigID(),
+		getTestGroupForGetEntityByEntityID(),
+		getTestGroupForGetEntityByRecordID(),
+		getTestGroupForGetRecord(),
+		getTestGroupForGetRecordPreview(),
+		getTestGroupForGetRedoRecord(),
+		getTestGroupForGetStats(),
+		getTestGroupForGetVirtualEntityByRecordID(),
+		getTestGroupForHowEntityByEntityID(),
+		getTestGroupForPrimeEngine(),
+		getTestGroupForProcessRedoRecord(),
+		getTestGroupForReevaluateEntity(),
+		getTestGroupForReevaluateRecord(),
+		getTestGroupForSearchByAttributes(),
+		getTestGroupForWhyEntities(),
+		getTestGroupForWhyRecordInEntity(),
+		getTestGroupForWhyRecords(),
+		getTestGroupForWhySearch(),
+	}
+}
+
+func getTestGroupForAddRecord() testGroup {
+	addRecord := func(dataSourceCode string, recordDefinition string, flags int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+			fixture.deleteOnCleanup(t, record1004)
+
+			return szEngine.AddRecord(t.Context(), dataSourceCode, record1004.ID, recordDefinition, flags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: true,
+		method:            "AddRecord",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        addRecord(badDataSourceCode, record1004.JSON, senzing.SzWithoutInfo),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:        "badRecordDefinition",
+				call:        addRecord(record1004.DataSource, badRecordDefinition, senzing.SzWithoutInfo),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:  "default",
+				call:  addRecord(record1004.DataSource, record1004.JSON, senzing.SzWithoutInfo),
+				check: requireRecordExists(record1004),
+			},
+			{
+				name: "withInfo",
+				call: addRecord(record1004.DataSource, record1004.JSON, senzing.SzWithInfo),
+				check: func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture, actual string) {
+					t.Helper()
+					require.Contains(t, parseObject(t, actual), "AFFECTED_ENTITIES")
+					requireRecordExists(record1004)(t, szEngine, fixture, actual)
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForCountRedoRecords() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "CountRedoRecords",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+					result, err := szEngine.CountRedoRecords(t.Context())
+
+					return strconv.FormatInt(result, baseTen), err
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForDeleteRecord() testGroup {
+	deleteRecord := func(dataSourceCode string, recordID string, flags int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+			ctx := t.Context()
+			fixture.add(t, record1005)
+
+			// Read first, so that a cache holds the record when it is deleted.
+
+			_, err := szEngine.GetRecord(ctx, record1005.DataSource, record1005.ID, senzing.SzRecordDefaultFlags)
+			require.NoError(t, err)
+
+			return szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: true,
+		method:            "DeleteRecord",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        deleteRecord(badDataSourceCode, record1005.ID, senzing.SzWithoutInfo),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name: "badRecordID",
+				call: deleteRecord(record1005.DataSource, badRecordID, senzing.SzWithoutInfo),
+			},
+			{
+				name:  "default",
+				call:  deleteRecord(record1005.DataSource, record1005.ID, senzing.SzWithoutInfo),
+				check: requireRecordNotFound(record1005),
+			},
+			{
+				name: "withInfo",
+				call: deleteRecord(record1005.DataSource, record1005.ID, senzing.SzWithInfo),
+				check: func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture, actual string) {
+					t.Helper()
+					require.Contains(t, parseObject(t, actual), "AFFECTED_ENTITIES")
+					requireRecordNotFound(record1005)(t, szEngine, fixture, actual)
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForExportCsvEntityReport() testGroup {
+	exportCsvEntityReport := func(csvColumnList string) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+			ctx := t.Context()
+
+			exportHandle, err := szEngine.ExportCsvEntityReport(ctx, csvColumnList, senzing.SzExportIncludeAllEntities)
+			if err != nil {
+				return "", err
+			}
+
+			return fetchAll(ctx, szEngine, exportHandle)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "ExportCsvEntityReport",
+		testCases: []testCase{
+			{
+				name:        "badCsvColumnList",
+				call:        exportCsvEntityReport(badCsvColumnList),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:  "default",
+				call:  exportCsvEntityReport(""),
+				check: requireCsvEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForExportCsvEntityReportIterator() testGroup {
+	exportCsvEntityReportIterator := func(csvColumnList string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return collect(szEngine.ExportCsvEntityReportIterator(t.Context(), csvColumnList,
+				senzing.SzExportIncludeAllEntities))
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "ExportCsvEntityReportIterator",
+		testCases: []testCase{
+			{
+				name:        "badCsvColumnList",
+				call:        exportCsvEntityReportIterator(badCsvColumnList),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:  "default",
+				call:  exportCsvEntityReportIterator(""),
+				check: requireCsvEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForExportJSONEntityReport() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "ExportJSONEntityReport",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+					ctx := t.Context()
+
+					exportHandle, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzExportIncludeAllEntities)
+					if err != nil {
+						return "", err
+					}
+
+					return fetchAll(ctx, szEngine, exportHandle)
+				},
+				check: requireJSONEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForExportJSONEntityReportIterator() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "ExportJSONEntityReportIterator",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+
+					return collect(szEngine.ExportJSONEntityReportIterator(t.Context(), senzing.SzExportIncludeAllEntities))
+				},
+				check: requireJSONEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForFindInterestingEntitiesByEntityID() testGroup {
+	findInterestingEntitiesByEntityID := func(entityID func(*fixture) int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindInterestingEntitiesByEntityID(t.Context(), entityID(fixture), senzing.SzNoFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindInterestingEntitiesByEntityID",
+		testCases: []testCase{
+			{
+				name:        "badEntityID",
+				call:        findInterestingEntitiesByEntityID(badEntity),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: findInterestingEntitiesByEntityID(seedEntity),
+			},
+		},
+	}
+}
+
+func getTestGroupForFindInterestingEntitiesByRecordID() testGroup {
+	findInterestingEntitiesByRecordID := func(dataSourceCode string, recordID string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindInterestingEntitiesByRecordID(t.Context(), dataSourceCode, recordID, senzing.SzNoFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindInterestingEntitiesByRecordID",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        findInterestingEntitiesByRecordID(badDataSourceCode, record1001.ID),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        findInterestingEntitiesByRecordID(record1001.DataSource, badRecordID),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: findInterestingEntitiesByRecordID(record1001.DataSource, record1001.ID),
+			},
+		},
+	}
+}
+
+func getTestGroupForFindNetworkByEntityID() testGroup {
+	findNetworkByEntityID := func(maxDegrees int64) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindNetworkByEntityID(t.Context(), entityIDsJSON(fixture.entityID), maxDegrees,
+				defaultBuildOutDegrees, defaultBuildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindNetworkByEntityID",
+		testCases: []testCase{
+			{
+				name:        "badMaxDegrees",
+				call:        findNetworkByEntityID(badMaxDegrees),
+				expectedErr: szerror.ErrSz,
+			},
+			{
+				name:  "default",
+				call:  findNetworkByEntityID(defaultMaxDegrees),
+				check: requireEntityInResult,
+			},
+		},
+	}
+}
+
+func getTestGroupForFindNetworkByRecordID() testGroup {
+	findNetworkByRecordID := func(recordKeys string, maxDegrees int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindNetworkByRecordID(t.Context(), recordKeys, maxDegrees,
+				defaultBuildOutDegrees, defaultBuildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindNetworkByRecordID",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        findNetworkByRecordID(recordKeysJSON(badDataSourceCode, record1001.ID), defaultMaxDegrees),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badMaxDegrees",
+				call:        findNetworkByRecordID(recordKeysJSON(record1001.DataSource, record1001.ID), badMaxDegrees),
+				expectedErr: szerror.ErrSz,
+			},
+			{
+				name:        "badRecordID",
+				call:        findNetworkByRecordID(recordKeysJSON(record1001.DataSource, badRecordID), defaultMaxDegrees),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name:  "default",
+				call:  findNetworkByRecordID(recordKeysJSON(record1001.DataSource, record1001.ID), defaultMaxDegrees),
+				check: requireEntityInResult,
+			},
+		},
+	}
+}
+
+func getTestGroupForFindPathByEntityID() testGroup {
+	findPathByEntityID := func(startEntityID func(*fixture) int64, avoidEntityIDs string, requiredDataSources string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindPathByEntityID(t.Context(), startEntityID(fixture), fixture.entityID, defaultMaxDegrees,
+				avoidEntityIDs, requiredDataSources, senzing.SzFindPathDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindPathByEntityID",
+		testCases: []testCase{
+			{
+				name:        "badAvoidEntityIDs",
+				call:        findPathByEntityID(seedEntity, "}{", senzing.SzNoRequiredDatasources),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:        "badRequiredDataSources",
+				call:        findPathByEntityID(seedEntity, senzing.SzNoAvoidance, "}{"),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:        "badStartEntityID",
+				call:        findPathByEntityID(badEntity, senzing.SzNoAvoidance, senzing.SzNoRequiredDatasources),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: findPathByEntityID(seedEntity, senzing.SzNoAvoidance, senzing.SzNoRequiredDatasources),
+			},
+			{
+				name: "including",
+				call: findPathByEntityID(seedEntity, senzing.SzNoAvoidance, dataSourcesJSON(record1001.DataSource)),
+			},
+		},
+	}
+}
+
+func getTestGroupForFindPathByRecordID() testGroup {
+	findPathByRecordID := func(startDataSourceCode string, startRecordID string, avoidRecordKeys string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.FindPathByRecordID(t.Context(), startDataSourceCode, startRecordID,
+				record1002.DataSource, record1002.ID, defaultMaxDegrees, avoidRecordKeys,
+				senzing.SzNoRequiredDatasources, senzing.SzFindPathDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "FindPathByRecordID",
+		testCases: []testCase{
+			{
+				name:        "badAvoidRecordKeys",
+				call:        findPathByRecordID(record1001.DataSource, record1001.ID, "}{"),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:        "badDataSourceCode",
+				call:        findPathByRecordID(badDataSourceCode, record1001.ID, senzing.SzNoAvoidance),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        findPathByRecordID(record1001.DataSource, badRecordID, senzing.SzNoAvoidance),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: findPathByRecordID(record1001.DataSource, record1001.ID, senzing.SzNoAvoidance),
+			},
+		},
+	}
+}
+
+func getTestGroupForGetActiveConfigID() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "GetActiveConfigID",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+					result, err := szEngine.GetActiveConfigID(t.Context())
+
+					return strconv.FormatInt(result, baseTen), err
+				},
+				check: func(t *testing.T, _ senzing.SzEngine, _ *fixture, actual string) {
+					t.Helper()
+					require.NotEqual(t, "0", actual)
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForGetEntityByEntityID() testGroup {
+	getEntityByEntityID := func(entityID func(*fixture) int64) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.GetEntityByEntityID(t.Context(), entityID(fixture), senzing.SzEntityDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "GetEntityByEntityID",
+		testCases: []testCase{
+			{
+				name:        "badEntityID",
+				call:        getEntityByEntityID(badEntity),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name:  "default",
+				call:  getEntityByEntityID(seedEntity),
+				check: requireResolvedEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForGetEntityByRecordID() testGroup {
+	getEntityByRecordID := func(dataSourceCode string, recordID string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.GetEntityByRecordID(t.Context(), dataSourceCode, recordID, senzing.SzEntityDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "GetEntityByRecordID",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        getEntityByRecordID(badDataSourceCode, record1001.ID),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        getEntityByRecordID(record1001.DataSource, badRecordID),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name:  "default",
+				call:  getEntityByRecordID(record1001.DataSource, record1001.ID),
+				check: requireResolvedEntity,
+			},
+			{
+				name:  "sameEntity",
+				call:  getEntityByRecordID(record1003.DataSource, record1003.ID),
+				check: requireResolvedEntity,
+			},
+		},
+	}
+}
+
+func getTestGroupForGetRecord() testGroup {
+	getRecord := func(dataSourceCode string, recordID string) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.GetRecord(t.Context(), dataSourceCode, recordID, senzing.SzRecordDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "GetRecord",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        getRecord(badDataSourceCode, record1001.ID),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        getRecord(record1001.DataSource, badRecordID),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: getRecord(record1001.DataSource, record1001.ID),
+				check: func(t *testing.T, _ senzing.SzEngine, _ *fixture, actual string) {
+					t.Helper()
+					requireRecordKey(t, actual, record1001)
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForGetRecordPreview() testGroup {
+	getRecordPreview := func(recordDefinition string) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.GetRecordPreview(t.Context(), recordDefinition, senzing.SzRecordPreviewDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "GetRecordPreview",
+		testCases: []testCase{
+			{
+				name:        "badRecordDefinition",
+				call:        getRecordPreview(badRecordDefinition),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name:  "default",
+				call:  getRecordPreview(record1004.JSON),
+				check: requireRecordNotFound(record1004),
+			},
+		},
+	}
+}
+
+func getTestGroupForGetRedoRecord() testGroup {
+	return testGroup{
+		changesRepository: true,
+		method:            "GetRedoRecord",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+
+					return szEngine.GetRedoRecord(t.Context())
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForGetStats() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "GetStats",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+
+					return szEngine.GetStats(t.Context())
+				},
+				check: func(t *testing.T, _ senzing.SzEngine, _ *fixture, actual string) {
+					t.Helper()
+					parseObject(t, actual)
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForGetVirtualEntityByRecordID() testGroup {
+	getVirtualEntityByRecordID := func(recordKeys string) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.GetVirtualEntityByRecordID(t.Context(), recordKeys, senzing.SzVirtualEntityDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "GetVirtualEntityByRecordID",
+		testCases: []testCase{
+			{
+				name: "badDataSourceCode",
+				call: getVirtualEntityByRecordID(
+					recordKeysJSON(badDataSourceCode, record1001.ID, record1002.DataSource, record1002.ID)),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name: "badRecordID",
+				call: getVirtualEntityByRecordID(
+					recordKeysJSON(record1001.DataSource, badRecordID, record1002.DataSource, record1002.ID)),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: getVirtualEntityByRecordID(
+					recordKeysJSON(record1001.DataSource, record1001.ID, record1002.DataSource, record1002.ID)),
+			},
+		},
+	}
+}
+
+func getTestGroupForHowEntityByEntityID() testGroup {
+	howEntityByEntityID := func(entityID func(*fixture) int64) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.HowEntityByEntityID(t.Context(), entityID(fixture), senzing.SzHowEntityDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "HowEntityByEntityID",
+		testCases: []testCase{
+			{
+				name:        "badEntityID",
+				call:        howEntityByEntityID(badEntity),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: howEntityByEntityID(seedEntity),
+			},
+		},
+	}
+}
+
+func getTestGroupForPrimeEngine() testGroup {
+	return testGroup{
+		changesRepository: false,
+		method:            "PrimeEngine",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+					t.Helper()
+
+					return "", szEngine.PrimeEngine(t.Context())
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForProcessRedoRecord() testGroup {
+	processRedoRecord := func(flags int64) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+			ctx := t.Context()
+
+			redoRecord, err := fixture.seedEngine.GetRedoRecord(ctx)
+			require.NoError(t, err)
+
+			return szEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: true,
+		method:            "ProcessRedoRecord",
+		testCases: []testCase{
+			{
+				name: "default",
+				call: processRedoRecord(senzing.SzWithoutInfo),
+			},
+			{
+				name: "withInfo",
+				call: processRedoRecord(senzing.SzWithInfo),
+			},
+		},
+	}
+}
+
+func getTestGroupForReevaluateEntity() testGroup {
+	reevaluateEntity := func(entityID func(*fixture) int64, flags int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.ReevaluateEntity(t.Context(), entityID(fixture), flags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: true,
+		method:            "ReevaluateEntity",
+		testCases: []testCase{
+			{
+				name: "badEntityID",
+				call: reevaluateEntity(badEntity, senzing.SzWithoutInfo),
+			},
+			{
+				name: "default",
+				call: reevaluateEntity(seedEntity, senzing.SzWithoutInfo),
+			},
+			{
+				name: "withInfo",
+				call: reevaluateEntity(seedEntity, senzing.SzWithInfo),
+				check: func(t *testing.T, _ senzing.SzEngine, _ *fixture, actual string) {
+					t.Helper()
+					require.Contains(t, parseObject(t, actual), "AFFECTED_ENTITIES")
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForReevaluateRecord() testGroup {
+	reevaluateRecord := func(dataSourceCode string, recordID string, flags int64) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.ReevaluateRecord(t.Context(), dataSourceCode, recordID, flags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: true,
+		method:            "ReevaluateRecord",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        reevaluateRecord(badDataSourceCode, record1001.ID, senzing.SzWithoutInfo),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name: "badRecordID",
+				call: reevaluateRecord(record1001.DataSource, badRecordID, senzing.SzWithoutInfo),
+			},
+			{
+				name: "default",
+				call: reevaluateRecord(record1001.DataSource, record1001.ID, senzing.SzWithoutInfo),
+			},
+			{
+				name: "withInfo",
+				call: reevaluateRecord(record1001.DataSource, record1001.ID, senzing.SzWithInfo),
+				check: func(t *testing.T, _ senzing.SzEngine, _ *fixture, actual string) {
+					t.Helper()
+					require.Contains(t, parseObject(t, actual), "AFFECTED_ENTITIES")
+				},
+			},
+		},
+	}
+}
+
+func getTestGroupForSearchByAttributes() testGroup {
+	searchByAttributes := func(attributes string, searchProfile string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.SearchByAttributes(t.Context(), attributes, searchProfile,
+				senzing.SzSearchByAttributesDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "SearchByAttributes",
+		testCases: []testCase{
+			{
+				name:        "badAttributes",
+				call:        searchByAttributes(badAttributes, senzing.SzNoSearchProfile),
+				expectedErr: szerror.ErrSz,
+			},
+			{
+				name:        "badSearchProfile",
+				call:        searchByAttributes(defaultAttributes, badSearchProfile),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name: "default",
+				call: searchByAttributes(defaultAttributes, senzing.SzNoSearchProfile),
+			},
+			{
+				name:  "seedRecord",
+				call:  searchByAttributes(record1001.JSON, senzing.SzNoSearchProfile),
+				check: requireEntityInResult,
+			},
+		},
+	}
+}
+
+func getTestGroupForWhyEntities() testGroup {
+	whyEntities := func(entityID1 func(*fixture) int64) func(*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.WhyEntities(t.Context(), entityID1(fixture), fixture.entityID, senzing.SzWhyEntitiesDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "WhyEntities",
+		testCases: []testCase{
+			{
+				name:        "badEntityID",
+				call:        whyEntities(badEntity),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: whyEntities(seedEntity),
+			},
+		},
+	}
+}
+
+func getTestGroupForWhyRecordInEntity() testGroup {
+	whyRecordInEntity := func(dataSourceCode string, recordID string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.WhyRecordInEntity(t.Context(), dataSourceCode, recordID, senzing.SzWhyRecordInEntityDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "WhyRecordInEntity",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        whyRecordInEntity(badDataSourceCode, record1001.ID),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        whyRecordInEntity(record1001.DataSource, badRecordID),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: whyRecordInEntity(record1001.DataSource, record1001.ID),
+			},
+		},
+	}
+}
+
+func getTestGroupForWhyRecords() testGroup {
+	whyRecords := func(dataSourceCode1 string, recordID1 string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.WhyRecords(t.Context(), dataSourceCode1, recordID1, record1002.DataSource, record1002.ID,
+				senzing.SzWhyRecordsDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "WhyRecords",
+		testCases: []testCase{
+			{
+				name:        "badDataSourceCode",
+				call:        whyRecords(badDataSourceCode, record1001.ID),
+				expectedErr: szerror.ErrSzUnknownDataSource,
+			},
+			{
+				name:        "badRecordID",
+				call:        whyRecords(record1001.DataSource, badRecordID),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name: "default",
+				call: whyRecords(record1001.DataSource, record1001.ID),
+			},
+		},
+	}
+}
+
+func getTestGroupForWhySearch() testGroup {
+	whySearch := func(entityID func(*fixture) int64, searchProfile string) func(
+		*testing.T, senzing.SzEngine, *fixture) (string, error) {
+		return func(t *testing.T, szEngine senzing.SzEngine, fixture *fixture) (string, error) {
+			t.Helper()
+
+			return szEngine.WhySearch(t.Context(), defaultAttributes, entityID(fixture), searchProfile,
+				senzing.SzWhySearchDefaultFlags)
+		}
+	}
+
+	return testGroup{
+		changesRepository: false,
+		method:            "WhySearch",
+		testCases: []testCase{
+			{
+				name:        "badEntityID",
+				call:        whySearch(badEntity, senzing.SzNoSearchProfile),
+				expectedErr: szerror.ErrSzNotFound,
+			},
+			{
+				name:        "badSearchProfile",
+				call:        whySearch(seedEntity, badSearchProfile),
+				expectedErr: szerror.ErrSzBadInput,
+			},
+			{
+				name: "default",
+				call: whySearch(seedEntity, senzing.SzNoSearchProfile),
+			},
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func badEntity(_ *fixture) int64 {
+	return badEntityID
+}
+
+/*
+Read an iterator to the end, so that its goroutine finishes, and return the first error.
+*/
+func collect(fragments chan senzing.StringFragment) (string, error) {
+	var (
+		err           error
+		resultBuilder strings.Builder
+	)
+
+	for fragment := range fragments {
+		if fragment.Error != nil {
+			if err == nil {
+				err = fragment.Error
+			}
+
+			continue
+		}
+
+		resultBuilder.WriteString(fragment.Value)
+	}
+
+	return resultBuilder.String(), err
+}
+
+func dataSourcesJSON(dataSourceCodes ...string) string {
+	result, _ := json.Marshal(map[string][]string{"DATA_SOURCES": dataSourceCodes}) //nolint:errchkjson
+
+	return string(result)
+}
+
+func entityIDsJSON(entityIDs ...int64) string {
+	entities := []map[string]int64{}
+	for _, entityID := range entityIDs {
+		entities = append(entities, map[string]int64{"ENTITY_ID": entityID})
+	}
+
+	result, _ := json.Marshal(map[string]any{"ENTITIES": entities}) //nolint:errchkjson
+
+	return string(result)
+}
+
+/*
+Read an export report to the end and close it.
+*/
+func fetchAll(ctx context.Context, szEngine senzing.SzEngine, exportHandle uintptr) (string, error) {
+	var resultBuilder strings.Builder
+
+	for {
+		fragment, err := szEngine.FetchNext(ctx, exportHandle)
+		if err != nil {
+			_ = szEngine.CloseExportReport(ctx, exportHandle)
+
+			return "", err //nolint:wrapcheck
+		}
+
+		if len(fragment) == 0 {
+			break
+		}
+
+		resultBuilder.WriteString(fragment)
+	}
+
+	return resultBuilder.String(), szEngine.CloseExportReport(ctx, exportHandle) //nolint:wrapcheck
+}
+
+func getEntityID(t *testing.T, szEngine senzing.SzEngine, aRecord record.Record) int64 {
+	t.Helper()
+
+	response, err := szEngine.GetEntityByRecordID(t.Context(), aRecord.DataSource, aRecord.ID, senzing.SzWithoutInfo)
+	require.NoError(t, err)
+
+	return parseEntityID(t, response)
+}
+
+func parseEntityID(t *testing.T, response string) int64 {
+	t.Helper()
+
+	var result struct {
+		ResolvedEntity struct {
+			EntityID int64 `json:"ENTITY_ID"`
+		} `json:"RESOLVED_ENTITY"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(response), &result), response)
+
+	return result.ResolvedEntity.EntityID
+}
+
+func parseObject(t *testing.T, response string) map[string]any {
+	t.Helper()
+
+	result := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(response), &result), response)
+
+	return result
+}
+
+func recordKeysJSON(dataSourceCodesAndRecordIDs ...string) string {
+	records := []map[string]string{}
+	for index := 0; index+1 < len(dataSourceCodesAndRecordIDs); index += 2 {
+		records = append(records, map[string]string{
+			"DATA_SOURCE": dataSourceCodesAndRecordIDs[index],
+			"RECORD_ID":   dataSourceCodesAndRecordIDs[index+1],
+		})
+	}
+
+	result, _ := json.Marshal(map[string]any{"RECORDS": records}) //nolint:errchkjson
+
+	return string(result)
+}
+
+/*
+The CSV export has a row for the seed entity.
+*/
+func requireCsvEntity(t *testing.T, _ senzing.SzEngine, fixture *fixture, actual string) {
+	t.Helper()
+	require.Contains(t, actual, strconv.FormatInt(fixture.entityID, baseTen))
+}
+
+/*
+The result mentions the seed entity, as in the ENTITY_ID of a network or a search.
+*/
+func requireEntityInResult(t *testing.T, _ senzing.SzEngine, fixture *fixture, actual string) {
+	t.Helper()
+
+	var compacted bytes.Buffer
+
+	require.NoError(t, json.Compact(&compacted, []byte(actual)), actual)
+	require.Contains(t, compacted.String(), `"ENTITY_ID":`+strconv.FormatInt(fixture.entityID, baseTen))
+}
+
+/*
+One line of the JSON export is the seed entity.
+*/
+func requireJSONEntity(t *testing.T, _ senzing.SzEngine, fixture *fixture, actual string) {
+	t.Helper()
+
+	entityIDs := []int64{}
+
+	for line := range strings.Lines(actual) {
+		if len(strings.TrimSpace(line)) > 0 {
+			entityIDs = append(entityIDs, parseEntityID(t, line))
+		}
+	}
+
+	require.Contains(t, entityIDs, fixture.entityID)
+}
+
+func requireRecordExists(aRecord record.Record) func(*testing.T, senzing.SzEngine, *fixture, string) {
+	return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture, _ string) {
+		t.Helper()
+
+		actual, err := szEngine.GetRecord(t.Context(), aRecord.DataSource, aRecord.ID, senzing.SzRecordDefaultFlags)
+		require.NoError(t, err)
+		requireRecordKey(t, actual, aRecord)
+	}
+}
+
+func requireRecordKey(t *testing.T, response string, aRecord record.Record) {
+	t.Helper()
+
+	var result struct {
+		DataSource string `json:"DATA_SOURCE"`
+		RecordID   string `json:"RECORD_ID"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(response), &result), response)
+	require.Equal(t, aRecord.DataSource, result.DataSource)
+	require.Equal(t, aRecord.ID, result.RecordID)
+}
+
+func requireRecordNotFound(aRecord record.Record) func(*testing.T, senzing.SzEngine, *fixture, string) {
+	return func(t *testing.T, szEngine senzing.SzEngine, _ *fixture, _ string) {
+		t.Helper()
+
+		_, err := szEngine.GetRecord(t.Context(), aRecord.DataSource, aRecord.ID, senzing.SzRecordDefaultFlags)
+		require.ErrorIs(t, err, szerror.ErrSzNotFound)
+	}
+}
+
+/*
+The result is the seed entity.
+*/
+func requireResolvedEntity(t *testing.T, _ senzing.SzEngine, fixture *fixture, actual string) {
+	t.Helper()
+	require.Equal(t, fixture.entityID, parseEntityID(t, actual))
+}
+
+func seedEntity(fixture *fixture) int64 {
+	return fixture.entityID
+}
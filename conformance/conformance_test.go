@@ -0,0 +1,131 @@
+package conformance_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/truthset"
+	"github.com/senzing-garage/sz-sdk-go-grpc/conformance"
+	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"google.golang.org/grpc"
+)
+
+var (
+	grpcAddress    = "0.0.0.0:8261"
+	grpcConnection *grpc.ClientConn
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSuite_Run(test *testing.T) {
+	ctx := test.Context()
+	suite := &conformance.Suite{
+		Factory: getSzAbstractFactory(ctx),
+	} //exhaustruct:ignore
+	suite.Run(test)
+}
+
+func TestSuite_Run_readOnly(test *testing.T) {
+	ctx := test.Context()
+	suite := &conformance.Suite{
+		Factory: &szabstractfactory.Szabstractfactory{
+			GrpcConnection: getGrpcConnection(ctx),
+			ReadOnly:       true,
+		}, //exhaustruct:ignore
+		ReadOnly:    true,
+		SeedFactory: getSzAbstractFactory(ctx),
+	}
+	suite.Run(test)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getGrpcConnection(ctx context.Context) *grpc.ClientConn {
+	if grpcConnection == nil {
+		transportCredentials, err := helper.GetGrpcTransportCredentials(ctx)
+		panicOnError(err)
+
+		dialOptions := []grpc.DialOption{
+			grpc.WithTransportCredentials(transportCredentials),
+		}
+
+		grpcConnection, err = grpc.NewClient(grpcAddress, dialOptions...)
+		panicOnError(err)
+	}
+
+	return grpcConnection
+}
+
+func getSzAbstractFactory(ctx context.Context) senzing.SzAbstractFactory {
+	return &szabstractfactory.Szabstractfactory{
+		GrpcConnection: getGrpcConnection(ctx),
+	} //exhaustruct:ignore
+}
+
+func panicOnError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	setup()
+
+	code := m.Run()
+
+	os.Exit(code)
+}
+
+func setup() {
+	setupSenzingConfiguration()
+}
+
+/*
+Register the truthset data sources, which the suite requires, in the default configuration.
+*/
+func setupSenzingConfiguration() {
+	ctx := context.TODO()
+	now := time.Now()
+
+	szConfigManager, err := getSzAbstractFactory(ctx).CreateConfigManager(ctx)
+	panicOnError(err)
+
+	szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+	panicOnError(err)
+
+	dataSourceCodes := []string{}
+	for dataSourceCode := range truthset.TruthsetDataSources {
+		dataSourceCodes = append(dataSourceCodes, dataSourceCode)
+	}
+
+	slices.Sort(dataSourceCodes)
+
+	for _, dataSourceCode := range dataSourceCodes {
+		_, err := szConfig.RegisterDataSource(ctx, dataSourceCode)
+		panicOnError(err)
+	}
+
+	configComment := fmt.Sprintf("Created by conformance_test at %s", now.UTC())
+	configDefinition, err := szConfig.Export(ctx)
+	panicOnError(err)
+
+	configID, err := szConfigManager.RegisterConfig(ctx, configDefinition, configComment)
+	panicOnError(err)
+
+	err = szConfigManager.SetDefaultConfigID(ctx, configID)
+	panicOnError(err)
+}
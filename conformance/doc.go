@@ -0,0 +1,25 @@
+/*
+Package conformance is a test suite for implementations of senzing.SzEngine.
+
+A [Suite] runs the same assertions against any senzing.SzAbstractFactory: this package's Szabstractfactory,
+or a decorator that adds caching, retries or a read-only mode.
+Use it from a test function:
+
+	func TestMyDecorator(test *testing.T) {
+		suite := &conformance.Suite{Factory: myDecorator}
+		suite.Run(test)
+	}
+
+The suite adds truthset records before its tests and deletes them afterward.
+The repository's default configuration must include the truthset data sources: CUSTOMERS, REFERENCE and WATCHLIST.
+
+Errors are checked by class, with errors.Is and the szerror sentinels, not by message.
+Messages name the functions the error passed through, so they differ from one implementation to the next.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package conformance
@@ -0,0 +1,46 @@
+package conformance
+
+import (
+	"github.com/senzing-garage/go-helpers/record"
+	"github.com/senzing-garage/go-helpers/truthset"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	baseTen                    = 10
+	defaultAttributes          = `{"NAMES": [{"NAME_TYPE": "PRIMARY", "NAME_LAST": "JOHNSON"}], "SSN_NUMBER": "053-39-3251"}`
+	defaultBuildOutDegrees     = int64(2)
+	defaultBuildOutMaxEntities = int64(10)
+	defaultMaxDegrees          = int64(2)
+)
+
+// Bad parameters
+
+const (
+	badAttributes       = "}{"
+	badCsvColumnList    = "BAD, CSV, COLUMN, LIST"
+	badDataSourceCode   = "BadDataSourceCode"
+	badEntityID         = int64(-1)
+	badMaxDegrees       = int64(-1)
+	badRecordDefinition = "}{"
+	badRecordID         = "BadRecordID"
+	badSearchProfile    = "}{"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Truthset records. The seed records are added before the tests; the others are added by the tests that use them.
+var (
+	record1001 = truthset.CustomerRecords["1001"]
+	record1002 = truthset.CustomerRecords["1002"]
+	record1003 = truthset.CustomerRecords["1003"]
+	record1004 = truthset.CustomerRecords["1004"]
+	record1005 = truthset.CustomerRecords["1005"]
+
+	seedRecords = []record.Record{record1001, record1002, record1003}
+)
@@ -0,0 +1,27 @@
+/*
+Package entitycache provides a read-through cache for SzEngine.GetEntityByEntityID and SzEngine.GetEntityByRecordID.
+
+The [Szengine] type decorates any senzing.SzEngine.
+Entity documents are cached by entity ID and flags, bounded by a maximum number of entries
+(least recently used entries are evicted first) and an optional time-to-live.
+Record lookups are mapped to the entity ID of the returned document, so that a record lookup
+and an entity lookup for the same entity share a cache entry.
+
+Writes made through the same [Szengine] invalidate cached entities.
+When a write is made with the senzing.SzWithInfo flag (or AlwaysWithInfo is set), only the affected entities
+are invalidated.
+Otherwise, the whole cache is purged: a write can merge or split entities the cache has not linked
+to the written record, so no narrower invalidation is safe.
+A configuration change is applied with SzAbstractFactory.Reinitialize, which the cache does not see,
+so call [Szengine.Purge] after reinitializing, for example from configwatcher.Watcher.OnChange:
+entities can resolve differently under the new configuration.
+Changes made by other clients are not seen until entries expire, so a TTL should be set when the repository
+is shared.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package entitycache
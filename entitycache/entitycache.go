@@ -0,0 +1,517 @@
+package entitycache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/withinfo"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Szengine decorates a senzing.SzEngine with a read-through cache of entity documents.
+Methods that are not overridden are passed through to the wrapped SzEngine.
+The zero value of the configuration fields is usable; fields must not be changed after first use.
+*/
+type Szengine struct {
+	senzing.SzEngine
+
+	// If true, senzing.SzWithInfo is added to the flags of every write so that only affected entities are invalidated.
+	// The information is only returned to the caller if the caller requested it.
+	AlwaysWithInfo bool
+
+	// Maximum number of cached entity documents. If 0, DefaultMaxEntries is used.
+	MaxEntries int
+
+	// Maximum age of a cached entity document. If 0, documents do not expire.
+	TTL time.Duration
+
+	entities   map[int64]*entityState
+	generation uint64 // Incremented on invalidation so that reads started earlier are not cached.
+	lru        *list.List
+	mutex      sync.Mutex
+	records    map[recordKey]int64
+	stats      Stats
+}
+
+/*
+Stats holds cache statistics.
+*/
+type Stats struct {
+	Entries       int
+	Evictions     int64
+	Expirations   int64
+	Hits          int64
+	Invalidations int64
+	Misses        int64
+}
+
+type cacheEntry struct {
+	entityID int64
+	expires  time.Time
+	flags    int64
+	value    string
+}
+
+type entityState struct {
+	elements map[int64]*list.Element // Keyed by flags.
+	records  map[recordKey]struct{}
+}
+
+type recordKey struct {
+	dataSourceCode string
+	recordID       string
+}
+
+type entityDocument struct {
+	ResolvedEntity struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"RESOLVED_ENTITY"`
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods - cached reads
+// ----------------------------------------------------------------------------
+
+/*
+Method GetEntityByEntityID returns information about a resolved identity, from the cache if possible.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	result, generation, isOK := client.get(entityID, flags)
+	if isOK {
+		return result, nil
+	}
+
+	result, err := client.SzEngine.GetEntityByEntityID(ctx, entityID, flags)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.put(generation, entityID, flags, result, nil)
+
+	return result, nil
+}
+
+/*
+Method GetEntityByRecordID returns information about the resolved identity containing a record,
+from the cache if possible.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) GetEntityByRecordID(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	key := recordKey{dataSourceCode: dataSourceCode, recordID: recordID}
+
+	result, generation, isOK := client.getByRecord(key, flags)
+	if isOK {
+		return result, nil
+	}
+
+	result, err := client.SzEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	document := entityDocument{} //exhaustruct:ignore
+
+	err = json.Unmarshal([]byte(result), &document)
+	if err == nil && document.ResolvedEntity.EntityID != 0 {
+		client.put(generation, document.ResolvedEntity.EntityID, flags, result, &key)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods - invalidating writes
+// ----------------------------------------------------------------------------
+
+/*
+Method AddRecord adds a record and invalidates the cached entities it affects.
+Without "WithInfo" information, the whole cache is purged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - recordDefinition: A JSON document containing the record to be added to the Senzing repository.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) AddRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (string, error) {
+	return client.write(
+		func(szEngine senzing.SzEngine) (string, error) {
+			return szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+		},
+	)
+}
+
+/*
+Method DeleteRecord deletes a record and invalidates the cached entities it affects.
+Without "WithInfo" information, the whole cache is purged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) DeleteRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return client.write(
+		func(szEngine senzing.SzEngine) (string, error) {
+			return szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+		},
+	)
+}
+
+/*
+Method ProcessRedoRecord processes a redo record and invalidates the cached entities it affects.
+Without "WithInfo" information, the whole cache is purged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - redoRecord: A redo record retrieved from GetRedoRecord.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	return client.write(
+		func(szEngine senzing.SzEngine) (string, error) {
+			return szEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+		},
+	)
+}
+
+/*
+Method ReevaluateEntity reevaluates an entity and invalidates the cached entities it affects.
+Without "WithInfo" information, the whole cache is purged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.write(
+		func(szEngine senzing.SzEngine) (string, error) {
+			return szEngine.ReevaluateEntity(ctx, entityID, flags)
+		},
+	)
+}
+
+/*
+Method ReevaluateRecord reevaluates a record and invalidates the cached entities it affects.
+Without "WithInfo" information, the whole cache is purged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ReevaluateRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return client.write(
+		func(szEngine senzing.SzEngine) (string, error) {
+			return szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags)
+		},
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Invalidate removes the cached documents of the given entities.
+
+Input
+  - entityIDs: The unique identifiers of entities.
+*/
+func (client *Szengine) Invalidate(entityIDs ...int64) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.generation++
+
+	for _, entityID := range entityIDs {
+		if client.removeEntity(entityID) {
+			client.stats.Invalidations++
+		}
+	}
+}
+
+/*
+Method Purge removes all cached documents.
+Call it after the Senzing clients are reinitialized with a new configuration.
+*/
+func (client *Szengine) Purge() {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.generation++
+	client.stats.Invalidations += int64(len(client.entities))
+	client.entities = nil
+	client.lru = nil
+	client.records = nil
+}
+
+/*
+Method Stats returns a snapshot of the cache statistics.
+
+Output
+  - Cache statistics.
+*/
+func (client *Szengine) Stats() Stats {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	result := client.stats
+	if client.lru != nil {
+		result.Entries = client.lru.Len()
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (client *Szengine) get(entityID int64, flags int64) (string, uint64, bool) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	result, isOK := client.lookup(entityID, flags)
+
+	return result, client.generation, isOK
+}
+
+func (client *Szengine) getByRecord(key recordKey, flags int64) (string, uint64, bool) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	entityID, isOK := client.records[key]
+	if !isOK {
+		client.stats.Misses++
+
+		return "", client.generation, false
+	}
+
+	result, isOK := client.lookup(entityID, flags)
+
+	return result, client.generation, isOK
+}
+
+// Must be called with the mutex held.
+func (client *Szengine) lookup(entityID int64, flags int64) (string, bool) {
+	state, isOK := client.entities[entityID]
+	if !isOK {
+		client.stats.Misses++
+
+		return "", false
+	}
+
+	element, isOK := state.elements[flags]
+	if !isOK {
+		client.stats.Misses++
+
+		return "", false
+	}
+
+	entry, _ := element.Value.(*cacheEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		client.removeElement(element)
+		client.stats.Expirations++
+		client.stats.Misses++
+
+		return "", false
+	}
+
+	client.lru.MoveToFront(element)
+	client.stats.Hits++
+
+	return entry.value, true
+}
+
+func (client *Szengine) put(generation uint64, entityID int64, flags int64, value string, key *recordKey) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if generation != client.generation {
+		return
+	}
+
+	if client.lru == nil {
+		client.entities = map[int64]*entityState{}
+		client.lru = list.New()
+		client.records = map[recordKey]int64{}
+	}
+
+	state, isOK := client.entities[entityID]
+	if !isOK {
+		state = &entityState{
+			elements: map[int64]*list.Element{},
+			records:  map[recordKey]struct{}{},
+		}
+		client.entities[entityID] = state
+	}
+
+	entry := &cacheEntry{
+		entityID: entityID,
+		expires:  time.Time{},
+		flags:    flags,
+		value:    value,
+	}
+	if client.TTL > 0 {
+		entry.expires = time.Now().Add(client.TTL)
+	}
+
+	element, isOK := state.elements[flags]
+	if isOK {
+		element.Value = entry
+		client.lru.MoveToFront(element)
+	} else {
+		state.elements[flags] = client.lru.PushFront(entry)
+	}
+
+	if key != nil {
+		previousEntityID, isOK := client.records[*key]
+		if isOK && previousEntityID != entityID {
+			previousState, isOK := client.entities[previousEntityID]
+			if isOK {
+				delete(previousState.records, *key)
+			}
+		}
+
+		client.records[*key] = entityID
+		state.records[*key] = struct{}{}
+	}
+
+	maxEntries := client.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+
+	for client.lru.Len() > maxEntries {
+		client.removeElement(client.lru.Back())
+		client.stats.Evictions++
+	}
+}
+
+// Must be called with the mutex held.
+func (client *Szengine) removeElement(element *list.Element) {
+	entry, _ := element.Value.(*cacheEntry)
+	client.lru.Remove(element)
+
+	state := client.entities[entry.entityID]
+	delete(state.elements, entry.flags)
+
+	if len(state.elements) == 0 {
+		for key := range state.records {
+			delete(client.records, key)
+		}
+
+		delete(client.entities, entry.entityID)
+	}
+}
+
+// Must be called with the mutex held.
+func (client *Szengine) removeEntity(entityID int64) bool {
+	state, isOK := client.entities[entityID]
+	if !isOK {
+		return false
+	}
+
+	for _, element := range state.elements {
+		client.removeElement(element)
+	}
+
+	return true
+}
+
+func (client *Szengine) write(method func(szEngine senzing.SzEngine) (string, error)) (string, error) {
+	var invalidated bool
+
+	withInfoEngine := &withinfo.Szengine{
+		SzEngine:       client.SzEngine,
+		AlwaysWithInfo: client.AlwaysWithInfo,
+		Handler: func(_ context.Context, event withinfo.Event) {
+			client.Invalidate(event.AffectedEntityIDs...)
+
+			invalidated = true
+		},
+		Events: nil,
+		ErrorHandler: func(_ context.Context, _ error) {
+			client.Purge()
+
+			invalidated = true
+		},
+	}
+
+	result, err := method(withInfoEngine)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	if !invalidated {
+		client.Purge()
+	}
+
+	return result, nil
+}
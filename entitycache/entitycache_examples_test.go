@@ -0,0 +1,44 @@
+package entitycache_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/entitycache"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSzengine_GetEntityByEntityID() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/entitycache/entitycache_test.go
+	ctx := context.TODO()
+	szEngine := &entitycache.Szengine{ //exhaustruct:ignore
+		SzEngine:       getSzEngine(ctx),
+		AlwaysWithInfo: true,
+		MaxEntries:     1000,
+		TTL:            time.Minute,
+	}
+
+	for range 3 {
+		_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzEntityDefaultFlags)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	stats := szEngine.Stats()
+	fmt.Printf("hits: %d, misses: %d\n", stats.Hits, stats.Misses)
+	// Output: hits: 2, misses: 1
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzEngine(_ context.Context) senzing.SzEngine {
+	return newFakeSzEngine()
+}
@@ -0,0 +1,397 @@
+package entitycache_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/entitycache"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzengine_GetEntityByEntityID(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	for range 3 {
+		actual, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+		printDebug(test, err, actual)
+		require.NoError(test, err)
+		require.JSONEq(test, entityDocument(100, 1), actual)
+	}
+
+	_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzEntityIncludeEntityName)
+	require.NoError(test, err)
+	require.Equal(test, 2, fake.callCount())
+
+	stats := szEngine.Stats()
+	require.Equal(test, int64(2), stats.Hits)
+	require.Equal(test, int64(2), stats.Misses)
+	require.Equal(test, 2, stats.Entries)
+}
+
+func TestSzengine_GetEntityByEntityID_error(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+	_, err := szEngine.GetEntityByEntityID(ctx, -1, senzing.SzNoFlags)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+}
+
+func TestSzengine_GetEntityByRecordID(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	actual, err := szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.JSONEq(test, entityDocument(100, 1), actual)
+
+	// Both lookups share the cache entry of entity 100.
+
+	_, err = szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 1, fake.callCount())
+	require.Equal(test, int64(2), szEngine.Stats().Hits)
+}
+
+func TestSzengine_MaxEntries(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake, MaxEntries: 2} //exhaustruct:ignore
+
+	for _, entityID := range []int64{100, 101, 100, 102} {
+		_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+
+	stats := szEngine.Stats()
+	require.Equal(test, 2, stats.Entries)
+	require.Equal(test, int64(1), stats.Evictions)
+
+	// Entity 101 was least recently used.
+
+	_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 3, fake.callCount())
+	_, err = szEngine.GetEntityByEntityID(ctx, 101, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 4, fake.callCount())
+}
+
+func TestSzengine_TTL(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake, TTL: 10 * time.Millisecond} //exhaustruct:ignore
+
+	_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 2, fake.callCount())
+	require.Equal(test, int64(1), szEngine.Stats().Expirations)
+}
+
+func TestSzengine_AddRecord_withInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	for _, entityID := range []int64{100, 101, 102} {
+		_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+
+	fake.setAffected(100, 101)
+	actual, err := szEngine.AddRecord(ctx, "CUSTOMERS", "1009", "{}", senzing.SzWithInfo)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual)
+
+	stats := szEngine.Stats()
+	require.Equal(test, 1, stats.Entries)
+	require.Equal(test, int64(2), stats.Invalidations)
+
+	actual, err = szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, entityDocument(100, 2), actual)
+}
+
+func TestSzengine_AddRecord_alwaysWithInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake, AlwaysWithInfo: true} //exhaustruct:ignore
+
+	for _, entityID := range []int64{100, 101} {
+		_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+
+	fake.setAffected(101)
+	actual, err := szEngine.AddRecord(ctx, "CUSTOMERS", "1009", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Empty(test, actual)
+	require.Equal(test, 1, szEngine.Stats().Entries)
+}
+
+func TestSzengine_AddRecord_withoutInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	_, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	// The record was never seen by the cache, but merges into entity 100.
+
+	fake.setAffected(100)
+	_, err = szEngine.AddRecord(ctx, "CUSTOMERS", "1009", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+
+	actual, err := szEngine.GetEntityByEntityID(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, entityDocument(100, 2), actual)
+	require.Equal(test, 2, fake.callCount())
+}
+
+func TestSzengine_DeleteRecord_withoutInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	_, err := szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByEntityID(ctx, 101, senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	_, err = szEngine.DeleteRecord(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+
+	_, err = szEngine.GetEntityByEntityID(ctx, 101, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 3, fake.callCount())
+}
+
+func TestSzengine_ProcessRedoRecord_withoutInfo(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	for _, entityID := range []int64{100, 101} {
+		_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+
+	_, err := szEngine.ProcessRedoRecord(ctx, "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+}
+
+func TestSzengine_ReevaluateEntity(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	for _, entityID := range []int64{100, 101} {
+		_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+
+	_, err := szEngine.ReevaluateEntity(ctx, 100, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+}
+
+func TestSzengine_ReevaluateRecord(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+
+	_, err := szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.ReevaluateRecord(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 0, szEngine.Stats().Entries)
+}
+
+func TestSzengine_Purge(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake} //exhaustruct:ignore
+	_, err := szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	szEngine.Purge()
+	require.Equal(test, 0, szEngine.Stats().Entries)
+	_, err = szEngine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, 2, fake.callCount())
+}
+
+func TestSzengine_concurrent(test *testing.T) {
+	ctx := test.Context()
+	fake := newFakeSzEngine()
+	szEngine := &entitycache.Szengine{SzEngine: fake, MaxEntries: 5} //exhaustruct:ignore
+
+	var waitGroup sync.WaitGroup
+
+	for worker := range 8 {
+		waitGroup.Go(func() {
+			for index := range 100 {
+				entityID := int64(100 + (worker+index)%10)
+				_, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzNoFlags)
+				require.NoError(test, err)
+
+				if index%10 == 0 {
+					szEngine.Invalidate(entityID)
+				}
+			}
+		})
+	}
+
+	waitGroup.Wait()
+	require.LessOrEqual(test, szEngine.Stats().Entries, 5)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	affected []int64
+	calls    int
+	mutex    sync.Mutex
+	versions map[int64]int
+}
+
+func newFakeSzEngine() *fakeSzEngine {
+	return &fakeSzEngine{ //exhaustruct:ignore
+		versions: map[int64]int{},
+	}
+}
+
+func (engine *fakeSzEngine) AddRecord(_ context.Context, _ string, _ string, _ string, flags int64) (string, error) {
+	return engine.write(flags)
+}
+
+func (engine *fakeSzEngine) DeleteRecord(_ context.Context, _ string, _ string, flags int64) (string, error) {
+	return engine.write(flags)
+}
+
+func (engine *fakeSzEngine) GetEntityByEntityID(_ context.Context, entityID int64, _ int64) (string, error) {
+	if entityID < 0 {
+		return "", szerror.New(37, fmt.Sprintf(`{"reason":"SENZ0037|Unknown resolved entity value '%d'"}`, entityID))
+	}
+
+	return engine.read(entityID), nil
+}
+
+func (engine *fakeSzEngine) GetEntityByRecordID(_ context.Context, _ string, _ string, _ int64) (string, error) {
+	return engine.read(100), nil
+}
+
+func (engine *fakeSzEngine) ProcessRedoRecord(_ context.Context, _ string, flags int64) (string, error) {
+	return engine.write(flags)
+}
+
+func (engine *fakeSzEngine) ReevaluateEntity(_ context.Context, _ int64, flags int64) (string, error) {
+	return engine.write(flags)
+}
+
+func (engine *fakeSzEngine) ReevaluateRecord(_ context.Context, _ string, _ string, flags int64) (string, error) {
+	return engine.write(flags)
+}
+
+func (engine *fakeSzEngine) callCount() int {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	return engine.calls
+}
+
+func (engine *fakeSzEngine) read(entityID int64) string {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	engine.calls++
+
+	return entityDocument(entityID, engine.versions[entityID]+1)
+}
+
+func (engine *fakeSzEngine) setAffected(entityIDs ...int64) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	engine.affected = entityIDs
+}
+
+func (engine *fakeSzEngine) write(flags int64) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	for _, entityID := range engine.affected {
+		engine.versions[entityID]++
+	}
+
+	if flags&senzing.SzWithInfo == 0 {
+		return "", nil
+	}
+
+	result := `{"AFFECTED_ENTITIES":[`
+
+	for index, entityID := range engine.affected {
+		if index > 0 {
+			result += ","
+		}
+
+		result += fmt.Sprintf(`{"ENTITY_ID":%d}`, entityID)
+	}
+
+	return result + `],"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1009"}`, nil
+}
+
+func entityDocument(entityID int64, version int) string {
+	return fmt.Sprintf(`{"RESOLVED_ENTITY":{"ENTITY_ID":%d,"ENTITY_NAME":"version %d"}}`, entityID, version)
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package entitycache
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultMaxEntries is the maximum number of cached entity documents when Szengine.MaxEntries is not set.
+*/
+const DefaultMaxEntries = 10000
@@ -0,0 +1,16 @@
+/*
+Package explain produces human-readable reports from the JSON returned by
+SzEngine.WhyEntities, SzEngine.WhyRecords, SzEngine.WhyRecordInEntity and SzEngine.HowEntityByEntityID.
+
+A report describes, in plain language, which features matched, which candidate keys brought the
+entities together, how each feature score compares to the configured thresholds,
+and for "How" results, each step of the resolution.
+Reports can be rendered as Markdown or HTML.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package explain
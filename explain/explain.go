@@ -0,0 +1,573 @@
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Explainer calls SzEngine "Why" and "How" methods and converts their responses into a [Report].
+*/
+type Explainer struct {
+	SzEngine   senzing.SzEngine
+	Thresholds Thresholds // Optional. See [ThresholdsFromConfig].
+}
+
+/*
+Report is a human-readable explanation of a "Why" or "How" response.
+*/
+type Report struct {
+	Comparisons []Comparison
+	FinalState  []string
+	Steps       []Step
+	Summary     string
+	Title       string
+}
+
+/*
+Comparison explains the outcome of comparing two entities, two records, or a record and an entity.
+*/
+type Comparison struct {
+	CandidateKeys []CandidateKeyExplanation
+	Features      []FeatureExplanation
+	Heading       string
+	MatchKey      string
+	MatchLevel    string
+	Narrative     string
+	Rule          string
+}
+
+/*
+CandidateKeyExplanation lists the values of a candidate key type that brought the two sides together.
+*/
+type CandidateKeyExplanation struct {
+	KeyType string
+	Values  []string
+}
+
+/*
+FeatureExplanation explains the score of a single feature comparison.
+*/
+type FeatureExplanation struct {
+	Assessment string
+	Bucket     string
+	Candidate  string
+	Feature    string
+	Inbound    string
+	Role       string
+	Score      int64
+}
+
+/*
+Step explains a single resolution step of a "How" response.
+*/
+type Step struct {
+	Comparison  Comparison
+	Description string
+	Number      int64
+}
+
+/*
+Roles of a feature in a match, derived from the match key.
+*/
+const (
+	RoleConfirmed = "confirmed"
+	RoleDenied    = "denied"
+	RoleScored    = "scored"
+)
+
+// ----------------------------------------------------------------------------
+// Explainer methods
+// ----------------------------------------------------------------------------
+
+/*
+Method HowEntityByEntityID explains how an entity was resolved.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+
+Output
+  - A report describing each resolution step.
+*/
+func (explainer *Explainer) HowEntityByEntityID(ctx context.Context, entityID int64) (*Report, error) {
+	response, err := explainer.SzEngine.HowEntityByEntityID(ctx, entityID, senzing.SzHowEntityDefaultFlags)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "HowEntityByEntityID: %d", entityID)
+	}
+
+	return NewHowReport(response, entityID, explainer.Thresholds)
+}
+
+/*
+Method WhyEntities explains why two entities did or did not resolve.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID1: The entity ID for the starting entity of the search path.
+  - entityID2: The entity ID for the ending entity of the search path.
+
+Output
+  - A report describing the comparison.
+*/
+func (explainer *Explainer) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64) (*Report, error) {
+	response, err := explainer.SzEngine.WhyEntities(ctx, entityID1, entityID2, whyFlags)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "WhyEntities: %d, %d", entityID1, entityID2)
+	}
+
+	return NewWhyReport(response, explainer.Thresholds)
+}
+
+/*
+Method WhyRecordInEntity explains why a record belongs to its resolved entity.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+
+Output
+  - A report describing the comparison.
+*/
+func (explainer *Explainer) WhyRecordInEntity(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+) (*Report, error) {
+	response, err := explainer.SzEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, whyFlags)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "WhyRecordInEntity: %s:%s", dataSourceCode, recordID)
+	}
+
+	return NewWhyReport(response, explainer.Thresholds)
+}
+
+/*
+Method WhyRecords explains why two records did or did not resolve.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode1: Identifies the provenance of the data.
+  - recordID1: The unique identifier within the records of the same data source.
+  - dataSourceCode2: Identifies the provenance of the data.
+  - recordID2: The unique identifier within the records of the same data source.
+
+Output
+  - A report describing the comparison.
+*/
+func (explainer *Explainer) WhyRecords(
+	ctx context.Context,
+	dataSourceCode1 string,
+	recordID1 string,
+	dataSourceCode2 string,
+	recordID2 string,
+) (*Report, error) {
+	response, err := explainer.SzEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, whyFlags)
+	if err != nil {
+		return nil, wraperror.Errorf(
+			err,
+			"WhyRecords: %s:%s, %s:%s",
+			dataSourceCode1,
+			recordID1,
+			dataSourceCode2,
+			recordID2,
+		)
+	}
+
+	return NewWhyReport(response, explainer.Thresholds)
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewHowReport function builds a report from the JSON returned by SzEngine.HowEntityByEntityID.
+
+Input
+  - responseJSON: The JSON document returned by SzEngine.HowEntityByEntityID.
+  - entityID: The entity that was explained. Used only in the report title.
+  - thresholds: Optional scoring thresholds. May be nil.
+
+Output
+  - A report describing each resolution step and the final state.
+*/
+func NewHowReport(responseJSON string, entityID int64, thresholds Thresholds) (*Report, error) {
+	response := HowResponse{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(responseJSON), &response)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	steps := response.HowResults.ResolutionSteps
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Step < steps[j].Step })
+
+	result := &Report{
+		Comparisons: nil,
+		FinalState:  describeFinalState(response.HowResults.FinalState),
+		Steps:       make([]Step, 0, len(steps)),
+		Summary:     describeHowSummary(entityID, response.HowResults),
+		Title:       fmt.Sprintf("How entity %d was resolved", entityID),
+	}
+
+	for _, step := range steps {
+		comparison := buildComparison(
+			fmt.Sprintf("Step %d", step.Step),
+			step.MatchInfo.MatchKey,
+			step.MatchInfo.ErruleCode,
+			step.MatchInfo,
+			thresholds,
+		)
+		result.Steps = append(result.Steps, Step{
+			Comparison:  comparison,
+			Description: describeStep(step),
+			Number:      step.Step,
+		})
+	}
+
+	return result, nil
+}
+
+/*
+The NewWhyReport function builds a report from the JSON returned by SzEngine.WhyEntities,
+SzEngine.WhyRecords, or SzEngine.WhyRecordInEntity.
+
+Input
+  - responseJSON: The JSON document returned by one of the "Why" methods.
+  - thresholds: Optional scoring thresholds. May be nil.
+
+Output
+  - A report with one comparison per "WHY_RESULTS" entry.
+*/
+func NewWhyReport(responseJSON string, thresholds Thresholds) (*Report, error) {
+	response := WhyResponse{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(responseJSON), &response)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	if len(response.WhyResults) == 0 {
+		return nil, wraperror.Errorf(errForPackage, "response contains no WHY_RESULTS")
+	}
+
+	entityNames := map[int64]string{}
+	for _, entity := range response.Entities {
+		entityNames[entity.ResolvedEntity.EntityID] = entity.ResolvedEntity.EntityName
+	}
+
+	result := &Report{
+		Comparisons: make([]Comparison, 0, len(response.WhyResults)),
+		FinalState:  nil,
+		Steps:       nil,
+		Summary:     "",
+		Title:       "",
+	}
+
+	for _, whyResult := range response.WhyResults {
+		heading := describeWhySubjects(whyResult, entityNames)
+		comparison := buildComparison(
+			heading,
+			whyResult.MatchInfo.WhyKey,
+			whyResult.MatchInfo.WhyErruleCode,
+			whyResult.MatchInfo,
+			thresholds,
+		)
+		result.Comparisons = append(result.Comparisons, comparison)
+	}
+
+	result.Title = "Why " + result.Comparisons[0].Heading
+	result.Summary = result.Comparisons[0].Narrative
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+var whyFlags = senzing.Flags(senzing.SzIncludeFeatureScores, senzing.SzEntityIncludeEntityName)
+
+func buildComparison(
+	heading string,
+	matchKey string,
+	rule string,
+	matchInfo MatchInfo,
+	thresholds Thresholds,
+) Comparison {
+	confirmed, denied := parseMatchKey(matchKey)
+
+	result := Comparison{
+		CandidateKeys: describeCandidateKeys(matchInfo.CandidateKeys),
+		Features:      []FeatureExplanation{},
+		Heading:       heading,
+		MatchKey:      matchKey,
+		MatchLevel:    matchInfo.MatchLevelCode,
+		Narrative:     "",
+		Rule:          rule,
+	}
+
+	for _, featureType := range sortedKeys(matchInfo.FeatureScores) {
+		for _, featureScore := range matchInfo.FeatureScores[featureType] {
+			role := RoleScored
+
+			switch {
+			case confirmed[featureType]:
+				role = RoleConfirmed
+			case denied[featureType]:
+				role = RoleDenied
+			}
+
+			result.Features = append(result.Features, FeatureExplanation{
+				Assessment: describeScore(featureType, featureScore, thresholds),
+				Bucket:     featureScore.ScoreBucket,
+				Candidate:  featureScore.CandidateFeatDesc,
+				Feature:    featureType,
+				Inbound:    featureScore.InboundFeatDesc,
+				Role:       role,
+				Score:      featureScore.Score,
+			})
+		}
+	}
+
+	result.Narrative = describeOutcome(result.MatchLevel, rule, confirmed, denied)
+
+	return result
+}
+
+func describeCandidateKeys(candidateKeys map[string][]CandidateKey) []CandidateKeyExplanation {
+	result := make([]CandidateKeyExplanation, 0, len(candidateKeys))
+
+	for _, keyType := range sortedKeys(candidateKeys) {
+		values := make([]string, 0, len(candidateKeys[keyType]))
+		for _, candidateKey := range candidateKeys[keyType] {
+			values = append(values, candidateKey.FeatDesc)
+		}
+
+		result = append(result, CandidateKeyExplanation{
+			KeyType: keyType,
+			Values:  values,
+		})
+	}
+
+	return result
+}
+
+func describeFinalState(finalState FinalState) []string {
+	result := make([]string, 0, len(finalState.VirtualEntities))
+
+	for _, virtualEntity := range finalState.VirtualEntities {
+		result = append(result, fmt.Sprintf(
+			"Virtual entity %s contains %s.",
+			virtualEntity.VirtualEntityID,
+			describeMembers(virtualEntity),
+		))
+	}
+
+	if finalState.NeedReevaluation != 0 {
+		result = append(result, "The entity is flagged for re-evaluation.")
+	}
+
+	return result
+}
+
+func describeHowSummary(entityID int64, howResults HowResults) string {
+	stepCount := len(howResults.ResolutionSteps)
+	entityCount := len(howResults.FinalState.VirtualEntities)
+
+	switch {
+	case stepCount == 0:
+		return fmt.Sprintf("Entity %d consists of a single record; no resolution steps were needed.", entityID)
+	case entityCount > 1:
+		return fmt.Sprintf(
+			"Entity %d was built in %d step(s) and currently splits into %d virtual entities.",
+			entityID,
+			stepCount,
+			entityCount,
+		)
+	default:
+		return fmt.Sprintf("Entity %d was built in %d step(s).", entityID, stepCount)
+	}
+}
+
+func describeMembers(virtualEntity VirtualEntity) string {
+	recordKeys := []string{}
+
+	for _, memberRecord := range virtualEntity.MemberRecords {
+		for _, record := range memberRecord.Records {
+			recordKeys = append(recordKeys, record.DataSource+":"+record.RecordID)
+		}
+	}
+
+	if len(recordKeys) == 0 {
+		return "no records"
+	}
+
+	return strings.Join(recordKeys, ", ")
+}
+
+func describeOutcome(matchLevel string, rule string, confirmed map[string]bool, denied map[string]bool) string {
+	var resultBuilder strings.Builder
+
+	levelDescription, isOK := matchLevelDescriptions[matchLevel]
+	if !isOK {
+		return "Senzing found no relationship between these."
+	}
+
+	resultBuilder.WriteString("Senzing considers these " + levelDescription)
+
+	if len(rule) > 0 {
+		resultBuilder.WriteString(" (rule " + rule + ")")
+	}
+
+	if len(confirmed) > 0 {
+		resultBuilder.WriteString(" because " + joinWords(sortedKeys(confirmed)) + " matched")
+	}
+
+	if len(denied) > 0 {
+		resultBuilder.WriteString(", although " + joinWords(sortedKeys(denied)) + " did not")
+	}
+
+	resultBuilder.WriteString(".")
+
+	return resultBuilder.String()
+}
+
+func describeScore(featureType string, featureScore FeatureScore, thresholds Thresholds) string {
+	bucketDescription, isOK := bucketDescriptions[featureScore.ScoreBucket]
+	if !isOK {
+		bucketDescription = "scored"
+	}
+
+	result := fmt.Sprintf("%s (%s)", bucketDescription, featureScore.ScoreBucket)
+
+	threshold, isOK := thresholds[featureType]
+	if isOK {
+		result += "; " + threshold.Describe(featureScore.Score)
+	}
+
+	return result
+}
+
+func describeStep(step ResolutionStep) string {
+	return fmt.Sprintf(
+		"Virtual entity %s (%s) was compared with %s (%s), producing %s.",
+		step.VirtualEntity1.VirtualEntityID,
+		describeMembers(step.VirtualEntity1),
+		step.VirtualEntity2.VirtualEntityID,
+		describeMembers(step.VirtualEntity2),
+		step.ResultVirtualEntityID,
+	)
+}
+
+func describeWhySubjects(whyResult WhyResult, entityNames map[int64]string) string {
+	describeEntity := func(entityID int64) string {
+		name := entityNames[entityID]
+		if len(name) > 0 {
+			return fmt.Sprintf("entity %d (%s)", entityID, name)
+		}
+
+		return fmt.Sprintf("entity %d", entityID)
+	}
+
+	describeRecords := func(recordKeys []RecordKey) string {
+		keys := make([]string, 0, len(recordKeys))
+		for _, recordKey := range recordKeys {
+			keys = append(keys, recordKey.DataSource+":"+recordKey.RecordID)
+		}
+
+		return "record " + strings.Join(keys, ", ")
+	}
+
+	switch {
+	case len(whyResult.FocusRecords) > 0 && len(whyResult.FocusRecords2) > 0:
+		return fmt.Sprintf("%s in %s vs %s in %s",
+			describeRecords(whyResult.FocusRecords), describeEntity(whyResult.EntityID),
+			describeRecords(whyResult.FocusRecords2), describeEntity(whyResult.EntityID2))
+	case len(whyResult.FocusRecords) > 0:
+		return fmt.Sprintf("%s in %s", describeRecords(whyResult.FocusRecords), describeEntity(whyResult.EntityID))
+	default:
+		return fmt.Sprintf("%s vs %s", describeEntity(whyResult.EntityID), describeEntity(whyResult.EntityID2))
+	}
+}
+
+func joinWords(words []string) string {
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	default:
+		return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+	}
+}
+
+/*
+Parse a match key like "+NAME+DOB+ADDRESS(HOME)-SSN" into the confirmed and denied feature types.
+*/
+func parseMatchKey(matchKey string) (map[string]bool, map[string]bool) {
+	confirmed := map[string]bool{}
+	denied := map[string]bool{}
+
+	var (
+		current map[string]bool
+		token   strings.Builder
+		depth   int
+	)
+
+	flush := func() {
+		if current != nil && token.Len() > 0 {
+			current[token.String()] = true
+		}
+
+		token.Reset()
+	}
+
+	for _, character := range matchKey {
+		switch {
+		case character == '(':
+			depth++
+		case character == ')':
+			depth--
+		case depth > 0:
+			continue
+		case character == '+':
+			flush()
+
+			current = confirmed
+		case character == '-':
+			flush()
+
+			current = denied
+		default:
+			token.WriteRune(character)
+		}
+	}
+
+	flush()
+
+	return confirmed, denied
+}
+
+func sortedKeys[V any](aMap map[string]V) []string {
+	result := make([]string, 0, len(aMap))
+	for key := range aMap {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
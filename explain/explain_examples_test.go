@@ -0,0 +1,85 @@
+package explain_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/explain"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleNewWhyReport() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/explain/explain_test.go
+	whyEntitiesResponse := `{
+		"WHY_RESULTS": [{
+			"ENTITY_ID": 1,
+			"ENTITY_ID_2": 2,
+			"MATCH_INFO": {
+				"WHY_KEY": "+NAME+DOB",
+				"WHY_ERRULE_CODE": "CNAME_CFF",
+				"MATCH_LEVEL_CODE": "RESOLVED",
+				"FEATURE_SCORES": {
+					"NAME": [{"INBOUND_FEAT_DESC": "Robert Smith", "CANDIDATE_FEAT_DESC": "Bob Smith",
+						"SCORE": 90, "SCORE_BUCKET": "CLOSE"}]
+				}
+			}
+		}]
+	}`
+
+	report, err := explain.NewWhyReport(whyEntitiesResponse, nil)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(report.Summary)
+	// Output: Senzing considers these resolved into the same entity (rule CNAME_CFF) because DOB and NAME matched.
+}
+
+func ExampleReport_Render() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/explain/explain_test.go
+	whyEntitiesResponse := `{
+		"WHY_RESULTS": [{
+			"ENTITY_ID": 1,
+			"ENTITY_ID_2": 2,
+			"MATCH_INFO": {
+				"WHY_KEY": "+NAME",
+				"WHY_ERRULE_CODE": "SF1",
+				"MATCH_LEVEL_CODE": "RESOLVED",
+				"FEATURE_SCORES": {
+					"NAME": [{"INBOUND_FEAT_DESC": "Robert Smith", "CANDIDATE_FEAT_DESC": "Bob Smith",
+						"SCORE": 90, "SCORE_BUCKET": "CLOSE"}]
+				}
+			}
+		}]
+	}`
+
+	report, err := explain.NewWhyReport(whyEntitiesResponse, nil)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	markdown, err := report.Render(explain.FormatMarkdown)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(markdown)
+	// Output:
+	// # Why entity 1 vs entity 2
+	//
+	// Senzing considers these resolved into the same entity (rule SF1) because NAME matched.
+	//
+	// ## entity 1 vs entity 2
+	//
+	// Senzing considers these resolved into the same entity (rule SF1) because NAME matched.
+	//
+	// - Match level: RESOLVED
+	// - Match key: `+NAME`
+	// - Rule: SF1
+	//
+	// | Feature | Role | Inbound | Candidate | Score | Assessment |
+	// | --- | --- | --- | --- | ---: | --- |
+	// | NAME | confirmed | Robert Smith | Bob Smith | 90 | a close match (CLOSE) |
+}
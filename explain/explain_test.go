@@ -0,0 +1,331 @@
+package explain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/explain"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestExplainer_WhyEntities(test *testing.T) {
+	ctx := test.Context()
+	explainer := &explain.Explainer{
+		SzEngine:   &fakeSzEngine{whyResponse: whyEntitiesResponse}, //exhaustruct:ignore
+		Thresholds: getTestThresholds(test),
+	}
+	report, err := explainer.WhyEntities(ctx, 1, 2)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Equal(test, "Why entity 1 (Robert Smith) vs entity 2 (Bob Smith)", report.Title)
+	require.Len(test, report.Comparisons, 1)
+
+	comparison := report.Comparisons[0]
+	require.Equal(test, "RESOLVED", comparison.MatchLevel)
+	require.Equal(test, "CNAME_CFF_CEXCL", comparison.Rule)
+	require.Contains(test, comparison.Narrative, "ADDRESS and NAME matched")
+	require.Contains(test, comparison.Narrative, "DOB did not")
+	require.Len(test, comparison.Features, 3)
+	require.Equal(test, "ADDRESS", comparison.Features[0].Feature)
+	require.Equal(test, explain.RoleConfirmed, comparison.Features[0].Role)
+	require.Equal(test, explain.RoleDenied, comparison.Features[1].Role)
+	require.Contains(test, comparison.Features[2].Assessment, "at or above the close threshold of 88")
+	require.Equal(test, "NAME_KEY", comparison.CandidateKeys[0].KeyType)
+}
+
+func TestExplainer_WhyEntities_error(test *testing.T) {
+	ctx := test.Context()
+	explainer := &explain.Explainer{
+		SzEngine:   &fakeSzEngine{whyResponse: "not JSON"}, //exhaustruct:ignore
+		Thresholds: nil,
+	}
+	_, err := explainer.WhyEntities(ctx, 1, 2)
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestExplainer_WhyRecords(test *testing.T) {
+	ctx := test.Context()
+	explainer := &explain.Explainer{
+		SzEngine:   &fakeSzEngine{whyResponse: whyRecordsResponse}, //exhaustruct:ignore
+		Thresholds: nil,
+	}
+	report, err := explainer.WhyRecords(ctx, "CUSTOMERS", "1001", "CUSTOMERS", "1002")
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Equal(test, "Why record CUSTOMERS:1001 in entity 1 vs record CUSTOMERS:1002 in entity 1", report.Title)
+	require.Equal(test, "a close match (CLOSE)", report.Comparisons[0].Features[0].Assessment)
+}
+
+func TestExplainer_HowEntityByEntityID(test *testing.T) {
+	ctx := test.Context()
+	explainer := &explain.Explainer{
+		SzEngine:   &fakeSzEngine{howResponse: howEntityResponse}, //exhaustruct:ignore
+		Thresholds: nil,
+	}
+	report, err := explainer.HowEntityByEntityID(ctx, 1)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Equal(test, "How entity 1 was resolved", report.Title)
+	require.Equal(test, "Entity 1 was built in 2 step(s).", report.Summary)
+	require.Len(test, report.Steps, 2)
+	require.Equal(test, int64(1), report.Steps[0].Number)
+	require.Contains(test, report.Steps[0].Description, "CUSTOMERS:1001")
+	require.Equal(test, "+NAME+DOB+PHONE", report.Steps[0].Comparison.MatchKey)
+	require.Len(test, report.FinalState, 1)
+}
+
+func TestNewWhyReport_noResults(test *testing.T) {
+	_, err := explain.NewWhyReport(`{"WHY_RESULTS": []}`, nil)
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestReport_Render(test *testing.T) {
+	report, err := explain.NewWhyReport(whyEntitiesResponse, getTestThresholds(test))
+	require.NoError(test, err)
+
+	markdown, err := report.Render(explain.FormatMarkdown)
+	printDebug(test, err, markdown)
+	require.NoError(test, err)
+	require.Contains(test, markdown, "# Why entity 1 (Robert Smith) vs entity 2 (Bob Smith)")
+	require.Contains(test, markdown, "| NAME | confirmed | Robert Smith | Bob Smith | 90 |")
+	require.Contains(test, markdown, `1515 Adela Ln \| Unit 2`)
+
+	html, err := report.Render(explain.FormatHTML)
+	printDebug(test, err, html)
+	require.NoError(test, err)
+	require.Contains(test, html, "<td>NAME</td><td>confirmed</td>")
+	require.Contains(test, html, "<h2>entity 1 (Robert Smith) vs entity 2 (Bob Smith)</h2>")
+}
+
+func TestReport_Render_how(test *testing.T) {
+	report, err := explain.NewHowReport(howEntityResponse, 1, nil)
+	require.NoError(test, err)
+
+	markdown, err := report.Render(explain.FormatMarkdown)
+	printDebug(test, err, markdown)
+	require.NoError(test, err)
+	require.Contains(test, markdown, "## Resolution steps")
+	require.Contains(test, markdown, "### Step 2")
+	require.Contains(test, markdown, "## Final state")
+}
+
+func TestReport_Render_badFormat(test *testing.T) {
+	report, err := explain.NewHowReport(howEntityResponse, 1, nil)
+	require.NoError(test, err)
+
+	_, err = report.Render(explain.Format(99))
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestThresholdsFromConfig(test *testing.T) {
+	thresholds := getTestThresholds(test)
+	require.Equal(test, int64(88), thresholds["NAME"].Close)
+	require.Equal(test, int64(100), thresholds["ADDRESS"].Same)
+	require.Equal(test, explain.BucketLikely, thresholds["NAME"].Bucket(80))
+	require.Equal(test, explain.BucketNoChance, thresholds["NAME"].Bucket(10))
+	require.Contains(test, thresholds["NAME"].Describe(10), "below the unlikely threshold")
+}
+
+func TestThresholdsFromConfig_badJSON(test *testing.T) {
+	_, err := explain.ThresholdsFromConfig("{")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	howResponse string
+	whyResponse string
+}
+
+func (engine *fakeSzEngine) HowEntityByEntityID(_ context.Context, _ int64, _ int64) (string, error) {
+	return engine.howResponse, nil
+}
+
+func (engine *fakeSzEngine) WhyEntities(_ context.Context, _ int64, _ int64, _ int64) (string, error) {
+	return engine.whyResponse, nil
+}
+
+func (engine *fakeSzEngine) WhyRecords(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+	_ int64,
+) (string, error) {
+	return engine.whyResponse, nil
+}
+
+func getTestThresholds(t *testing.T) explain.Thresholds {
+	t.Helper()
+
+	thresholds, err := explain.ThresholdsFromConfig(configDefinition)
+	require.NoError(t, err)
+
+	return thresholds
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test data
+// ----------------------------------------------------------------------------
+
+const configDefinition = `{
+  "G2_CONFIG": {
+    "CFG_FTYPE": [
+      {"FTYPE_ID": 1, "FTYPE_CODE": "NAME"},
+      {"FTYPE_ID": 2, "FTYPE_CODE": "DOB"},
+      {"FTYPE_ID": 3, "FTYPE_CODE": "ADDRESS"}
+    ],
+    "CFG_CFCALL": [
+      {"CFCALL_ID": 1, "FTYPE_ID": 1, "CFUNC_ID": 10},
+      {"CFCALL_ID": 2, "FTYPE_ID": 2, "CFUNC_ID": 20},
+      {"CFCALL_ID": 3, "FTYPE_ID": 3, "CFUNC_ID": 30}
+    ],
+    "CFG_CFRTN": [
+      {"CFUNC_ID": 10, "FTYPE_ID": 0, "EXEC_ORDER": 1,
+       "SAME_SCORE": 100, "CLOSE_SCORE": 92, "LIKELY_SCORE": 90, "PLAUSIBLE_SCORE": 80, "UN_LIKELY_SCORE": 70},
+      {"CFUNC_ID": 10, "FTYPE_ID": 1, "EXEC_ORDER": 2,
+       "SAME_SCORE": 100, "CLOSE_SCORE": 88, "LIKELY_SCORE": 75, "PLAUSIBLE_SCORE": 60, "UN_LIKELY_SCORE": 50},
+      {"CFUNC_ID": 20, "FTYPE_ID": 0, "EXEC_ORDER": 1,
+       "SAME_SCORE": 100, "CLOSE_SCORE": 95, "LIKELY_SCORE": 85, "PLAUSIBLE_SCORE": 75, "UN_LIKELY_SCORE": 65},
+      {"CFUNC_ID": 30, "FTYPE_ID": 0, "EXEC_ORDER": 2,
+       "SAME_SCORE": 99, "CLOSE_SCORE": 90, "LIKELY_SCORE": 80, "PLAUSIBLE_SCORE": 70, "UN_LIKELY_SCORE": 60},
+      {"CFUNC_ID": 30, "FTYPE_ID": 0, "EXEC_ORDER": 1,
+       "SAME_SCORE": 100, "CLOSE_SCORE": 90, "LIKELY_SCORE": 80, "PLAUSIBLE_SCORE": 70, "UN_LIKELY_SCORE": 60}
+    ]
+  }
+}`
+
+const whyEntitiesResponse = `{
+  "WHY_RESULTS": [
+    {
+      "ENTITY_ID": 1,
+      "ENTITY_ID_2": 2,
+      "MATCH_INFO": {
+        "WHY_KEY": "+NAME+ADDRESS(HOME)-DOB",
+        "WHY_ERRULE_CODE": "CNAME_CFF_CEXCL",
+        "MATCH_LEVEL_CODE": "RESOLVED",
+        "CANDIDATE_KEYS": {
+          "NAME_KEY": [{"FEAT_ID": 5, "FEAT_DESC": "SMTH|RPRT"}]
+        },
+        "FEATURE_SCORES": {
+          "NAME": [
+            {"INBOUND_FEAT_DESC": "Robert Smith", "CANDIDATE_FEAT_DESC": "Bob Smith",
+             "SCORE": 90, "SCORE_BUCKET": "CLOSE", "SCORE_BEHAVIOR": "NAME"}
+          ],
+          "DOB": [
+            {"INBOUND_FEAT_DESC": "1978-11-12", "CANDIDATE_FEAT_DESC": "1987-12-11",
+             "SCORE": 50, "SCORE_BUCKET": "NO_CHANCE", "SCORE_BEHAVIOR": "FMES"}
+          ],
+          "ADDRESS": [
+            {"INBOUND_FEAT_DESC": "1515 Adela Ln | Unit 2", "CANDIDATE_FEAT_DESC": "1515 Adela Lane",
+             "SCORE": 100, "SCORE_BUCKET": "SAME", "SCORE_BEHAVIOR": "FF"}
+          ]
+        }
+      }
+    }
+  ],
+  "ENTITIES": [
+    {"RESOLVED_ENTITY": {"ENTITY_ID": 1, "ENTITY_NAME": "Robert Smith"}},
+    {"RESOLVED_ENTITY": {"ENTITY_ID": 2, "ENTITY_NAME": "Bob Smith"}}
+  ]
+}`
+
+const whyRecordsResponse = `{
+  "WHY_RESULTS": [
+    {
+      "ENTITY_ID": 1,
+      "ENTITY_ID_2": 1,
+      "FOCUS_RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}],
+      "FOCUS_RECORDS_2": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1002"}],
+      "MATCH_INFO": {
+        "WHY_KEY": "+NAME",
+        "WHY_ERRULE_CODE": "SF1",
+        "MATCH_LEVEL_CODE": "RESOLVED",
+        "FEATURE_SCORES": {
+          "NAME": [
+            {"INBOUND_FEAT_DESC": "Robert Smith", "CANDIDATE_FEAT_DESC": "Bob Smith",
+             "SCORE": 90, "SCORE_BUCKET": "CLOSE"}
+          ]
+        }
+      }
+    }
+  ],
+  "ENTITIES": [{"RESOLVED_ENTITY": {"ENTITY_ID": 1}}]
+}`
+
+const howEntityResponse = `{
+  "HOW_RESULTS": {
+    "RESOLUTION_STEPS": [
+      {
+        "STEP": 2,
+        "VIRTUAL_ENTITY_1": {"VIRTUAL_ENTITY_ID": "V1-S1",
+          "MEMBER_RECORDS": [{"INTERNAL_ID": 1, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]},
+                             {"INTERNAL_ID": 2, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1002"}]}]},
+        "VIRTUAL_ENTITY_2": {"VIRTUAL_ENTITY_ID": "V3",
+          "MEMBER_RECORDS": [{"INTERNAL_ID": 3, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1003"}]}]},
+        "INBOUND_VIRTUAL_ENTITY_ID": "V3",
+        "RESULT_VIRTUAL_ENTITY_ID": "V1-S2",
+        "MATCH_INFO": {"MATCH_KEY": "+NAME+DOB", "ERRULE_CODE": "CNAME_CFF", "MATCH_LEVEL_CODE": "RESOLVED"}
+      },
+      {
+        "STEP": 1,
+        "VIRTUAL_ENTITY_1": {"VIRTUAL_ENTITY_ID": "V1",
+          "MEMBER_RECORDS": [{"INTERNAL_ID": 1, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}]},
+        "VIRTUAL_ENTITY_2": {"VIRTUAL_ENTITY_ID": "V2",
+          "MEMBER_RECORDS": [{"INTERNAL_ID": 2, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1002"}]}]},
+        "INBOUND_VIRTUAL_ENTITY_ID": "V2",
+        "RESULT_VIRTUAL_ENTITY_ID": "V1-S1",
+        "MATCH_INFO": {"MATCH_KEY": "+NAME+DOB+PHONE", "ERRULE_CODE": "CNAME_CFF_CSTAB", "MATCH_LEVEL_CODE": "RESOLVED",
+          "FEATURE_SCORES": {"NAME": [{"INBOUND_FEAT_DESC": "Robert Smith", "CANDIDATE_FEAT_DESC": "Bob Smith",
+            "SCORE": 90, "SCORE_BUCKET": "CLOSE"}]}}
+      }
+    ],
+    "FINAL_STATE": {
+      "NEED_REEVALUATION": 0,
+      "VIRTUAL_ENTITIES": [
+        {"VIRTUAL_ENTITY_ID": "V1-S2",
+         "MEMBER_RECORDS": [{"INTERNAL_ID": 1, "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}]}
+      ]
+    }
+  }
+}`
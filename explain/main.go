@@ -0,0 +1,61 @@
+package explain
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Format identifies the output format of a rendered [Report].
+*/
+type Format int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+Supported report formats.
+*/
+const (
+	FormatMarkdown Format = iota // GitHub-flavored Markdown
+	FormatHTML                   // Self-contained HTML fragment
+)
+
+/*
+Score buckets returned by Senzing in the "SCORE_BUCKET" field of a feature score.
+*/
+const (
+	BucketSame      = "SAME"
+	BucketClose     = "CLOSE"
+	BucketLikely    = "LIKELY"
+	BucketPlausible = "PLAUSIBLE"
+	BucketUnlikely  = "UN_LIKELY"
+	BucketNoChance  = "NO_CHANCE"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("explain")
+
+var bucketDescriptions = map[string]string{
+	BucketSame:      "the same",
+	BucketClose:     "a close match",
+	BucketLikely:    "a likely match",
+	BucketPlausible: "a plausible match",
+	BucketUnlikely:  "an unlikely match",
+	BucketNoChance:  "not a match",
+}
+
+var matchLevelDescriptions = map[string]string{
+	"RESOLVED":         "resolved into the same entity",
+	"POSSIBLY_SAME":    "possibly the same entity",
+	"POSSIBLY_RELATED": "possibly related",
+	"NAME_ONLY":        "related by name only",
+	"DISCLOSED":        "related by a disclosed relationship",
+}
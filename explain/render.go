@@ -0,0 +1,193 @@
+package explain
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Render formats the report for display.
+
+Input
+  - format: One of the Format* constants.
+
+Output
+  - The rendered report.
+*/
+func (report *Report) Render(format Format) (string, error) {
+	switch format {
+	case FormatMarkdown:
+		return report.renderMarkdown(), nil
+	case FormatHTML:
+		return report.renderHTML()
+	default:
+		return "", wraperror.Errorf(errForPackage, "unknown format: %d", format)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (report *Report) renderHTML() (string, error) {
+	var buffer bytes.Buffer
+
+	err := htmlTemplate.Execute(&buffer, report)
+	if err != nil {
+		return "", wraperror.Errorf(err, "htmlTemplate.Execute")
+	}
+
+	return buffer.String(), nil
+}
+
+func (report *Report) renderMarkdown() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# %s\n\n", report.Title)
+
+	if len(report.Summary) > 0 {
+		fmt.Fprintf(&builder, "%s\n\n", report.Summary)
+	}
+
+	for _, comparison := range report.Comparisons {
+		writeMarkdownComparison(&builder, "##", comparison)
+	}
+
+	if len(report.Steps) > 0 {
+		builder.WriteString("## Resolution steps\n\n")
+
+		for _, step := range report.Steps {
+			fmt.Fprintf(&builder, "### Step %d\n\n%s\n\n", step.Number, step.Description)
+			writeMarkdownComparison(&builder, "####", step.Comparison)
+		}
+	}
+
+	if len(report.FinalState) > 0 {
+		builder.WriteString("## Final state\n\n")
+
+		for _, line := range report.FinalState {
+			fmt.Fprintf(&builder, "- %s\n", line)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	return strings.TrimRight(builder.String(), "\n") + "\n"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<div class="senzing-explain">
+<h1>{{.Title}}</h1>
+{{- if .Summary}}
+<p>{{.Summary}}</p>
+{{- end}}
+{{- range .Comparisons}}
+<h2>{{.Heading}}</h2>
+{{template "comparison" .}}
+{{- end}}
+{{- if .Steps}}
+<h2>Resolution steps</h2>
+{{- range .Steps}}
+<h3>Step {{.Number}}</h3>
+<p>{{.Description}}</p>
+{{template "comparison" .Comparison}}
+{{- end}}
+{{- end}}
+{{- if .FinalState}}
+<h2>Final state</h2>
+<ul>
+{{- range .FinalState}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</div>
+{{define "comparison"}}<p>{{.Narrative}}</p>
+<dl>
+<dt>Match level</dt><dd>{{or .MatchLevel "none"}}</dd>
+<dt>Match key</dt><dd>{{or .MatchKey "none"}}</dd>
+<dt>Rule</dt><dd>{{or .Rule "none"}}</dd>
+</dl>
+{{- if .Features}}
+<table>
+<thead><tr><th>Feature</th><th>Role</th><th>Inbound</th><th>Candidate</th><th>Score</th><th>Assessment</th></tr></thead>
+<tbody>
+{{- range .Features}}
+<tr><td>{{.Feature}}</td><td>{{.Role}}</td><td>{{.Inbound}}</td><td>{{.Candidate}}</td><td>{{.Score}}</td><td>{{.Assessment}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+{{- if .CandidateKeys}}
+<h4>Candidate keys</h4>
+<ul>
+{{- range .CandidateKeys}}
+<li>{{.KeyType}}: {{range $index, $value := .Values}}{{if $index}}, {{end}}{{$value}}{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{end}}`))
+
+func escapeMarkdown(value string) string {
+	value = strings.ReplaceAll(value, "|", `\|`)
+
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+func orNone(value string) string {
+	if len(value) == 0 {
+		return "none"
+	}
+
+	return value
+}
+
+func writeMarkdownComparison(builder *strings.Builder, headingLevel string, comparison Comparison) {
+	if headingLevel == "##" {
+		fmt.Fprintf(builder, "%s %s\n\n", headingLevel, comparison.Heading)
+	}
+
+	fmt.Fprintf(builder, "%s\n\n", comparison.Narrative)
+	fmt.Fprintf(builder, "- Match level: %s\n", orNone(comparison.MatchLevel))
+	fmt.Fprintf(builder, "- Match key: `%s`\n", orNone(comparison.MatchKey))
+	fmt.Fprintf(builder, "- Rule: %s\n\n", orNone(comparison.Rule))
+
+	if len(comparison.Features) > 0 {
+		builder.WriteString("| Feature | Role | Inbound | Candidate | Score | Assessment |\n")
+		builder.WriteString("| --- | --- | --- | --- | ---: | --- |\n")
+
+		for _, feature := range comparison.Features {
+			fmt.Fprintf(builder, "| %s | %s | %s | %s | %d | %s |\n",
+				escapeMarkdown(feature.Feature),
+				feature.Role,
+				escapeMarkdown(feature.Inbound),
+				escapeMarkdown(feature.Candidate),
+				feature.Score,
+				escapeMarkdown(feature.Assessment),
+			)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	if len(comparison.CandidateKeys) > 0 {
+		fmt.Fprintf(builder, "%s# Candidate keys\n\n", headingLevel)
+
+		for _, candidateKey := range comparison.CandidateKeys {
+			fmt.Fprintf(builder, "- %s: %s\n", candidateKey.KeyType, strings.Join(candidateKey.Values, ", "))
+		}
+
+		builder.WriteString("\n")
+	}
+}
@@ -0,0 +1,132 @@
+package explain
+
+// ----------------------------------------------------------------------------
+// Types - subset of the Senzing JSON responses used in reports
+// ----------------------------------------------------------------------------
+
+/*
+WhyResponse is the subset of the JSON returned by SzEngine.WhyEntities, SzEngine.WhyRecords,
+and SzEngine.WhyRecordInEntity used by this package.
+*/
+type WhyResponse struct {
+	Entities   []EntityResult `json:"ENTITIES"`
+	WhyResults []WhyResult    `json:"WHY_RESULTS"`
+}
+
+/*
+HowResponse is the subset of the JSON returned by SzEngine.HowEntityByEntityID used by this package.
+*/
+type HowResponse struct {
+	HowResults HowResults `json:"HOW_RESULTS"`
+}
+
+/*
+EntityResult describes an entity referenced by a "Why" response.
+*/
+type EntityResult struct {
+	ResolvedEntity ResolvedEntity `json:"RESOLVED_ENTITY"`
+}
+
+/*
+ResolvedEntity identifies an entity and, if requested by flags, its name.
+*/
+type ResolvedEntity struct {
+	EntityID   int64  `json:"ENTITY_ID"`
+	EntityName string `json:"ENTITY_NAME"`
+}
+
+/*
+WhyResult is a single comparison within a "Why" response.
+*/
+type WhyResult struct {
+	EntityID      int64       `json:"ENTITY_ID"`
+	EntityID2     int64       `json:"ENTITY_ID_2"`
+	FocusRecords  []RecordKey `json:"FOCUS_RECORDS"`
+	FocusRecords2 []RecordKey `json:"FOCUS_RECORDS_2"`
+	MatchInfo     MatchInfo   `json:"MATCH_INFO"`
+}
+
+/*
+RecordKey identifies a record by data source code and record identifier.
+*/
+type RecordKey struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+/*
+MatchInfo holds the match details of a "Why" result or a "How" resolution step.
+"Why" responses populate WhyKey and WhyErruleCode; "How" responses populate MatchKey and ErruleCode.
+*/
+type MatchInfo struct {
+	CandidateKeys  map[string][]CandidateKey `json:"CANDIDATE_KEYS"`
+	ErruleCode     string                    `json:"ERRULE_CODE"`
+	FeatureScores  map[string][]FeatureScore `json:"FEATURE_SCORES"`
+	MatchKey       string                    `json:"MATCH_KEY"`
+	MatchLevelCode string                    `json:"MATCH_LEVEL_CODE"`
+	WhyErruleCode  string                    `json:"WHY_ERRULE_CODE"`
+	WhyKey         string                    `json:"WHY_KEY"`
+}
+
+/*
+CandidateKey is a feature value that caused two entities to be compared.
+*/
+type CandidateKey struct {
+	FeatDesc string `json:"FEAT_DESC"`
+	FeatID   int64  `json:"FEAT_ID"`
+}
+
+/*
+FeatureScore is the comparison of an inbound feature value with a candidate feature value.
+*/
+type FeatureScore struct {
+	CandidateFeatDesc string `json:"CANDIDATE_FEAT_DESC"`
+	InboundFeatDesc   string `json:"INBOUND_FEAT_DESC"`
+	Score             int64  `json:"SCORE"`
+	ScoreBehavior     string `json:"SCORE_BEHAVIOR"`
+	ScoreBucket       string `json:"SCORE_BUCKET"`
+}
+
+/*
+HowResults holds the resolution steps and final state of a "How" response.
+*/
+type HowResults struct {
+	FinalState      FinalState       `json:"FINAL_STATE"`
+	ResolutionSteps []ResolutionStep `json:"RESOLUTION_STEPS"`
+}
+
+/*
+FinalState describes the virtual entities remaining after all resolution steps.
+*/
+type FinalState struct {
+	NeedReevaluation int64           `json:"NEED_REEVALUATION"`
+	VirtualEntities  []VirtualEntity `json:"VIRTUAL_ENTITIES"`
+}
+
+/*
+ResolutionStep is a single step in the resolution of an entity.
+*/
+type ResolutionStep struct {
+	InboundVirtualEntityID string        `json:"INBOUND_VIRTUAL_ENTITY_ID"`
+	MatchInfo              MatchInfo     `json:"MATCH_INFO"`
+	ResultVirtualEntityID  string        `json:"RESULT_VIRTUAL_ENTITY_ID"`
+	Step                   int64         `json:"STEP"`
+	VirtualEntity1         VirtualEntity `json:"VIRTUAL_ENTITY_1"`
+	VirtualEntity2         VirtualEntity `json:"VIRTUAL_ENTITY_2"`
+}
+
+/*
+VirtualEntity is an intermediate entity built during resolution.
+*/
+type VirtualEntity struct {
+	MemberRecords   []MemberRecord `json:"MEMBER_RECORDS"`
+	VirtualEntityID string         `json:"VIRTUAL_ENTITY_ID"`
+}
+
+/*
+MemberRecord lists the records sharing an internal identifier within a virtual entity.
+*/
+type MemberRecord struct {
+	InternalID int64       `json:"INTERNAL_ID"`
+	Records    []RecordKey `json:"RECORDS"`
+}
@@ -0,0 +1,178 @@
+package explain
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Threshold holds the minimum scores of each score bucket for a feature type,
+as configured in the CFG_CFRTN section of a Senzing configuration.
+*/
+type Threshold struct {
+	Close     int64 `json:"CLOSE_SCORE"`
+	Likely    int64 `json:"LIKELY_SCORE"`
+	Plausible int64 `json:"PLAUSIBLE_SCORE"`
+	Same      int64 `json:"SAME_SCORE"`
+	Unlikely  int64 `json:"UN_LIKELY_SCORE"`
+}
+
+/*
+Thresholds maps a feature type code (e.g. "NAME", "ADDRESS") to its scoring thresholds.
+*/
+type Thresholds map[string]Threshold
+
+type configDocument struct {
+	G2Config struct {
+		CfgCfcall []struct {
+			CfuncID int64 `json:"CFUNC_ID"`
+			FtypeID int64 `json:"FTYPE_ID"`
+		} `json:"CFG_CFCALL"`
+		CfgCfrtn []struct {
+			Threshold
+
+			CfuncID   int64 `json:"CFUNC_ID"`
+			ExecOrder int64 `json:"EXEC_ORDER"`
+			FtypeID   int64 `json:"FTYPE_ID"`
+		} `json:"CFG_CFRTN"`
+		CfgFtype []struct {
+			FtypeCode string `json:"FTYPE_CODE"`
+			FtypeID   int64  `json:"FTYPE_ID"`
+		} `json:"CFG_FTYPE"`
+	} `json:"G2_CONFIG"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ThresholdsFromConfig function extracts per-feature scoring thresholds from a Senzing configuration.
+
+For each feature type with a comparison call, the comparison return values specific to the feature type
+are preferred over the generic (FTYPE_ID = 0) return values.
+Among those, the return value with the lowest execution order is used.
+
+Input
+  - configDefinition: A Senzing configuration JSON document, as returned by SzConfig.Export.
+
+Output
+  - Thresholds keyed by feature type code.
+*/
+func ThresholdsFromConfig(configDefinition string) (Thresholds, error) {
+	result := Thresholds{}
+	document := configDocument{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(configDefinition), &document)
+	if err != nil {
+		return result, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	ftypeCodes := map[int64]string{}
+	for _, ftype := range document.G2Config.CfgFtype {
+		ftypeCodes[ftype.FtypeID] = ftype.FtypeCode
+	}
+
+	for _, cfcall := range document.G2Config.CfgCfcall {
+		ftypeCode, isOK := ftypeCodes[cfcall.FtypeID]
+		if !isOK {
+			continue
+		}
+
+		var (
+			best      *Threshold
+			bestOrder int64
+			bestExact bool
+		)
+
+		for index := range document.G2Config.CfgCfrtn {
+			cfrtn := &document.G2Config.CfgCfrtn[index]
+			if cfrtn.CfuncID != cfcall.CfuncID {
+				continue
+			}
+
+			isExact := cfrtn.FtypeID == cfcall.FtypeID
+			if !isExact && cfrtn.FtypeID != 0 {
+				continue
+			}
+
+			if best == nil || (isExact && !bestExact) || (isExact == bestExact && cfrtn.ExecOrder < bestOrder) {
+				best = &cfrtn.Threshold
+				bestOrder = cfrtn.ExecOrder
+				bestExact = isExact
+			}
+		}
+
+		if best != nil {
+			result[ftypeCode] = *best
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Threshold methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Bucket returns the score bucket a score falls into according to the threshold.
+
+Input
+  - score: A feature score from 0 to 100.
+
+Output
+  - One of the Bucket* constants.
+*/
+func (threshold Threshold) Bucket(score int64) string {
+	switch {
+	case score >= threshold.Same:
+		return BucketSame
+	case score >= threshold.Close:
+		return BucketClose
+	case score >= threshold.Likely:
+		return BucketLikely
+	case score >= threshold.Plausible:
+		return BucketPlausible
+	case score >= threshold.Unlikely:
+		return BucketUnlikely
+	default:
+		return BucketNoChance
+	}
+}
+
+/*
+Method Describe explains in plain language how a score compares to the threshold.
+
+Input
+  - score: A feature score from 0 to 100.
+
+Output
+  - A sentence fragment such as "92 is at or above the close threshold of 90 (same requires 100)".
+*/
+func (threshold Threshold) Describe(score int64) string {
+	switch threshold.Bucket(score) {
+	case BucketSame:
+		return fmt.Sprintf("%d is at or above the same threshold of %d", score, threshold.Same)
+	case BucketClose:
+		return fmt.Sprintf("%d is at or above the close threshold of %d (same requires %d)",
+			score, threshold.Close, threshold.Same)
+	case BucketLikely:
+		return fmt.Sprintf("%d is at or above the likely threshold of %d (close requires %d)",
+			score, threshold.Likely, threshold.Close)
+	case BucketPlausible:
+		return fmt.Sprintf("%d is at or above the plausible threshold of %d (likely requires %d)",
+			score, threshold.Plausible, threshold.Likely)
+	case BucketUnlikely:
+		return fmt.Sprintf("%d is at or above the unlikely threshold of %d (plausible requires %d)",
+			score, threshold.Unlikely, threshold.Plausible)
+	default:
+		return fmt.Sprintf("%d is below the unlikely threshold of %d", score, threshold.Unlikely)
+	}
+}
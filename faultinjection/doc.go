@@ -0,0 +1,23 @@
+/*
+Package faultinjection makes a Senzing gRPC connection fail on purpose, for chaos testing.
+
+An [Injector] is a pair of gRPC client interceptors, installed on the connection given to Szabstractfactory
+with Injector.DialOptions. Each [Fault] applies to some or all gRPC methods with a probability, and injects one of:
+
+  - [KindLatency]: a delay before the call.
+  - [KindStatus]: a gRPC status error instead of the call.
+  - [KindSenzingError]: a Senzing error, such as SENZ0010, instead of the call.
+    It is formatted as the server formats errors, so helper.ConvertGrpcError classifies it as the matching szerror.
+  - [KindStreamTruncation]: a streaming export that ends early, without an error.
+  - [KindConnectionDrop]: a codes.Unavailable error after the server has handled the call,
+    or part way through a stream, so the outcome of the call is unknown to the client.
+
+Random draws come from Injector.Seed, so a run with the same seed and the same sequence of calls injects the same faults.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package faultinjection
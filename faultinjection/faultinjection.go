@@ -0,0 +1,296 @@
+package faultinjection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Kind is the kind of a Fault.
+*/
+type Kind int
+
+/*
+Fault describes one kind of failure and when it happens.
+*/
+type Fault struct {
+	Kind Kind
+
+	// Optional. Full gRPC method names, such as szenginepb.SzEngine_AddRecord_FullMethodName,
+	// or service prefixes ending in "/", such as "/szengine.SzEngine/". If empty, the fault applies to every method.
+	Methods []string
+
+	// The chance, from 0 to 1, that a call gets the fault.
+	Probability float64
+
+	// KindStatus. The gRPC status code. If codes.OK, codes.Unknown is used.
+	Code codes.Code
+
+	// KindLatency. The delay before the call.
+	Latency time.Duration
+
+	// KindStatus and KindSenzingError. The error text, such as "Retry timeout exceeded".
+	Message string
+
+	// KindSenzingError. The Senzing error code, such as 10 for "SENZ0010".
+	SenzingErrorCode int
+
+	// KindStreamTruncation and KindConnectionDrop. The number of stream messages delivered before the fault.
+	TruncateAfter int
+}
+
+/*
+Injection is a fault that was injected.
+*/
+type Injection struct {
+	Kind   Kind
+	Method string
+}
+
+/*
+Injector injects faults into the calls made through a gRPC connection.
+*/
+type Injector struct {
+	Faults []Fault
+
+	// Optional. The seed of the random draws.
+	Seed uint64
+
+	injections []Injection
+	mutex      sync.Mutex // Guards injections and random.
+	random     *rand.Rand
+}
+
+/*
+A stream that ends, or fails, after a number of messages.
+*/
+type faultyStream struct {
+	grpc.ClientStream
+
+	err       error // io.EOF for a truncation.
+	remaining int   // Messages to deliver before err.
+}
+
+// ----------------------------------------------------------------------------
+// Injector methods
+// ----------------------------------------------------------------------------
+
+/*
+Method DialOptions returns the options that add the injector to a connection, such as one made with grpc.NewClient.
+
+Output
+  - The dial options.
+*/
+func (injector *Injector) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(injector.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(injector.StreamClientInterceptor),
+	}
+}
+
+/*
+Method Injections lists the faults injected so far.
+
+Output
+  - The injections, in the order they were made.
+*/
+func (injector *Injector) Injections() []Injection {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	return append([]Injection{}, injector.injections...)
+}
+
+/*
+Method StreamClientInterceptor is a grpc.StreamClientInterceptor that injects faults into streams.
+*/
+func (injector *Injector) StreamClientInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	connection *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	options ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	var streamFault *Fault
+
+	for _, fault := range injector.draw(method) {
+		switch fault.Kind {
+		case KindLatency:
+			err := sleep(ctx, fault.Latency)
+			if err != nil {
+				return nil, err
+			}
+		case KindStatus, KindSenzingError:
+			return nil, fault.err()
+		case KindStreamTruncation, KindConnectionDrop:
+			if streamFault == nil {
+				streamFault = &fault
+			}
+		}
+	}
+
+	clientStream, err := streamer(ctx, desc, connection, method, options...)
+	if err != nil || streamFault == nil {
+		return clientStream, err
+	}
+
+	result := &faultyStream{
+		ClientStream: clientStream,
+		err:          io.EOF,
+		remaining:    streamFault.TruncateAfter,
+	}
+
+	if streamFault.Kind == KindConnectionDrop {
+		result.err = streamFault.err()
+	}
+
+	return result, nil
+}
+
+/*
+Method UnaryClientInterceptor is a grpc.UnaryClientInterceptor that injects faults into unary calls.
+*/
+func (injector *Injector) UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	request any,
+	reply any,
+	connection *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	options ...grpc.CallOption,
+) error {
+	dropConnection := false
+
+	for _, fault := range injector.draw(method) {
+		switch fault.Kind {
+		case KindLatency:
+			err := sleep(ctx, fault.Latency)
+			if err != nil {
+				return err
+			}
+		case KindStatus, KindSenzingError:
+			return fault.err()
+		case KindConnectionDrop:
+			dropConnection = true
+		case KindStreamTruncation:
+		}
+	}
+
+	err := invoker(ctx, method, request, reply, connection, options...)
+	if dropConnection {
+		return status.Error(codes.Unavailable, connectionDropMessage) //nolint:wrapcheck
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// faultyStream methods
+// ----------------------------------------------------------------------------
+
+func (stream *faultyStream) RecvMsg(message any) error {
+	if stream.remaining <= 0 {
+		return stream.err
+	}
+
+	stream.remaining--
+
+	return stream.ClientStream.RecvMsg(message) //nolint:wrapcheck // Callers compare with io.EOF.
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Draw the faults that apply to a call, in the order of Faults.
+Every fault for the method takes a draw, so that the sequence of draws depends only on the sequence of calls.
+*/
+func (injector *Injector) draw(method string) []Fault {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	if injector.random == nil {
+		injector.random = rand.New(rand.NewPCG(injector.Seed, injector.Seed)) //nolint:gosec // Repeatable by design.
+	}
+
+	result := []Fault{}
+
+	for _, fault := range injector.Faults {
+		if !fault.appliesTo(method) {
+			continue
+		}
+
+		if injector.random.Float64() < fault.Probability {
+			result = append(result, fault)
+			injector.injections = append(injector.injections, Injection{Kind: fault.Kind, Method: method})
+		}
+	}
+
+	return result
+}
+
+func (fault *Fault) appliesTo(method string) bool {
+	if len(fault.Methods) == 0 {
+		return true
+	}
+
+	for _, candidate := range fault.Methods {
+		if candidate == method || strings.HasSuffix(candidate, "/") && strings.HasPrefix(method, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (fault *Fault) err() error {
+	switch fault.Kind {
+	case KindConnectionDrop:
+		return status.Error(codes.Unavailable, connectionDropMessage) //nolint:wrapcheck
+	case KindSenzingError:
+		reason, _ := json.Marshal(map[string]string{ //nolint:errchkjson
+			"reason": fmt.Sprintf("SENZ%04d|%s", fault.SenzingErrorCode, fault.Message),
+		})
+
+		return status.Error(codes.Unknown, string(reason)) //nolint:wrapcheck
+	default:
+		code := fault.Code
+		if code == codes.OK {
+			code = codes.Unknown
+		}
+
+		return status.Error(code, fault.Message) //nolint:wrapcheck
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
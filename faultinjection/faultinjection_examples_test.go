@@ -0,0 +1,46 @@
+package faultinjection_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/faultinjection"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleInjector_DialOptions() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/faultinjection/faultinjection_test.go
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{
+				Kind:        faultinjection.KindLatency,
+				Probability: 0.2,
+				Latency:     500 * time.Millisecond,
+			},
+			{
+				Kind:             faultinjection.KindSenzingError,
+				Methods:          []string{szenginepb.SzEngine_AddRecord_FullMethodName},
+				Probability:      0.05,
+				Message:          "Retry timeout exceeded",
+				SenzingErrorCode: 10,
+			},
+			{
+				Kind:          faultinjection.KindStreamTruncation,
+				Methods:       []string{szenginepb.SzEngine_StreamExportJsonEntityReport_FullMethodName},
+				Probability:   0.1,
+				TruncateAfter: 1000,
+			},
+		},
+		Seed: 42,
+	} //exhaustruct:ignore
+
+	// Pass the dial options to grpc.NewClient, and the connection to Szabstractfactory.
+
+	dialOptions := injector.DialOptions()
+	fmt.Println(len(dialOptions))
+	// Output: 2
+}
@@ -0,0 +1,295 @@
+package faultinjection_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/faultinjection"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/bufconntest"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+var exportLines = []string{
+	`{"RESOLVED_ENTITY": {"ENTITY_ID": 1}}` + "\n",
+	`{"RESOLVED_ENTITY": {"ENTITY_ID": 2}}` + "\n",
+	`{"RESOLVED_ENTITY": {"ENTITY_ID": 3}}` + "\n",
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestInjector_SenzingError(test *testing.T) {
+	ctx := test.Context()
+	server := &fakeServer{} //exhaustruct:ignore
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{
+				Kind:             faultinjection.KindSenzingError,
+				Methods:          []string{szenginepb.SzEngine_AddRecord_FullMethodName},
+				Probability:      1,
+				Message:          "Retry timeout exceeded",
+				SenzingErrorCode: 10,
+			},
+		},
+	} //exhaustruct:ignore
+	szEngine, szProduct := getTestObjects(test, server, injector)
+
+	_, err := szEngine.AddRecord(ctx, "TEST", "1", `{}`, senzing.SzNoFlags)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+	require.Zero(test, server.addRecordCalls.Load())
+
+	// Other methods are not affected.
+
+	_, err = szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	require.Equal(test,
+		[]faultinjection.Injection{{Kind: faultinjection.KindSenzingError, Method: szenginepb.SzEngine_AddRecord_FullMethodName}},
+		injector.Injections())
+}
+
+func TestInjector_Status(test *testing.T) {
+	ctx := test.Context()
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{
+				Kind:        faultinjection.KindStatus,
+				Methods:     []string{"/szproduct.SzProduct/"},
+				Probability: 1,
+				Code:        codes.ResourceExhausted,
+				Message:     "too many requests",
+			},
+		},
+	} //exhaustruct:ignore
+	_, szProduct := getTestObjects(test, &fakeServer{}, injector) //exhaustruct:ignore
+
+	_, err := szProduct.GetVersion(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "too many requests")
+}
+
+func TestInjector_Latency(test *testing.T) {
+	ctx := test.Context()
+	latency := 20 * time.Millisecond
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{Kind: faultinjection.KindLatency, Probability: 1, Latency: latency},
+		},
+	} //exhaustruct:ignore
+	_, szProduct := getTestObjects(test, &fakeServer{}, injector) //exhaustruct:ignore
+
+	start := time.Now()
+	_, err := szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	require.GreaterOrEqual(test, time.Since(start), latency)
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = szProduct.GetVersion(canceledCtx)
+	require.Error(test, err)
+}
+
+func TestInjector_StreamTruncation(test *testing.T) {
+	ctx := test.Context()
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{Kind: faultinjection.KindStreamTruncation, Probability: 1, TruncateAfter: 1},
+		},
+	} //exhaustruct:ignore
+	szEngine, _ := getTestObjects(test, &fakeServer{}, injector) //exhaustruct:ignore
+
+	lines := []string{}
+
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		require.NoError(test, fragment.Error)
+		lines = append(lines, fragment.Value)
+	}
+
+	require.Equal(test, exportLines[:1], lines)
+}
+
+func TestInjector_ConnectionDrop(test *testing.T) {
+	ctx := test.Context()
+	server := &fakeServer{} //exhaustruct:ignore
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{Kind: faultinjection.KindConnectionDrop, Probability: 1, TruncateAfter: 2},
+		},
+	} //exhaustruct:ignore
+	szEngine, _ := getTestObjects(test, server, injector)
+
+	// The server handles the call, but the client gets an error.
+
+	_, err := szEngine.AddRecord(ctx, "TEST", "1", `{}`, senzing.SzNoFlags)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "connection dropped")
+	require.Equal(test, int64(1), server.addRecordCalls.Load())
+
+	lines := []string{}
+
+	var streamErr error
+
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		if fragment.Error != nil {
+			streamErr = fragment.Error
+
+			continue
+		}
+
+		lines = append(lines, fragment.Value)
+	}
+
+	require.Equal(test, exportLines[:2], lines)
+	require.ErrorContains(test, streamErr, "connection dropped")
+}
+
+func TestInjector_Seed(test *testing.T) {
+	ctx := test.Context()
+	newInjector := func(seed uint64) *faultinjection.Injector {
+		return &faultinjection.Injector{
+			Faults: []faultinjection.Fault{
+				{Kind: faultinjection.KindStatus, Probability: 0.5, Code: codes.Unavailable},
+			},
+			Seed: seed,
+		} //exhaustruct:ignore
+	}
+
+	failures := func(injector *faultinjection.Injector) []bool {
+		_, szProduct := getTestObjects(test, &fakeServer{}, injector) //exhaustruct:ignore
+		result := []bool{}
+
+		for range 40 {
+			_, err := szProduct.GetVersion(ctx)
+			result = append(result, err != nil)
+		}
+
+		return result
+	}
+
+	first := failures(newInjector(42))
+	require.Equal(test, first, failures(newInjector(42)))
+	require.NotEqual(test, first, failures(newInjector(43)))
+	require.Contains(test, first, true)
+	require.Contains(test, first, false)
+}
+
+func TestInjector_noFaults(test *testing.T) {
+	ctx := test.Context()
+	injector := &faultinjection.Injector{
+		Faults: []faultinjection.Fault{
+			{Kind: faultinjection.KindStatus, Probability: 0},
+		},
+	} //exhaustruct:ignore
+	_, szProduct := getTestObjects(test, &fakeServer{}, injector) //exhaustruct:ignore
+
+	for range 10 {
+		_, err := szProduct.GetVersion(ctx)
+		require.NoError(test, err)
+	}
+
+	require.Empty(test, injector.Injections())
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeServer struct {
+	szenginepb.UnimplementedSzEngineServer
+	szproductpb.UnimplementedSzProductServer
+
+	addRecordCalls atomic.Int64
+}
+
+func (server *fakeServer) AddRecord(
+	_ context.Context,
+	_ *szenginepb.AddRecordRequest,
+) (*szenginepb.AddRecordResponse, error) {
+	server.addRecordCalls.Add(1)
+
+	return &szenginepb.AddRecordResponse{Result: "{}"}, nil
+}
+
+func (server *fakeServer) GetVersion(
+	_ context.Context,
+	_ *szproductpb.GetVersionRequest,
+) (*szproductpb.GetVersionResponse, error) {
+	return &szproductpb.GetVersionResponse{Result: `{"VERSION": "4.1.1"}`}, nil
+}
+
+func (server *fakeServer) StreamExportJsonEntityReport(
+	_ *szenginepb.StreamExportJsonEntityReportRequest,
+	stream grpc.ServerStreamingServer[szenginepb.StreamExportJsonEntityReportResponse],
+) error {
+	for _, line := range exportLines {
+		err := stream.Send(&szenginepb.StreamExportJsonEntityReportResponse{Result: line})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+Serve SzEngine and SzProduct over an in-memory connection with the injector installed.
+*/
+func getTestObjects(
+	t *testing.T,
+	fake *fakeServer,
+	injector *faultinjection.Injector,
+) (senzing.SzEngine, senzing.SzProduct) {
+	t.Helper()
+	ctx := t.Context()
+
+	connection := bufconntest.Connection(t, func(server *grpc.Server) {
+		szenginepb.RegisterSzEngineServer(server, fake)
+		szproductpb.RegisterSzProductServer(server, fake)
+	}, injector.DialOptions()...)
+
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{GrpcConnection: connection} //exhaustruct:ignore
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(t, err)
+
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(t, err)
+
+	return szEngine, szProduct
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
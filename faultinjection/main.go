@@ -0,0 +1,16 @@
+package faultinjection
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Kinds of fault. See Fault.
+const (
+	KindLatency Kind = iota + 1
+	KindStatus
+	KindSenzingError
+	KindStreamTruncation
+	KindConnectionDrop
+)
+
+const connectionDropMessage = "faultinjection: connection dropped"
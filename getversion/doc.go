@@ -0,0 +1,14 @@
+/*
+Package getversion reports the version of the Senzing product behind a Senzing client.
+
+[GetVersion] returns the whole SzProduct.GetVersion document as a [SenzingVersion];
+[RequireVersion] checks it against a constraint such as ">=4.1" and is meant to be called at startup.
+[GetSenzingVersion] is the older, panicking form that packs the version into a single integer.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package getversion
@@ -17,7 +17,22 @@ const (
 	semanticVersionParts = 3
 )
 
+/*
+SenzingVersionResponse is the JSON document returned by SzProduct.GetVersion.
+*/
 type SenzingVersionResponse struct {
+	BuildDate            string `json:"BUILD_DATE"`
+	BuildNumber          string `json:"BUILD_NUMBER"`
+	BuildVersion         string `json:"BUILD_VERSION"`
+	CompatibilityVersion struct {
+		ConfigVersion string `json:"CONFIG_VERSION"`
+	} `json:"COMPATIBILITY_VERSION"`
+	ProductName   string `json:"PRODUCT_NAME"`
+	SchemaVersion struct {
+		EngineSchemaVersion          string `json:"ENGINE_SCHEMA_VERSION"`
+		MaximumRequiredSchemaVersion string `json:"MAXIMUM_REQUIRED_SCHEMA_VERSION"`
+		MinimumRequiredSchemaVersion string `json:"MINIMUM_REQUIRED_SCHEMA_VERSION"`
+	} `json:"SCHEMA_VERSION"`
 	Version string `json:"VERSION"`
 }
 
@@ -29,6 +44,7 @@ The GetSenzingVersion function returns an integer in the form MMmmPP where:
 For instance 40103 is semantic version 4.1.3.
 
 Limitations: neither Major, Minor, nor Patch can be greater than 99.
+GetSenzingVersion panics if the version cannot be read; GetVersion returns an error instead.
 
 Input
   - ctx: a context.
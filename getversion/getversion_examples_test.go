@@ -0,0 +1,65 @@
+package getversion_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/getversion"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleGetVersion() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/getversion/getversion_test.go
+	ctx := context.TODO()
+	szProduct := getSzProduct(ctx)
+
+	senzingVersion, err := getversion.GetVersion(ctx, szProduct)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(senzingVersion.Version, senzingVersion.BuildDate.Format("2006-01-02"))
+	// Output: 4.1.1 2025-10-10
+}
+
+func ExampleRequireVersion() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/getversion/getversion_test.go
+	ctx := context.TODO()
+	szProduct := getSzProduct(ctx)
+
+	err := getversion.RequireVersion(ctx, szProduct, ">=4.1, <5")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(err == nil)
+	// Output: true
+}
+
+func ExampleVersion_Satisfies() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/getversion/getversion_test.go
+	version, err := getversion.ParseVersion("4.1.1")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	isSatisfied, err := version.Satisfies(">=4.2")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(isSatisfied)
+	// Output: false
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzProduct(_ context.Context) senzing.SzProduct {
+	return &fakeSzProduct{versionJSON: versionJSON} //exhaustruct:ignore
+}
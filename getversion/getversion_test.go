@@ -2,14 +2,26 @@ package getversion_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	truncator "github.com/aquilax/truncate"
 	"github.com/senzing-garage/sz-sdk-go-grpc/getversion"
 	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 )
 
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
 var (
 	grpcAddress    = "0.0.0.0:8261"
 	grpcConnection *grpc.ClientConn
@@ -26,6 +38,136 @@ func TestVersion_GetSenzingVersion(test *testing.T) {
 	assert.NotEmpty(test, x)
 }
 
+// ----------------------------------------------------------------------------
+// Structured version - test
+// ----------------------------------------------------------------------------
+
+func TestVersion_GetVersion(test *testing.T) {
+	ctx := test.Context()
+	senzingVersion, err := getversion.GetVersion(ctx, &fakeSzProduct{versionJSON: versionJSON}) //exhaustruct:ignore
+	printDebug(test, err, senzingVersion)
+	require.NoError(test, err)
+	require.Equal(test, getversion.Version{Major: 4, Minor: 1, Patch: 1}, senzingVersion.Version)
+	require.Equal(test, 4, senzingVersion.Major)
+	require.Equal(test, time.Date(2025, time.October, 10, 0, 0, 0, 0, time.UTC), senzingVersion.BuildDate)
+	require.Equal(test, "2025_10_10__15_01", senzingVersion.BuildNumber)
+	require.Equal(test, "4.1.1.25283", senzingVersion.BuildVersion)
+	require.Equal(test, "11", senzingVersion.CompatibilityVersion.ConfigVersion)
+	require.Equal(test, "Senzing SDK", senzingVersion.ProductName)
+	require.Equal(test, "4.1", senzingVersion.SchemaVersion.EngineSchemaVersion)
+}
+
+func TestVersion_GetVersion_error(test *testing.T) {
+	ctx := test.Context()
+	szProduct := &fakeSzProduct{
+		err: szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`),
+	} //exhaustruct:ignore
+	_, err := getversion.GetVersion(ctx, szProduct)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+}
+
+func TestVersion_ParseSenzingVersion_bad(test *testing.T) {
+	badVersionJSONs := []string{
+		"}{",
+		"{}",
+		`{"VERSION": "4.x"}`,
+		`{"VERSION": "4.1.1", "BUILD_DATE": "10/10/2025"}`,
+	}
+
+	for _, badVersionJSON := range badVersionJSONs {
+		_, err := getversion.ParseSenzingVersion(badVersionJSON)
+		printDebug(test, err)
+		require.Error(test, err, badVersionJSON)
+	}
+}
+
+func TestVersion_ParseVersion(test *testing.T) {
+	testCases := []struct {
+		version  string
+		expected getversion.Version
+	}{
+		{version: "4", expected: getversion.Version{Major: 4}},
+		{version: "4.1", expected: getversion.Version{Major: 4, Minor: 1}},
+		{version: "4.1.1", expected: getversion.Version{Major: 4, Minor: 1, Patch: 1}},
+		{version: " 4.100.123 ", expected: getversion.Version{Major: 4, Minor: 100, Patch: 123}},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := getversion.ParseVersion(testCase.version)
+		require.NoError(test, err)
+		require.Equal(test, testCase.expected, actual)
+	}
+
+	for _, version := range []string{"", "4.", "4.1.1.25283", "v4.1", "4.-1"} {
+		_, err := getversion.ParseVersion(version)
+		printDebug(test, err)
+		require.Error(test, err, version)
+	}
+}
+
+func TestVersion_Compare(test *testing.T) {
+	older := getversion.Version{Major: 4, Minor: 99, Patch: 100}
+	newer := getversion.Version{Major: 4, Minor: 100}
+	require.Equal(test, -1, older.Compare(newer))
+	require.Equal(test, 1, newer.Compare(older))
+	require.Equal(test, 0, older.Compare(older))
+	require.True(test, newer.AtLeast(older))
+	require.True(test, newer.AtLeast(newer))
+	require.False(test, older.AtLeast(newer))
+	require.Equal(test, "4.100.0", newer.String())
+}
+
+func TestVersion_Satisfies(test *testing.T) {
+	version := getversion.Version{Major: 4, Minor: 1, Patch: 1}
+	testCases := []struct {
+		constraint string
+		expected   bool
+	}{
+		{constraint: ">=4.1", expected: true},
+		{constraint: ">=4.2", expected: false},
+		{constraint: ">4.1", expected: true},
+		{constraint: ">4.1.1", expected: false},
+		{constraint: "<5", expected: true},
+		{constraint: "<=4.1.1", expected: true},
+		{constraint: "<4.1.1", expected: false},
+		{constraint: "4.1.1", expected: true},
+		{constraint: "=4.1.1", expected: true},
+		{constraint: "==4.1", expected: false},
+		{constraint: "!=4.1.0", expected: true},
+		{constraint: ">=4.1, <5", expected: true},
+		{constraint: ">=4.0, <4.1", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := version.Satisfies(testCase.constraint)
+		require.NoError(test, err, testCase.constraint)
+		require.Equal(test, testCase.expected, actual, testCase.constraint)
+	}
+
+	for _, versionConstraint := range []string{"", ">=", "~>4.1", ">=4.1,", "=>4.1"} {
+		_, err := version.Satisfies(versionConstraint)
+		printDebug(test, err)
+		require.Error(test, err, versionConstraint)
+	}
+}
+
+func TestVersion_RequireVersion(test *testing.T) {
+	ctx := test.Context()
+	szProduct := &fakeSzProduct{versionJSON: versionJSON} //exhaustruct:ignore
+	require.NoError(test, getversion.RequireVersion(ctx, szProduct, ">=4.1"))
+
+	err := getversion.RequireVersion(ctx, szProduct, ">=4.2")
+	printDebug(test, err)
+	require.ErrorIs(test, err, getversion.ErrUnsupportedVersion)
+	require.ErrorContains(test, err, "4.1.1")
+
+	err = getversion.RequireVersion(ctx, szProduct, "latest")
+	printDebug(test, err)
+	require.Error(test, err)
+	require.NotErrorIs(test, err, getversion.ErrUnsupportedVersion)
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -46,8 +188,41 @@ func getGrpcConnection(ctx context.Context) *grpc.ClientConn {
 	return grpcConnection
 }
 
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
+
 func panicOnError(err error) {
 	if err != nil {
 		panic(err)
 	}
 }
+
+const versionJSON = `{"BUILD_DATE": "2025-10-10", "BUILD_NUMBER": "2025_10_10__15_01",` +
+	` "BUILD_VERSION": "4.1.1.25283", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}, "PRODUCT_NAME": "Senzing SDK", "SCHEMA_VERSION":` +
+	` {"ENGINE_SCHEMA_VERSION": "4.1", "MAXIMUM_REQUIRED_SCHEMA_VERSION": "4.99",` +
+	` "MINIMUM_REQUIRED_SCHEMA_VERSION": "4.0"}, "VERSION": "4.1.1"}`
+
+type fakeSzProduct struct {
+	senzing.SzProduct
+
+	err         error
+	versionJSON string
+}
+
+func (product *fakeSzProduct) GetVersion(_ context.Context) (string, error) {
+	return product.versionJSON, product.err
+}
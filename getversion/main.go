@@ -0,0 +1,25 @@
+package getversion
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	buildDateLayout     = "2006-01-02"
+	constraintSeparator = ","
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+ErrUnsupportedVersion is returned by RequireVersion when the Senzing version does not satisfy the constraint.
+*/
+var ErrUnsupportedVersion = errors.New("unsupported Senzing version")
+
+var errForPackage = errors.New("getversion")
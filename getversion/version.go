@@ -0,0 +1,328 @@
+package getversion
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Version is a semantic version, such as 4.1.1.
+*/
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+/*
+SenzingVersion is the version of the Senzing product, as returned by GetVersion.
+Its Version is promoted, so Major, Minor, Patch and the comparison methods can be used directly.
+*/
+type SenzingVersion struct {
+	Version
+
+	BuildDate            time.Time // Zero if not reported.
+	BuildNumber          string    // e.g. "2025_10_10__15_01"
+	BuildVersion         string    // e.g. "4.1.1.25283"
+	CompatibilityVersion CompatibilityVersion
+	ProductName          string
+	SchemaVersion        SchemaVersion
+}
+
+/*
+CompatibilityVersion lists the versions of artifacts the Senzing product is compatible with.
+*/
+type CompatibilityVersion struct {
+	ConfigVersion string // Version of the Senzing configuration JSON document.
+}
+
+/*
+SchemaVersion lists the database schema versions of the Senzing product.
+*/
+type SchemaVersion struct {
+	EngineSchemaVersion          string
+	MaximumRequiredSchemaVersion string
+	MinimumRequiredSchemaVersion string
+}
+
+type constraint struct {
+	operator string
+	version  Version
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The GetVersion function returns the version of the Senzing product.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szProduct: A Senzing client, such as szproduct.Szproduct.
+
+Output
+  - The version of the Senzing product.
+*/
+func GetVersion(ctx context.Context, szProduct senzing.SzProduct) (*SenzingVersion, error) {
+	versionJSON, err := szProduct.GetVersion(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetVersion")
+	}
+
+	return ParseSenzingVersion(versionJSON)
+}
+
+/*
+The ParseSenzingVersion function reads the JSON document returned by SzProduct.GetVersion.
+
+Input
+  - versionJSON: The JSON document returned by SzProduct.GetVersion.
+
+Output
+  - The version of the Senzing product.
+*/
+func ParseSenzingVersion(versionJSON string) (*SenzingVersion, error) {
+	response := SenzingVersionResponse{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(versionJSON), &response)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	version, err := ParseVersion(response.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var buildDate time.Time
+
+	if len(response.BuildDate) > 0 {
+		buildDate, err = time.Parse(buildDateLayout, response.BuildDate)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "BUILD_DATE")
+		}
+	}
+
+	result := &SenzingVersion{
+		Version:      version,
+		BuildDate:    buildDate,
+		BuildNumber:  response.BuildNumber,
+		BuildVersion: response.BuildVersion,
+		CompatibilityVersion: CompatibilityVersion{
+			ConfigVersion: response.CompatibilityVersion.ConfigVersion,
+		},
+		ProductName: response.ProductName,
+		SchemaVersion: SchemaVersion{
+			EngineSchemaVersion:          response.SchemaVersion.EngineSchemaVersion,
+			MaximumRequiredSchemaVersion: response.SchemaVersion.MaximumRequiredSchemaVersion,
+			MinimumRequiredSchemaVersion: response.SchemaVersion.MinimumRequiredSchemaVersion,
+		},
+	}
+
+	return result, nil
+}
+
+/*
+The ParseVersion function reads a version such as "4.1.1".
+Missing minor and patch numbers are 0, so "4.1" is 4.1.0.
+
+Input
+  - version: The version, with one to three dot-separated numbers.
+
+Output
+  - The version.
+*/
+func ParseVersion(version string) (Version, error) {
+	result := Version{}
+	parts := strings.Split(strings.TrimSpace(version), ".")
+
+	if len(parts) > semanticVersionParts {
+		return result, wraperror.Errorf(errForPackage, "invalid version %q", version)
+	}
+
+	numbers := []*int{&result.Major, &result.Minor, &result.Patch}
+
+	for index, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return Version{}, wraperror.Errorf(errForPackage, "invalid version %q", version)
+		}
+
+		*numbers[index] = number
+	}
+
+	return result, nil
+}
+
+/*
+The RequireVersion function checks that the Senzing product satisfies a version constraint.
+It is meant to be called at startup.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szProduct: A Senzing client, such as szproduct.Szproduct.
+  - versionConstraint: A constraint, such as ">=4.1" or ">=4.1, <5". See Version.Satisfies.
+
+Output
+  - An error wrapping ErrUnsupportedVersion if the constraint is not satisfied.
+*/
+func RequireVersion(ctx context.Context, szProduct senzing.SzProduct, versionConstraint string) error {
+	senzingVersion, err := GetVersion(ctx, szProduct)
+	if err != nil {
+		return err
+	}
+
+	isSatisfied, err := senzingVersion.Satisfies(versionConstraint)
+	if err != nil {
+		return err
+	}
+
+	if !isSatisfied {
+		return fmt.Errorf("%w: %s does not satisfy %q", ErrUnsupportedVersion, senzingVersion.Version, versionConstraint)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Version methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AtLeast reports whether the version is the same as, or later than, another.
+
+Input
+  - other: The version to compare with.
+
+Output
+  - True if the version is at least other.
+*/
+func (version Version) AtLeast(other Version) bool {
+	return version.Compare(other) >= 0
+}
+
+/*
+Method Compare compares the version with another.
+
+Input
+  - other: The version to compare with.
+
+Output
+  - -1 if the version is earlier than other, 0 if they are the same, and +1 if it is later.
+*/
+func (version Version) Compare(other Version) int {
+	return cmp.Or(
+		cmp.Compare(version.Major, other.Major),
+		cmp.Compare(version.Minor, other.Minor),
+		cmp.Compare(version.Patch, other.Patch),
+	)
+}
+
+/*
+Method Satisfies checks the version against a constraint.
+
+A constraint is one or more comma-separated comparisons, all of which must hold,
+such as ">=4.1" or ">=4.1, <5".
+The operators are "=", "==", "!=", "<", "<=", ">" and ">="; a version without an operator must match exactly.
+Versions are read by ParseVersion, so "4.1" means 4.1.0.
+
+Input
+  - versionConstraint: The constraint.
+
+Output
+  - True if the version satisfies the constraint.
+*/
+func (version Version) Satisfies(versionConstraint string) (bool, error) {
+	constraints, err := parseConstraint(versionConstraint)
+	if err != nil {
+		return false, err
+	}
+
+	for _, aConstraint := range constraints {
+		if !aConstraint.isSatisfiedBy(version) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+/*
+Method String returns the version in "Major.Minor.Patch" form.
+
+Output
+  - The version, such as "4.1.1".
+*/
+func (version Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (aConstraint constraint) isSatisfiedBy(version Version) bool {
+	comparison := version.Compare(aConstraint.version)
+
+	switch aConstraint.operator {
+	case "!=":
+		return comparison != 0
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	default:
+		return comparison == 0
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func parseConstraint(versionConstraint string) ([]constraint, error) {
+	// Two-character operators are listed first so that "<=" is not read as "<".
+
+	operators := []string{"!=", "<=", "==", ">=", "<", "=", ">"}
+	result := []constraint{}
+
+	for part := range strings.SplitSeq(versionConstraint, constraintSeparator) {
+		part = strings.TrimSpace(part)
+		operator := ""
+
+		for _, candidate := range operators {
+			if strings.HasPrefix(part, candidate) {
+				operator = candidate
+				part = part[len(candidate):]
+
+				break
+			}
+		}
+
+		version, err := ParseVersion(part)
+		if err != nil {
+			return nil, wraperror.Errorf(errForPackage, "invalid version constraint %q", versionConstraint)
+		}
+
+		result = append(result, constraint{operator: operator, version: version})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,28 @@
+/*
+Package golden compares Senzing responses with golden files, for snapshot tests.
+
+Responses hold values that change from run to run: entity IDs, feature IDs, timestamps such as LAST_SEEN_DT,
+and configuration IDs. [Golden.Canonicalize] makes a response stable:
+
+  - Object keys are sorted and the document is indented.
+  - Values of scrubbed fields, such as "*_DT", are replaced by [ScrubbedValue].
+  - Values of renumbered fields, such as "ENTITY_ID" and "RES_ENT_ID", are replaced by 1, 2, 3...
+    in order of first appearance, visiting object keys in sorted order. Fields in the same namespace share numbers,
+    so an entity keeps its number wherever it appears in the response.
+  - A stream of JSON documents, such as a JSON export, is canonicalized document by document, with shared numbers.
+
+[Golden.Assert] compares the canonical response with the golden file of the test, testdata/golden/<test name>.golden.
+Run the tests of a package that uses golden with -golden.update to write the golden files instead:
+
+	go test ./mypackage -run TestGetEntityByRecordID -golden.update
+
+Any Szengine result can be asserted: JSON strings, other text such as a CSV export,
+numbers such as CountRedoRecords, and the channels returned by the iterator methods.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package golden
@@ -0,0 +1,296 @@
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Golden compares responses with golden files.
+The zero value uses the defaults.
+*/
+type Golden struct {
+	// Optional. If empty, DefaultDirectory is used.
+	Directory string
+
+	// Optional. Field name patterns mapped to the namespace of their numbers. If nil, DefaultRenumberedFields is used.
+	RenumberedFields map[string]string
+
+	// Optional. Field name patterns. If nil, DefaultScrubbedFields is used.
+	ScrubbedFields []string
+
+	// Optional. If true, golden files are written, as with the -golden.update flag.
+	Update bool
+}
+
+/*
+The state of one call to Canonicalize.
+*/
+type canonicalizer struct {
+	numbers            map[string]map[string]int // Namespace to original value to number.
+	renumberedFields   map[string]string
+	renumberedPatterns []string // The keys of renumberedFields, sorted.
+	scrubbedFields     []string
+}
+
+// ----------------------------------------------------------------------------
+// Golden methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Assert compares a result with the golden file of the test, or writes the golden file when updating.
+
+Input
+  - t: The test. Its name, including subtests, names the golden file.
+  - actual: A result of a Szengine method: a string, a []byte, a chan senzing.StringFragment,
+    or any other value, which is compared as JSON.
+*/
+func (golden *Golden) Assert(t *testing.T, actual any) {
+	t.Helper()
+
+	text := golden.text(t, actual)
+	goldenPath := golden.Path(t.Name())
+
+	if golden.Update || *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(goldenPath), directoryPermissions))
+		require.NoError(t, os.WriteFile(goldenPath, []byte(text), filePermissions))
+
+		return
+	}
+
+	expected, err := os.ReadFile(goldenPath)
+	require.NoError(t, err, "Run the test with -golden.update to write the golden file.")
+	require.Equal(t, string(expected), text, goldenPath)
+}
+
+/*
+Method Canonicalize makes a JSON document, or a stream of JSON documents, comparable from run to run.
+See the package documentation.
+
+Input
+  - document: One or more JSON documents, such as a Szengine response or a JSON export.
+
+Output
+  - The canonical JSON documents, each indented and followed by a new line.
+*/
+func (golden *Golden) Canonicalize(document string) (string, error) {
+	var resultBuffer bytes.Buffer
+
+	aCanonicalizer := golden.newCanonicalizer()
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+
+	encoder := json.NewEncoder(&resultBuffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", jsonIndentation)
+
+	count := 0
+
+	for {
+		var value any
+
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", wraperror.Errorf(err, "json.Decode")
+		}
+
+		err = encoder.Encode(aCanonicalizer.canonicalize(value))
+		if err != nil {
+			return "", wraperror.Errorf(err, "json.Encode")
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		return "", fmt.Errorf("%w: no JSON document", errForPackage)
+	}
+
+	return resultBuffer.String(), nil
+}
+
+/*
+Method Path returns the golden file of a test.
+
+Input
+  - testName: The name of the test, as from testing.T.Name.
+
+Output
+  - The path of the golden file. Subtests are in the subdirectory of their parent test.
+*/
+func (golden *Golden) Path(testName string) string {
+	directory := golden.Directory
+	if len(directory) == 0 {
+		directory = DefaultDirectory
+	}
+
+	return filepath.Join(directory, filepath.FromSlash(testName)+fileExtension)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (golden *Golden) newCanonicalizer() *canonicalizer {
+	result := &canonicalizer{
+		numbers:          map[string]map[string]int{},
+		renumberedFields: golden.RenumberedFields,
+		scrubbedFields:   golden.ScrubbedFields,
+	} //exhaustruct:ignore
+
+	if result.renumberedFields == nil {
+		result.renumberedFields = DefaultRenumberedFields
+	}
+
+	if result.scrubbedFields == nil {
+		result.scrubbedFields = DefaultScrubbedFields
+	}
+
+	for pattern := range result.renumberedFields {
+		result.renumberedPatterns = append(result.renumberedPatterns, pattern)
+	}
+
+	slices.Sort(result.renumberedPatterns)
+
+	return result
+}
+
+/*
+The text compared with the golden file: canonical JSON if the result is JSON, otherwise the result itself.
+*/
+func (golden *Golden) text(t *testing.T, actual any) string {
+	t.Helper()
+
+	var document string
+
+	switch typedActual := actual.(type) {
+	case string:
+		document = typedActual
+	case []byte:
+		document = string(typedActual)
+	case chan senzing.StringFragment:
+		var documentBuilder strings.Builder
+
+		for fragment := range typedActual {
+			require.NoError(t, fragment.Error)
+			documentBuilder.WriteString(fragment.Value)
+		}
+
+		document = documentBuilder.String()
+	default:
+		documentBytes, err := json.Marshal(actual)
+		require.NoError(t, err)
+
+		document = string(documentBytes)
+	}
+
+	result, err := golden.Canonicalize(document)
+	if err != nil {
+		result = strings.TrimRight(document, "\n") + "\n"
+	}
+
+	return result
+}
+
+/*
+Canonicalize a decoded JSON value. Object keys are visited in sorted order, so numbering is repeatable.
+*/
+func (aCanonicalizer *canonicalizer) canonicalize(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			typedValue[key] = aCanonicalizer.canonicalizeField(key, typedValue[key])
+		}
+	case []any:
+		for index, child := range typedValue {
+			typedValue[index] = aCanonicalizer.canonicalize(child)
+		}
+	}
+
+	return value
+}
+
+func (aCanonicalizer *canonicalizer) canonicalizeField(key string, value any) any {
+	if value == nil {
+		return nil
+	}
+
+	if matchesAny(aCanonicalizer.scrubbedFields, key) {
+		return ScrubbedValue
+	}
+
+	switch value.(type) {
+	case json.Number, string:
+		for _, pattern := range aCanonicalizer.renumberedPatterns {
+			if matches(pattern, key) {
+				return aCanonicalizer.renumber(aCanonicalizer.renumberedFields[pattern], fmt.Sprint(value))
+			}
+		}
+	}
+
+	return aCanonicalizer.canonicalize(value)
+}
+
+func (aCanonicalizer *canonicalizer) renumber(namespace string, value string) int {
+	numbers, ok := aCanonicalizer.numbers[namespace]
+	if !ok {
+		numbers = map[string]int{}
+		aCanonicalizer.numbers[namespace] = numbers
+	}
+
+	result, ok := numbers[value]
+	if !ok {
+		result = len(numbers) + 1
+		numbers[value] = result
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func matches(pattern string, key string) bool {
+	result, err := path.Match(pattern, key)
+
+	return err == nil && result
+}
+
+func matchesAny(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matches(pattern, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+package golden_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/golden"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleGolden_Canonicalize() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/golden/golden_test.go
+	aGolden := &golden.Golden{} //exhaustruct:ignore
+
+	result, err := aGolden.Canonicalize(`{"RESOLVED_ENTITY": {"LAST_SEEN_DT": "2026-10-18T17:27:15Z", "ENTITY_ID": 35}}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(result)
+	// Output:
+	// {
+	//   "RESOLVED_ENTITY": {
+	//     "ENTITY_ID": 1,
+	//     "LAST_SEEN_DT": "<scrubbed>"
+	//   }
+	// }
+}
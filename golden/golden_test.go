@@ -0,0 +1,210 @@
+package golden_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/golden"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+const (
+	entityJSON = `{"RESOLVED_ENTITY": {"ENTITY_ID": 35, "LAST_SEEN_DT": "2026-10-18T17:27:15Z",` +
+		` "RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "INTERNAL_ID": 35}]},` +
+		` "RELATED_ENTITIES": [{"ENTITY_ID": 36}]}`
+	entityJSONOtherRun = `{"RELATED_ENTITIES": [{"ENTITY_ID": 136}], "RESOLVED_ENTITY": {"RECORDS":` +
+		` [{"INTERNAL_ID": 135, "RECORD_ID": "1001", "DATA_SOURCE": "CUSTOMERS"}],` +
+		` "LAST_SEEN_DT": "2026-10-19T08:00:00Z", "ENTITY_ID": 135}}`
+	expectedEntityJSON = `{
+  "RELATED_ENTITIES": [
+    {
+      "ENTITY_ID": 1
+    }
+  ],
+  "RESOLVED_ENTITY": {
+    "ENTITY_ID": 2,
+    "LAST_SEEN_DT": "<scrubbed>",
+    "RECORDS": [
+      {
+        "DATA_SOURCE": "CUSTOMERS",
+        "INTERNAL_ID": 1,
+        "RECORD_ID": "1001"
+      }
+    ]
+  }
+}
+`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGolden_Canonicalize(test *testing.T) {
+	aGolden := &golden.Golden{} //exhaustruct:ignore
+
+	actual, err := aGolden.Canonicalize(entityJSON)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, expectedEntityJSON, actual)
+
+	actual, err = aGolden.Canonicalize(entityJSONOtherRun)
+	require.NoError(test, err)
+	require.Equal(test, expectedEntityJSON, actual)
+}
+
+func TestGolden_Canonicalize_documents(test *testing.T) {
+	aGolden := &golden.Golden{} //exhaustruct:ignore
+
+	actual, err := aGolden.Canonicalize(
+		`{"RESOLVED_ENTITY": {"ENTITY_ID": 7}}` + "\n" +
+			`{"RESOLVED_ENTITY": {"ENTITY_ID": 9}, "RELATED_ENTITIES": [{"ENTITY_ID": 7}]}` + "\n")
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, `{
+  "RESOLVED_ENTITY": {
+    "ENTITY_ID": 1
+  }
+}
+{
+  "RELATED_ENTITIES": [
+    {
+      "ENTITY_ID": 1
+    }
+  ],
+  "RESOLVED_ENTITY": {
+    "ENTITY_ID": 2
+  }
+}
+`, actual)
+}
+
+func TestGolden_Canonicalize_fields(test *testing.T) {
+	aGolden := &golden.Golden{
+		RenumberedFields: map[string]string{"ID": "ID", "PARENT_ID": "ID"},
+		ScrubbedFields:   []string{"SECRET"},
+	} //exhaustruct:ignore
+
+	actual, err := aGolden.Canonicalize(`{"ID": 5, "PARENT_ID": 5, "ENTITY_ID": 5, "SECRET": {"KEY": 1}, "LAST_SEEN_DT": "x"}`)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.JSONEq(test, `{"ENTITY_ID": 5, "ID": 1, "LAST_SEEN_DT": "x", "PARENT_ID": 1, "SECRET": "<scrubbed>"}`, actual)
+}
+
+func TestGolden_Canonicalize_error(test *testing.T) {
+	aGolden := &golden.Golden{} //exhaustruct:ignore
+
+	for _, document := range []string{"", "RESOLVED_ENTITY_ID,RELATED_ENTITY_ID", `{"ENTITY_ID": 1}}`} {
+		_, err := aGolden.Canonicalize(document)
+		printDebug(test, err)
+		require.Error(test, err)
+	}
+}
+
+func TestGolden_Assert(test *testing.T) {
+	aGolden := &golden.Golden{Directory: test.TempDir(), Update: true} //exhaustruct:ignore
+
+	aGolden.Assert(test, entityJSON)
+
+	written, err := os.ReadFile(aGolden.Path(test.Name()))
+	require.NoError(test, err)
+	require.Equal(test, expectedEntityJSON, string(written))
+
+	aGolden.Update = false
+	aGolden.Assert(test, entityJSONOtherRun)
+	aGolden.Assert(test, []byte(entityJSONOtherRun))
+}
+
+func TestGolden_Assert_values(test *testing.T) {
+	aGolden := &golden.Golden{Directory: test.TempDir(), Update: true} //exhaustruct:ignore
+
+	testCases := []struct {
+		name     string
+		actual   any
+		expected string
+	}{
+		{
+			name:     "csv",
+			actual:   "RESOLVED_ENTITY_ID,RELATED_ENTITY_ID\n1,0\n",
+			expected: "RESOLVED_ENTITY_ID,RELATED_ENTITY_ID\n1,0\n",
+		},
+		{
+			name:     "int64",
+			actual:   int64(3),
+			expected: "3\n",
+		},
+		{
+			name:     "iterator",
+			actual:   fragments(`{"RESOLVED_ENTITY": {"ENTITY_ID": 35}}`+"\n", `{"RESOLVED_ENTITY": {"ENTITY_ID": 36}}`+"\n"),
+			expected: "{\n  \"RESOLVED_ENTITY\": {\n    \"ENTITY_ID\": 1\n  }\n}\n{\n  \"RESOLVED_ENTITY\": {\n    \"ENTITY_ID\": 2\n  }\n}\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			aGolden.Assert(test, testCase.actual)
+
+			written, err := os.ReadFile(aGolden.Path(test.Name()))
+			require.NoError(test, err)
+			require.Equal(test, testCase.expected, string(written))
+		})
+	}
+}
+
+func TestGolden_Path(test *testing.T) {
+	aGolden := &golden.Golden{} //exhaustruct:ignore
+	require.Equal(test, "testdata/golden/TestX/sub_test.golden", aGolden.Path("TestX/sub_test"))
+
+	aGolden.Directory = "other"
+	require.Equal(test, "other/TestX.golden", aGolden.Path("TestX"))
+}
+
+func TestGolden_updateFlag(test *testing.T) {
+	// The flag is namespaced, so it does not collide with an -update flag of the package under test.
+
+	require.NotNil(test, flag.Lookup("golden.update"))
+	require.Nil(test, flag.Lookup("update"))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func fragments(values ...string) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment, len(values))
+
+	for _, value := range values {
+		result <- senzing.StringFragment{Value: value} //exhaustruct:ignore
+	}
+
+	close(result)
+
+	return result
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package golden
+
+import (
+	"errors"
+	"flag"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// DefaultDirectory is the directory of golden files, relative to the package under test.
+	DefaultDirectory = "testdata/golden"
+
+	// ScrubbedValue replaces the values of scrubbed fields.
+	ScrubbedValue = "<scrubbed>"
+)
+
+const (
+	directoryPermissions = 0o750
+	fileExtension        = ".golden"
+	filePermissions      = 0o600
+	jsonIndentation      = "  "
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// DefaultRenumberedFields maps field name patterns, as in path.Match, to the namespace of their numbers.
+var DefaultRenumberedFields = map[string]string{
+	"*_ENTITY_ID": "ENTITY",
+	"ENTITY_ID":   "ENTITY",
+	"ENTITY_ID_*": "ENTITY",
+	"INTERNAL_ID": "INTERNAL",
+	"LIB_FEAT_ID": "FEATURE",
+	"RES_ENT_ID":  "ENTITY",
+}
+
+// DefaultScrubbedFields are field name patterns, as in path.Match.
+var DefaultScrubbedFields = []string{
+	"*_DT",
+	"*_TIMESTAMP",
+	"CONFIG_ID",
+}
+
+var (
+	errForPackage = errors.New("golden")
+	update        = flag.Bool("golden.update", false, "Write golden files instead of comparing with them.")
+)
@@ -0,0 +1,24 @@
+/*
+Package health reports on the health of a Senzing gRPC server.
+
+A [Checker] gathers SzProduct.GetVersion and GetLicense, SzDiagnostic.GetRepositoryInfo,
+SzEngine.CountRedoRecords and GetActiveConfigID, and optionally SzConfigManager.GetDefaultConfigID,
+concurrently and each with its own timeout.
+SzEngine.GetStats is checked only if Checker.IncludeStats is set:
+GetStats resets the engine's counters, so a check on every probe would discard them for other readers.
+The resulting [Report] has an overall status:
+
+  - OK: every check succeeded.
+  - DEGRADED: an informational check failed, the redo backlog exceeds Checker.MaxRedoRecords,
+    or the active configuration is not the default configuration.
+  - FAILING: SzEngine.GetActiveConfigID or SzDiagnostic.GetRepositoryInfo failed.
+
+A Checker is also an http.Handler that serves the report as JSON, for readiness probes and dashboards.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package health
@@ -0,0 +1,310 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Status is the outcome of a check, or of a whole report.
+*/
+type Status string
+
+/*
+Checker gathers a health [Report].
+Checks whose client is nil are skipped.
+*/
+type Checker struct {
+	SzConfigManager senzing.SzConfigManager // Optional. If set, the active configuration is compared with the default.
+	SzDiagnostic    senzing.SzDiagnostic
+	SzEngine        senzing.SzEngine
+	SzProduct       senzing.SzProduct
+	IncludeStats    bool          // Optional. If true, SzEngine.GetStats is checked, which resets the engine's counters.
+	MaxRedoRecords  int64         // Optional. If set, a larger redo backlog makes the report DEGRADED.
+	Timeout         time.Duration // Optional. If 0, DefaultTimeout is used.
+}
+
+/*
+Report is the result of [Checker.Check].
+Fields derived from a check are zero if the check failed or was skipped.
+*/
+type Report struct {
+	ActiveConfigID  int64         `json:"ACTIVE_CONFIG_ID"`
+	CheckedAt       time.Time     `json:"CHECKED_AT"`
+	Checks          []CheckResult `json:"CHECKS"`
+	DefaultConfigID int64         `json:"DEFAULT_CONFIG_ID"`
+	Reasons         []string      `json:"REASONS"`
+	RedoRecords     int64         `json:"REDO_RECORDS"`
+	Status          Status        `json:"STATUS"`
+	Version         string        `json:"VERSION"`
+}
+
+/*
+CheckResult is the outcome of a single check.
+*/
+type CheckResult struct {
+	Duration time.Duration   `json:"DURATION"`
+	Error    string          `json:"ERROR,omitempty"`
+	Name     string          `json:"NAME"`
+	Result   json.RawMessage `json:"RESULT,omitempty"` // The JSON returned by the Senzing method, if any.
+	Status   Status          `json:"STATUS"`
+}
+
+type check struct {
+	critical bool
+	name     string
+	run      func(ctx context.Context) (string, error)
+}
+
+type outcome struct {
+	err    error
+	result string
+}
+
+// ----------------------------------------------------------------------------
+// Checker methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Check runs all checks concurrently and summarizes them.
+Check does not return an error; failed checks are reported in the Report.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The health report.
+*/
+func (checker *Checker) Check(ctx context.Context) *Report {
+	checks := checker.checks()
+	results := make([]CheckResult, len(checks))
+
+	var waitGroup sync.WaitGroup
+
+	for index, aCheck := range checks {
+		waitGroup.Go(func() {
+			results[index] = checker.run(ctx, aCheck)
+		})
+	}
+
+	waitGroup.Wait()
+
+	return checker.summarize(checks, results)
+}
+
+/*
+Method ServeHTTP serves the health report as JSON.
+The HTTP status is 200 when the report is OK or DEGRADED, and 503 when it is FAILING.
+
+Input
+  - writer: The HTTP response.
+  - request: The HTTP request. Its context controls the checks.
+*/
+func (checker *Checker) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	report := checker.Check(request.Context())
+
+	body, err := json.Marshal(report)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	httpStatus := http.StatusOK
+	if report.Status == StatusFailing {
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	writer.Header().Set(httpContentTypeHeader, httpContentType)
+	writer.WriteHeader(httpStatus)
+	_, _ = writer.Write(body)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (checker *Checker) checks() []check {
+	result := make([]check, 0, checkCount)
+
+	if checker.SzEngine != nil {
+		result = append(result,
+			check{critical: true, name: CheckActiveConfigID, run: func(ctx context.Context) (string, error) {
+				configID, err := checker.SzEngine.GetActiveConfigID(ctx)
+
+				return strconv.FormatInt(configID, baseTen), err
+			}},
+			check{critical: false, name: CheckRedoRecords, run: func(ctx context.Context) (string, error) {
+				count, err := checker.SzEngine.CountRedoRecords(ctx)
+
+				return strconv.FormatInt(count, baseTen), err
+			}},
+		)
+	}
+
+	if checker.SzEngine != nil && checker.IncludeStats {
+		result = append(result,
+			check{critical: false, name: CheckStats, run: checker.SzEngine.GetStats},
+		)
+	}
+
+	if checker.SzDiagnostic != nil {
+		result = append(result,
+			check{critical: true, name: CheckRepositoryInfo, run: checker.SzDiagnostic.GetRepositoryInfo},
+		)
+	}
+
+	if checker.SzProduct != nil {
+		result = append(result,
+			check{critical: false, name: CheckLicense, run: checker.SzProduct.GetLicense},
+			check{critical: false, name: CheckVersion, run: checker.SzProduct.GetVersion},
+		)
+	}
+
+	if checker.SzConfigManager != nil {
+		result = append(result,
+			check{critical: false, name: CheckDefaultConfigID, run: func(ctx context.Context) (string, error) {
+				configID, err := checker.SzConfigManager.GetDefaultConfigID(ctx)
+
+				return strconv.FormatInt(configID, baseTen), err
+			}},
+		)
+	}
+
+	return result
+}
+
+/*
+Run a check with its own timeout.
+A check that does not return in time is abandoned; its goroutine ends when the Senzing call returns.
+*/
+func (checker *Checker) run(ctx context.Context, aCheck check) CheckResult {
+	timeout := checker.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	outcomes := make(chan outcome, 1)
+
+	go func() {
+		result, err := aCheck.run(checkCtx)
+		outcomes <- outcome{err: err, result: result}
+	}()
+
+	var anOutcome outcome
+
+	select {
+	case anOutcome = <-outcomes:
+	case <-checkCtx.Done():
+		anOutcome = outcome{err: fmt.Errorf("timed out after %s: %w", timeout, checkCtx.Err()), result: ""}
+	}
+
+	result := CheckResult{
+		Duration: time.Since(start),
+		Error:    "",
+		Name:     aCheck.name,
+		Result:   nil,
+		Status:   StatusOK,
+	}
+
+	if anOutcome.err != nil {
+		result.Error = anOutcome.err.Error()
+		result.Status = StatusDegraded
+
+		if aCheck.critical {
+			result.Status = StatusFailing
+		}
+
+		return result
+	}
+
+	if json.Valid([]byte(anOutcome.result)) {
+		result.Result = json.RawMessage(anOutcome.result)
+	}
+
+	return result
+}
+
+func (checker *Checker) summarize(checks []check, results []CheckResult) *Report {
+	report := &Report{
+		ActiveConfigID:  0,
+		CheckedAt:       time.Now().UTC(),
+		Checks:          results,
+		DefaultConfigID: 0,
+		Reasons:         []string{},
+		RedoRecords:     0,
+		Status:          StatusOK,
+		Version:         "",
+	}
+
+	for index, result := range results {
+		if result.Status != StatusOK {
+			report.degrade(result.Status, fmt.Sprintf("%s failed: %s", checks[index].name, result.Error))
+
+			continue
+		}
+
+		switch result.Name {
+		case CheckActiveConfigID:
+			_ = json.Unmarshal(result.Result, &report.ActiveConfigID)
+		case CheckDefaultConfigID:
+			_ = json.Unmarshal(result.Result, &report.DefaultConfigID)
+		case CheckRedoRecords:
+			_ = json.Unmarshal(result.Result, &report.RedoRecords)
+		case CheckVersion:
+			report.Version = parseVersion(result.Result)
+		}
+	}
+
+	if checker.MaxRedoRecords > 0 && report.RedoRecords > checker.MaxRedoRecords {
+		report.degrade(StatusDegraded,
+			fmt.Sprintf("%d redo records exceed the limit of %d", report.RedoRecords, checker.MaxRedoRecords))
+	}
+
+	if report.ActiveConfigID != 0 && report.DefaultConfigID != 0 && report.ActiveConfigID != report.DefaultConfigID {
+		report.degrade(StatusDegraded,
+			fmt.Sprintf("active configuration %d is not the default configuration %d",
+				report.ActiveConfigID, report.DefaultConfigID))
+	}
+
+	return report
+}
+
+func (report *Report) degrade(status Status, reason string) {
+	report.Reasons = append(report.Reasons, reason)
+
+	if status == StatusFailing || report.Status == StatusOK {
+		report.Status = status
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func parseVersion(versionJSON json.RawMessage) string {
+	version := struct {
+		Version string `json:"VERSION"`
+	}{
+		Version: "",
+	}
+
+	_ = json.Unmarshal(versionJSON, &version)
+
+	return version.Version
+}
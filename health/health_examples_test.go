@@ -0,0 +1,42 @@
+package health_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/health"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleChecker_Check() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/health/health_test.go
+	ctx := context.TODO()
+	checker := getChecker(ctx)
+
+	report := checker.Check(ctx)
+	fmt.Println(report.Status, report.Version, report.ActiveConfigID)
+	// Output: OK 4.1.1 1001
+}
+
+func ExampleChecker_ServeHTTP() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/health/health_test.go
+	ctx := context.TODO()
+	checker := getChecker(ctx)
+
+	serveMux := http.NewServeMux()
+	serveMux.Handle("/readyz", checker)
+	// Serve with http.ListenAndServe(":8080", serveMux).
+	// Output:
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getChecker(_ context.Context) *health.Checker {
+	return newChecker()
+}
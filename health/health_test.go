@@ -0,0 +1,280 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/health"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestChecker_Check(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusOK, report.Status)
+	require.Empty(test, report.Reasons)
+	require.Len(test, report.Checks, 6)
+	require.Equal(test, int64(1001), report.ActiveConfigID)
+	require.Equal(test, int64(1001), report.DefaultConfigID)
+	require.Equal(test, int64(3), report.RedoRecords)
+	require.Equal(test, "4.1.1", report.Version)
+
+	for _, result := range report.Checks {
+		require.Equal(test, health.StatusOK, result.Status, result.Name)
+		require.NotEmpty(test, result.Result, result.Name)
+	}
+}
+
+func TestChecker_Check_includeStats(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+
+	checker.Check(ctx)
+	require.Zero(test, checker.SzEngine.(*fakeSzEngine).statsCalls.Load())
+
+	checker.IncludeStats = true
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusOK, report.Status)
+	require.Len(test, report.Checks, 7)
+	require.Equal(test, int32(1), checker.SzEngine.(*fakeSzEngine).statsCalls.Load())
+}
+
+func TestChecker_Check_degraded(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+	checker.SzProduct.(*fakeSzProduct).licenseErr = szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusDegraded, report.Status)
+	require.Len(test, report.Reasons, 1)
+	require.Contains(test, report.Reasons[0], health.CheckLicense)
+	require.Contains(test, report.Reasons[0], "SENZ0010")
+}
+
+func TestChecker_Check_failing(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+	checker.SzProduct.(*fakeSzProduct).licenseErr = szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)
+	checker.SzDiagnostic.(*fakeSzDiagnostic).err = szerror.New(1006, `{"reason":"SENZ1006|Database connection lost"}`)
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusFailing, report.Status)
+	require.Len(test, report.Reasons, 2)
+}
+
+func TestChecker_Check_timeout(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+	checker.Timeout = 20 * time.Millisecond
+	checker.IncludeStats = true
+	release := make(chan struct{})
+	checker.SzEngine.(*fakeSzEngine).statsRelease = release
+
+	defer close(release)
+
+	start := time.Now()
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Less(test, time.Since(start), time.Second)
+	require.Equal(test, health.StatusDegraded, report.Status)
+	require.Contains(test, report.Reasons[0], health.CheckStats)
+	require.Contains(test, report.Reasons[0], "timed out")
+}
+
+func TestChecker_Check_redoBacklog(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+	checker.MaxRedoRecords = 2
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusDegraded, report.Status)
+	require.Equal(test, []string{"3 redo records exceed the limit of 2"}, report.Reasons)
+}
+
+func TestChecker_Check_staleConfig(test *testing.T) {
+	ctx := test.Context()
+	checker := newChecker()
+	checker.SzConfigManager.(*fakeSzConfigManager).defaultConfigID = 1002
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusDegraded, report.Status)
+	require.Equal(test, []string{"active configuration 1001 is not the default configuration 1002"}, report.Reasons)
+}
+
+func TestChecker_Check_partial(test *testing.T) {
+	ctx := test.Context()
+	checker := &health.Checker{SzProduct: &fakeSzProduct{}} //exhaustruct:ignore
+
+	report := checker.Check(ctx)
+	printDebug(test, nil, report)
+	require.Equal(test, health.StatusOK, report.Status)
+	require.Len(test, report.Checks, 2)
+}
+
+func TestChecker_ServeHTTP(test *testing.T) {
+	checker := newChecker()
+	server := httptest.NewServer(checker)
+
+	defer server.Close()
+
+	report := getReport(test, server.URL, http.StatusOK)
+	require.Equal(test, health.StatusOK, report.Status)
+
+	checker.SzEngine.(*fakeSzEngine).activeConfigErr = szerror.New(
+		1006,
+		`{"reason":"SENZ1006|Database connection lost"}`,
+	)
+
+	report = getReport(test, server.URL, http.StatusServiceUnavailable)
+	require.Equal(test, health.StatusFailing, report.Status)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newChecker() *health.Checker {
+	return &health.Checker{ //exhaustruct:ignore
+		SzConfigManager: &fakeSzConfigManager{defaultConfigID: 1001}, //exhaustruct:ignore
+		SzDiagnostic:    &fakeSzDiagnostic{},                         //exhaustruct:ignore
+		SzEngine:        &fakeSzEngine{},                             //exhaustruct:ignore
+		SzProduct:       &fakeSzProduct{},                            //exhaustruct:ignore
+	}
+}
+
+func getReport(t *testing.T, url string, expectedStatusCode int) *health.Report {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+
+	defer response.Body.Close()
+
+	require.Equal(t, expectedStatusCode, response.StatusCode)
+	require.Equal(t, "application/json", response.Header.Get("Content-Type"))
+
+	result := &health.Report{} //exhaustruct:ignore
+	require.NoError(t, json.NewDecoder(response.Body).Decode(result))
+	printDebug(t, nil, result)
+
+	return result
+}
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	defaultConfigID int64
+}
+
+func (manager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	return manager.defaultConfigID, nil
+}
+
+type fakeSzDiagnostic struct {
+	senzing.SzDiagnostic
+
+	err error
+}
+
+func (diagnostic *fakeSzDiagnostic) GetRepositoryInfo(_ context.Context) (string, error) {
+	if diagnostic.err != nil {
+		return "", diagnostic.err
+	}
+
+	return `{"dataStores":[{"id":"CORE","type":"sqlite3","location":"/tmp/G2C.db"}]}`, nil
+}
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	activeConfigErr error
+	statsCalls      atomic.Int32
+	statsRelease    chan struct{}
+}
+
+func (engine *fakeSzEngine) CountRedoRecords(_ context.Context) (int64, error) {
+	return 3, nil
+}
+
+func (engine *fakeSzEngine) GetActiveConfigID(_ context.Context) (int64, error) {
+	if engine.activeConfigErr != nil {
+		return 0, engine.activeConfigErr
+	}
+
+	return 1001, nil
+}
+
+func (engine *fakeSzEngine) GetStats(_ context.Context) (string, error) {
+	engine.statsCalls.Add(1)
+
+	if engine.statsRelease != nil {
+		<-engine.statsRelease
+	}
+
+	return `{"workload":{"loadedRecords":5}}`, nil
+}
+
+type fakeSzProduct struct {
+	senzing.SzProduct
+
+	licenseErr error
+}
+
+func (product *fakeSzProduct) GetLicense(_ context.Context) (string, error) {
+	if product.licenseErr != nil {
+		return "", product.licenseErr
+	}
+
+	return `{"licenseType":"EVAL (Solely for non-productive use)","expireDate":"2026-12-31","recordLimit":50000}`, nil
+}
+
+func (product *fakeSzProduct) GetVersion(_ context.Context) (string, error) {
+	return `{"PRODUCT_NAME":"Senzing SDK","VERSION":"4.1.1","BUILD_DATE":"2025-10-01"}`, nil
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package health
+
+import (
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Statuses, from best to worst.
+const (
+	StatusOK       Status = "OK"
+	StatusDegraded Status = "DEGRADED"
+	StatusFailing  Status = "FAILING"
+)
+
+// Check names.
+const (
+	CheckActiveConfigID   = "SzEngine.GetActiveConfigID"
+	CheckDefaultConfigID  = "SzConfigManager.GetDefaultConfigID"
+	CheckLicense          = "SzProduct.GetLicense"
+	CheckRedoRecords      = "SzEngine.CountRedoRecords"
+	CheckRepositoryInfo   = "SzDiagnostic.GetRepositoryInfo"
+	CheckStats            = "SzEngine.GetStats" // Only with Checker.IncludeStats: GetStats resets the engine's counters.
+	CheckVersion          = "SzProduct.GetVersion"
+	checkCount            = 7
+	baseTen               = 10
+	httpContentType       = "application/json"
+	httpContentTypeHeader = "Content-Type"
+)
+
+/*
+DefaultTimeout is the time allowed for each check when Checker.Timeout is not set.
+*/
+const DefaultTimeout = 5 * time.Second
@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The GetGrpcConnection function returns a client connection to a Senzing gRPC server,
+with the transport credentials of GetGrpcTransportCredentials.
+The connection is made lazily, on the first call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - grpcURL: The URL of the server, such as "grpc://localhost:8261". The "grpc://" prefix is optional.
+
+Output
+  - The client connection, which the caller must close.
+*/
+func GetGrpcConnection(ctx context.Context, grpcURL string) (*grpc.ClientConn, error) {
+	transportCredentials, err := GetGrpcTransportCredentials(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetGrpcTransportCredentials")
+	}
+
+	result, err := grpc.NewClient(strings.TrimPrefix(grpcURL, "grpc://"),
+		grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "NewClient %s", grpcURL)
+	}
+
+	return result, nil
+}
+
+/*
+The GetGrpcURL function returns the URL of the Senzing gRPC server from the SENZING_TOOLS_GRPC_URL
+OS environment variable, or DefaultGrpcURL if it is not set.
+
+Output
+  - The URL, such as "grpc://localhost:8261".
+*/
+func GetGrpcURL() string {
+	result, isSet := os.LookupEnv(GrpcURLEnvVar)
+	if !isSet || len(result) == 0 {
+		return DefaultGrpcURL
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package helper_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestGetGrpcConnection(test *testing.T) {
+	envVar := "SENZING_TOOLS_SERVER_CA_CERTIFICATE_FILE"
+	test.Setenv(envVar, "") // Restores the variable after the test.
+	os.Unsetenv(envVar)     //nolint
+
+	actual, err := helper.GetGrpcConnection(test.Context(), "grpc://localhost:8261")
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, actual.Close()) }()
+
+	require.Equal(test, "localhost:8261", actual.Target())
+}
+
+func TestGetGrpcConnection_badCredentials(test *testing.T) {
+	test.Setenv("SENZING_TOOLS_SERVER_CA_CERTIFICATE_FILE", "../testdata/certificates/no-such-file.pem")
+
+	_, err := helper.GetGrpcConnection(test.Context(), "grpc://localhost:8261")
+	require.ErrorContains(test, err, "GetGrpcTransportCredentials")
+}
+
+func TestGetGrpcURL(test *testing.T) {
+	test.Setenv(helper.GrpcURLEnvVar, "grpc://localhost:8262")
+	require.Equal(test, "grpc://localhost:8262", helper.GetGrpcURL())
+
+	test.Setenv(helper.GrpcURLEnvVar, "")
+	require.Equal(test, helper.DefaultGrpcURL, helper.GetGrpcURL())
+}
@@ -14,4 +14,14 @@ const (
 	MessageIDPrefix = "SZSDK"
 )
 
+/*
+DefaultGrpcURL is the URL of the Senzing gRPC server when the GrpcURLEnvVar OS environment variable is not set.
+*/
+const DefaultGrpcURL = "grpc://0.0.0.0:8261"
+
+/*
+GrpcURLEnvVar is the OS environment variable read by GetGrpcURL.
+*/
+const GrpcURLEnvVar = "SENZING_TOOLS_GRPC_URL"
+
 var errForPackage = errors.New("helper")
@@ -0,0 +1,52 @@
+package bufconntest
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Connection function serves gRPC services over an in-memory connection.
+
+Input
+  - t: The test. The server and connection are stopped when it ends.
+  - register: Registers the services on the server, such as szenginepb.RegisterSzEngineServer.
+  - dialOptions: Options added to those of the in-memory connection, such as interceptors.
+
+Output
+  - A client connection to the server.
+*/
+func Connection(t *testing.T, register func(server *grpc.Server), dialOptions ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(bufferSize)
+	server := grpc.NewServer()
+	register(server)
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	dialOptions = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, dialOptions...)
+
+	result, err := grpc.NewClient(target, dialOptions...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = result.Close() })
+
+	return result
+}
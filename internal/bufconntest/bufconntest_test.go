@@ -0,0 +1,67 @@
+package bufconntest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/bufconntest"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestConnection(test *testing.T) {
+	ctx := test.Context()
+	connection := bufconntest.Connection(test, func(server *grpc.Server) {
+		szproductpb.RegisterSzProductServer(server, &fakeServer{}) //exhaustruct:ignore
+	})
+
+	response, err := szproductpb.NewSzProductClient(connection).GetVersion(ctx, &szproductpb.GetVersionRequest{})
+	require.NoError(test, err)
+	require.JSONEq(test, `{"VERSION": "4.1.1"}`, response.GetResult())
+}
+
+func TestConnection_dialOptions(test *testing.T) {
+	ctx := test.Context()
+	calls := []string{}
+	interceptor := func(
+		ctx context.Context,
+		method string,
+		request any,
+		reply any,
+		clientConn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		calls = append(calls, method)
+
+		return invoker(ctx, method, request, reply, clientConn, opts...)
+	}
+
+	connection := bufconntest.Connection(test, func(server *grpc.Server) {
+		szproductpb.RegisterSzProductServer(server, &fakeServer{}) //exhaustruct:ignore
+	}, grpc.WithUnaryInterceptor(interceptor))
+
+	_, err := szproductpb.NewSzProductClient(connection).GetVersion(ctx, &szproductpb.GetVersionRequest{})
+	require.NoError(test, err)
+	require.Equal(test, []string{"/szproduct.SzProduct/GetVersion"}, calls)
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type fakeServer struct {
+	szproductpb.UnimplementedSzProductServer
+}
+
+func (server *fakeServer) GetVersion(
+	_ context.Context,
+	_ *szproductpb.GetVersionRequest,
+) (*szproductpb.GetVersionResponse, error) {
+	return &szproductpb.GetVersionResponse{Result: `{"VERSION": "4.1.1"}`}, nil
+}
@@ -0,0 +1,14 @@
+/*
+Package bufconntest serves gRPC services over an in-memory connection for tests.
+
+[Connection] starts a grpc.Server on a bufconn listener, registers the services of a test's fake servers,
+and returns a client connection to it, ready for a szabstractfactory.Szabstractfactory.
+The server and connection are stopped when the test ends.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package bufconntest
@@ -0,0 +1,10 @@
+package bufconntest
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	bufferSize = 1024 * 1024
+	target     = "passthrough:///bufconn"
+)
@@ -0,0 +1,15 @@
+/*
+Package operationstats summarizes the client-measured latency of operations
+for the reports of the benchmark and loadtest packages.
+
+[Stats] holds the count, errors and latency percentiles of one operation.
+[WriteTable] renders operations as aligned columns, and [MarshalReport] and [ParseReport]
+save and read back any report as JSON.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package operationstats
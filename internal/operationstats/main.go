@@ -0,0 +1,12 @@
+package operationstats
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	percent      = 100
+	percentile50 = 50
+	percentile95 = 95
+	percentile99 = 99
+)
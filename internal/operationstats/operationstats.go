@@ -0,0 +1,197 @@
+package operationstats
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Stats summarizes the calls of an operation.
+Failed calls are counted in Errors and not timed.
+*/
+type Stats struct {
+	Count      int            `json:"COUNT"`
+	Errors     int            `json:"ERRORS"`
+	ErrorTypes map[string]int `json:"ERROR_TYPES,omitempty"` // Failed calls by type of error, if classified.
+	Max        time.Duration  `json:"MAX"`
+	Mean       time.Duration  `json:"MEAN"`
+	Min        time.Duration  `json:"MIN"`
+	Name       string         `json:"NAME"`
+	P50        time.Duration  `json:"P50"`
+	P95        time.Duration  `json:"P95"`
+	P99        time.Duration  `json:"P99"`
+	PerSecond  float64        `json:"PER_SECOND"` // See New.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The New function summarizes the latencies of the successful calls of an operation.
+
+Input
+  - name: The name of the operation.
+  - latencies: The latency of each successful call, in any order.
+  - errorCount: The number of failed calls.
+  - window: The time the calls were made in. If 0, PerSecond is the rate of calls made one at a time,
+    from the total latency; otherwise it is the rate of calls completed in the window.
+
+Output
+  - The statistics.
+*/
+func New(name string, latencies []time.Duration, errorCount int, window time.Duration) Stats {
+	result := Stats{
+		Count:      len(latencies),
+		Errors:     errorCount,
+		ErrorTypes: nil,
+		Max:        0,
+		Mean:       0,
+		Min:        0,
+		Name:       name,
+		P50:        0,
+		P95:        0,
+		P99:        0,
+		PerSecond:  0,
+	}
+
+	if len(latencies) == 0 {
+		return result
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+
+	result.Max = sorted[len(sorted)-1]
+	result.Mean = total / time.Duration(len(sorted))
+	result.Min = sorted[0]
+	result.P50 = percentile(sorted, percentile50)
+	result.P95 = percentile(sorted, percentile95)
+	result.P99 = percentile(sorted, percentile99)
+
+	if window <= 0 {
+		window = total
+	}
+
+	if window > 0 {
+		result.PerSecond = float64(len(sorted)) / window.Seconds()
+	}
+
+	return result
+}
+
+/*
+The Find function returns the statistics of an operation.
+
+Input
+  - operations: The statistics of the operations of a report.
+  - name: The name of the operation.
+
+Output
+  - The statistics, and whether the operation is in operations.
+*/
+func Find(operations []Stats, name string) (Stats, bool) {
+	for _, operation := range operations {
+		if operation.Name == name {
+			return operation, true
+		}
+	}
+
+	return Stats{}, false //exhaustruct:ignore
+}
+
+/*
+The FormatLatency function rounds a latency to microseconds for display.
+*/
+func FormatLatency(latency time.Duration) string {
+	return latency.Round(time.Microsecond).String()
+}
+
+/*
+The MarshalReport function returns a report as a JSON document.
+
+Input
+  - report: The report.
+
+Output
+  - The JSON document.
+*/
+func MarshalReport(report any) (string, error) {
+	result, err := json.Marshal(report)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+/*
+The NewTabWriter function returns the writer of aligned columns used by reports.
+The writer must be flushed.
+*/
+func NewTabWriter(builder *strings.Builder) *tabwriter.Writer {
+	return tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0) //nolint:mnd
+}
+
+/*
+The ParseReport function reads a report saved with MarshalReport.
+
+Input
+  - reportJSON: The JSON document.
+  - report: A pointer to the report to fill.
+*/
+func ParseReport(reportJSON string, report any) error {
+	err := json.Unmarshal([]byte(reportJSON), report)
+
+	return wraperror.Errorf(err, "json.Unmarshal")
+}
+
+/*
+The WriteTable function writes operations as indented, aligned columns.
+
+Input
+  - builder: Where the table is written.
+  - operations: The statistics of the operations of a report.
+*/
+func WriteTable(builder *strings.Builder, operations []Stats) {
+	writer := NewTabWriter(builder)
+	fmt.Fprintln(writer, "  Operation\tCount\tErrors\tMin\tP50\tP95\tP99\tMax\tPer second")
+
+	for _, operation := range operations {
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%.1f\n",
+			operation.Name, operation.Count, operation.Errors,
+			FormatLatency(operation.Min), FormatLatency(operation.P50), FormatLatency(operation.P95),
+			FormatLatency(operation.P99), FormatLatency(operation.Max), operation.PerSecond)
+	}
+
+	_ = writer.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+The nearest-rank percentile of sorted latencies.
+*/
+func percentile(sorted []time.Duration, rank int) time.Duration {
+	index := int(math.Ceil(float64(rank)/percent*float64(len(sorted)))) - 1
+
+	return sorted[max(index, 0)]
+}
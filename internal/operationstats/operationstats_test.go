@@ -0,0 +1,142 @@
+package operationstats_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/operationstats"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNew(test *testing.T) {
+	latencies := make([]time.Duration, 0, 100)
+	for index := 100; index > 0; index-- {
+		latencies = append(latencies, time.Duration(index)*time.Millisecond)
+	}
+
+	actual := operationstats.New("SzEngine.AddRecord", latencies, 2, 0)
+	printDebug(test, nil, actual)
+	require.Equal(test, 100, actual.Count)
+	require.Equal(test, 2, actual.Errors)
+	require.Equal(test, time.Millisecond, actual.Min)
+	require.Equal(test, 100*time.Millisecond, actual.Max)
+	require.Equal(test, 50500*time.Microsecond, actual.Mean)
+	require.Equal(test, 50*time.Millisecond, actual.P50)
+	require.Equal(test, 95*time.Millisecond, actual.P95)
+	require.Equal(test, 99*time.Millisecond, actual.P99)
+	require.InDelta(test, 100/5.05, actual.PerSecond, 0.001)
+	require.Equal(test, 100*time.Millisecond, latencies[0], "latencies are not sorted in place")
+}
+
+func TestNew_window(test *testing.T) {
+	actual := operationstats.New("SzEngine.AddRecord", []time.Duration{time.Millisecond, time.Millisecond}, 0,
+		time.Second)
+	require.InDelta(test, 2.0, actual.PerSecond, 0.001)
+}
+
+func TestNew_empty(test *testing.T) {
+	actual := operationstats.New("SzEngine.AddRecord", nil, 3, time.Second)
+	require.Zero(test, actual.Count)
+	require.Equal(test, 3, actual.Errors)
+	require.Zero(test, actual.P99)
+	require.Zero(test, actual.PerSecond)
+}
+
+func TestFind(test *testing.T) {
+	operations := []operationstats.Stats{
+		operationstats.New("SzEngine.AddRecord", []time.Duration{time.Millisecond}, 0, 0),
+		operationstats.New("SzEngine.DeleteRecord", []time.Duration{time.Millisecond}, 1, 0),
+	}
+
+	actual, isOK := operationstats.Find(operations, "SzEngine.DeleteRecord")
+	require.True(test, isOK)
+	require.Equal(test, 1, actual.Errors)
+
+	_, isOK = operationstats.Find(operations, "SzEngine.WhyEntities")
+	require.False(test, isOK)
+}
+
+func TestMarshalReport(test *testing.T) {
+	report := struct {
+		Operations []operationstats.Stats `json:"OPERATIONS"`
+	}{
+		Operations: []operationstats.Stats{
+			operationstats.New("SzEngine.AddRecord", []time.Duration{time.Millisecond}, 0, 0),
+		},
+	}
+	report.Operations[0].ErrorTypes = map[string]int{"SzRetryTimeoutExceededError": 1}
+
+	reportJSON, err := operationstats.MarshalReport(report)
+	printDebug(test, err, reportJSON)
+	require.NoError(test, err)
+	require.Contains(test, reportJSON, `"ERROR_TYPES":{"SzRetryTimeoutExceededError":1}`)
+
+	actual := report
+	actual.Operations = nil
+	err = operationstats.ParseReport(reportJSON, &actual)
+	require.NoError(test, err)
+	require.Equal(test, report, actual)
+}
+
+func TestMarshalReport_noErrorTypes(test *testing.T) {
+	reportJSON, err := operationstats.MarshalReport(operationstats.New("SzEngine.AddRecord", nil, 0, 0))
+	require.NoError(test, err)
+	require.NotContains(test, reportJSON, "ERROR_TYPES")
+}
+
+func TestParseReport_badJSON(test *testing.T) {
+	report := operationstats.Stats{} //exhaustruct:ignore
+	err := operationstats.ParseReport("{", &report)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "json.Unmarshal")
+}
+
+func TestWriteTable(test *testing.T) {
+	var builder strings.Builder
+
+	operationstats.WriteTable(&builder, []operationstats.Stats{
+		operationstats.New("SzEngine.AddRecord", []time.Duration{1500 * time.Microsecond}, 1, time.Second),
+	})
+	printDebug(test, nil, builder.String())
+
+	lines := strings.Split(strings.TrimSpace(builder.String()), "\n")
+	require.Len(test, lines, 2)
+	require.Equal(test, []string{"Operation", "Count", "Errors", "Min", "P50", "P95", "P99", "Max", "Per", "second"},
+		strings.Fields(lines[0]))
+	require.Equal(test, []string{"SzEngine.AddRecord", "1", "1", "1.5ms", "1.5ms", "1.5ms", "1.5ms", "1.5ms", "1.0"},
+		strings.Fields(lines[1]))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package licensemonitor warns when the Senzing license nears its expiry date or its record limit.
+
+A [Monitor] reads the license with SzProduct.GetLicense and compares its expiry date and record limit
+with the current time and the number of records in the repository.
+Warnings are logged and sent to observers registered with [Monitor.RegisterObserver].
+
+SzDiagnostic.GetRepositoryInfo describes the repository's data stores but does not report a record count,
+so the count is supplied by the Monitor's RecordCount function, for example a count of the DSRC_RECORD table.
+If RecordCount is not set, the record limit is not checked.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package licensemonitor
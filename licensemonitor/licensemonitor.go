@@ -0,0 +1,460 @@
+package licensemonitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+License is the JSON document returned by SzProduct.GetLicense.
+*/
+type License struct {
+	AdvSearch    int64  `json:"advSearch"`
+	Billing      string `json:"billing"`
+	Contract     string `json:"contract"`
+	Customer     string `json:"customer"`
+	ExpireDate   string `json:"expireDate"` // In "YYYY-MM-DD" format.
+	IssueDate    string `json:"issueDate"`  // In "YYYY-MM-DD" format.
+	LicenseLevel string `json:"licenseLevel"`
+	LicenseType  string `json:"licenseType"`
+	RecordLimit  int64  `json:"recordLimit"` // If 0, the number of records is not limited.
+}
+
+/*
+DataStore is an entry of the "dataStores" list returned by SzDiagnostic.GetRepositoryInfo.
+*/
+type DataStore struct {
+	ID       string `json:"id"`
+	Location string `json:"location"`
+	Type     string `json:"type"`
+}
+
+/*
+Monitor checks the Senzing license against the clock and the repository's record count.
+*/
+type Monitor struct {
+	SzDiagnostic senzing.SzDiagnostic // Optional. If set, the repository's data stores are reported.
+	SzProduct    senzing.SzProduct
+
+	// Optional. Returns the number of records in the repository.
+	// If nil, the record limit is not checked.
+	RecordCount func(ctx context.Context) (int64, error)
+
+	// Optional. The fraction of the record limit, between 0 and 1, at which a warning is raised.
+	// If 0, DefaultCapacityWarning is used.
+	CapacityWarning float64
+
+	// Optional. How long before the license expires a warning is raised.
+	// If 0, DefaultExpiryWarning is used.
+	ExpiryWarning time.Duration
+
+	// Optional. Returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	// Optional. If 0, DefaultPollInterval is used.
+	PollInterval time.Duration
+
+	logger         logging.Logging
+	loggerMutex    sync.Mutex   // Guards logger.
+	observerMutex  sync.RWMutex // Guards observerOrigin and observers.
+	observerOrigin string
+	observers      subject.Subject
+}
+
+/*
+Status is the result of [Monitor.Check].
+*/
+type Status struct {
+	CheckedAt   time.Time
+	DataStores  []DataStore // Empty if Monitor.SzDiagnostic is not set.
+	ExpiresAt   time.Time
+	ExpiresIn   time.Duration // Negative once the license has expired.
+	License     License
+	RecordCount int64 // 0 if Monitor.RecordCount is not set.
+	Warnings    []Warning
+}
+
+/*
+Warning is a threshold reached by the license.
+*/
+type Warning struct {
+	MessageID int // One of the Message* constants.
+	Text      string
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ParseLicense function reads the JSON document returned by SzProduct.GetLicense.
+
+Input
+  - licenseJSON: The JSON document returned by SzProduct.GetLicense.
+
+Output
+  - The license.
+*/
+func ParseLicense(licenseJSON string) (*License, error) {
+	result := &License{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(licenseJSON), result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// License methods
+// ----------------------------------------------------------------------------
+
+/*
+Method ExpiresAt returns the expiry date of the license, at midnight UTC.
+
+Output
+  - The time at which the license expires.
+*/
+func (license *License) ExpiresAt() (time.Time, error) {
+	if len(license.ExpireDate) == 0 {
+		return time.Time{}, wraperror.Errorf(errForPackage, "license has no expireDate")
+	}
+
+	result, err := time.Parse(dateLayout, license.ExpireDate)
+
+	return result, wraperror.Errorf(err, "expireDate")
+}
+
+// ----------------------------------------------------------------------------
+// Monitor methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Check reads the license once and reports the thresholds it has reached.
+Each warning is logged and sent to observers.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The license status.
+*/
+func (monitor *Monitor) Check(ctx context.Context) (*Status, error) {
+	result, err := monitor.gather(ctx)
+	if err != nil {
+		monitor.getLogger().Log(MessageCheckFailed, err)
+		monitor.notify(ctx, MessageCheckFailed, err, nil)
+
+		return nil, err
+	}
+
+	result.Warnings = monitor.evaluate(result)
+
+	for _, warning := range result.Warnings {
+		monitor.notify(ctx, warning.MessageID, nil, result.details(warning))
+	}
+
+	return result, nil
+}
+
+/*
+Method GetObserverOrigin returns the "origin" value of past Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The value sent in the Observer's "origin" key/value pair.
+*/
+func (monitor *Monitor) GetObserverOrigin(ctx context.Context) string {
+	_ = ctx
+
+	monitor.observerMutex.RLock()
+	defer monitor.observerMutex.RUnlock()
+
+	return monitor.observerOrigin
+}
+
+/*
+Method RegisterObserver adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (monitor *Monitor) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	monitor.observerMutex.Lock()
+	defer monitor.observerMutex.Unlock()
+
+	if monitor.observers == nil {
+		monitor.observers = &subject.SimpleSubject{}
+	}
+
+	err := monitor.observers.RegisterObserver(ctx, observer)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method Run checks the license every PollInterval until the context is canceled.
+Errors are logged and reported to observers and do not stop Run.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (monitor *Monitor) Run(ctx context.Context) {
+	pollInterval := monitor.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = monitor.Check(ctx) // Errors are logged and reported to observers.
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+Method SetLogLevel sets the level of logging.
+Warnings are logged at WARN level; failed checks at ERROR level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logLevelName: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func (monitor *Monitor) SetLogLevel(ctx context.Context, logLevelName string) error {
+	_ = ctx
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		return wraperror.Errorf(errForPackage, "invalid error level: %s", logLevelName)
+	}
+
+	err := monitor.getLogger().SetLogLevel(logLevelName)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (monitor *Monitor) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+
+	monitor.observerMutex.Lock()
+	defer monitor.observerMutex.Unlock()
+
+	monitor.observerOrigin = origin
+}
+
+/*
+Method UnregisterObserver removes the observer from the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (monitor *Monitor) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	// Waits for notifications in flight, as SimpleSubject does not guard its observer list while notifying.
+
+	monitor.observerMutex.Lock()
+	defer monitor.observerMutex.Unlock()
+
+	if monitor.observers != nil {
+		err = monitor.observers.UnregisterObserver(ctx, observer)
+
+		if !monitor.observers.HasObservers(ctx) {
+			monitor.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Compare the status with the thresholds, logging each warning.
+*/
+func (monitor *Monitor) evaluate(status *Status) []Warning {
+	result := []Warning{}
+
+	expiryWarning := monitor.ExpiryWarning
+	if expiryWarning <= 0 {
+		expiryWarning = DefaultExpiryWarning
+	}
+
+	switch {
+	case status.ExpiresIn <= 0:
+		result = append(result, monitor.warn(MessageLicenseExpired, status.License.ExpireDate))
+	case status.ExpiresIn <= expiryWarning:
+		result = append(result,
+			monitor.warn(MessageLicenseExpiring, status.License.ExpireDate, int(status.ExpiresIn/day)))
+	}
+
+	recordLimit := status.License.RecordLimit
+	if monitor.RecordCount == nil || recordLimit <= 0 {
+		return result
+	}
+
+	capacityWarning := monitor.CapacityWarning
+	if capacityWarning <= 0 {
+		capacityWarning = DefaultCapacityWarning
+	}
+
+	capacityUsed := float64(status.RecordCount) / float64(recordLimit)
+
+	switch {
+	case status.RecordCount >= recordLimit:
+		result = append(result, monitor.warn(MessageCapacityExceeded, status.RecordCount, recordLimit))
+	case capacityUsed >= capacityWarning:
+		result = append(result,
+			monitor.warn(MessageCapacityApproaching, status.RecordCount, capacityUsed*percent, recordLimit))
+	}
+
+	return result
+}
+
+func (monitor *Monitor) gather(ctx context.Context) (*Status, error) {
+	licenseJSON, err := monitor.SzProduct.GetLicense(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetLicense")
+	}
+
+	license, err := ParseLicense(licenseJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := license.ExpiresAt()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now
+	if monitor.Now != nil {
+		now = monitor.Now
+	}
+
+	checkedAt := now()
+	result := &Status{
+		CheckedAt:   checkedAt,
+		DataStores:  []DataStore{},
+		ExpiresAt:   expiresAt,
+		ExpiresIn:   expiresAt.Sub(checkedAt),
+		License:     *license,
+		RecordCount: 0,
+		Warnings:    []Warning{},
+	}
+
+	if monitor.SzDiagnostic != nil {
+		result.DataStores, err = monitor.getDataStores(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if monitor.RecordCount != nil {
+		result.RecordCount, err = monitor.RecordCount(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "RecordCount")
+		}
+	}
+
+	return result, nil
+}
+
+func (monitor *Monitor) getDataStores(ctx context.Context) ([]DataStore, error) {
+	repositoryInfoJSON, err := monitor.SzDiagnostic.GetRepositoryInfo(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetRepositoryInfo")
+	}
+
+	repositoryInfo := struct {
+		DataStores []DataStore `json:"dataStores"`
+	}{
+		DataStores: []DataStore{},
+	}
+
+	err = json.Unmarshal([]byte(repositoryInfoJSON), &repositoryInfo)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal: GetRepositoryInfo")
+	}
+
+	return repositoryInfo.DataStores, nil
+}
+
+// Get the Logger singleton.
+func (monitor *Monitor) getLogger() logging.Logging {
+	monitor.loggerMutex.Lock()
+	defer monitor.loggerMutex.Unlock()
+
+	if monitor.logger == nil {
+		monitor.logger = helper.GetLogger(ComponentID, IDMessages, baseCallerSkip)
+	}
+
+	return monitor.logger
+}
+
+func (monitor *Monitor) notify(ctx context.Context, messageID int, err error, details map[string]string) {
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	go func() {
+		monitor.observerMutex.RLock()
+		defer monitor.observerMutex.RUnlock()
+
+		notifier.Notify(ctx, monitor.observers, monitor.observerOrigin, ComponentID, messageID, err, details)
+	}()
+}
+
+func (monitor *Monitor) warn(messageID int, details ...any) Warning {
+	monitor.getLogger().Log(messageID, details...)
+
+	return Warning{
+		MessageID: messageID,
+		Text:      fmt.Sprintf(IDMessages[messageID], details...),
+	}
+}
+
+func (status *Status) details(warning Warning) map[string]string {
+	return map[string]string{
+		"expireDate":  status.License.ExpireDate,
+		"recordCount": strconv.FormatInt(status.RecordCount, 10),
+		"recordLimit": strconv.FormatInt(status.License.RecordLimit, 10),
+		"text":        warning.Text,
+	}
+}
@@ -0,0 +1,49 @@
+package licensemonitor_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/licensemonitor"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleParseLicense() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/licensemonitor/licensemonitor_test.go
+	license, err := licensemonitor.ParseLicense(licenseJSON)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(license.ExpireDate, license.RecordLimit)
+	// Output: 2026-10-11 500
+}
+
+func ExampleMonitor_Check() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/licensemonitor/licensemonitor_test.go
+	ctx := context.TODO()
+	monitor := getMonitor(ctx)
+
+	status, err := monitor.Check(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	for _, warning := range status.Warnings {
+		fmt.Println(warning.Text)
+	}
+	// Output:
+	// Senzing license expires on 2026-10-11, in 10 days.
+	// Senzing repository holds 460 records, 92% of the license limit of 500.
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getMonitor(_ context.Context) *licensemonitor.Monitor {
+	return newMonitor(daysBeforeExpiry(10), 460)
+}
@@ -0,0 +1,358 @@
+package licensemonitor_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/licensemonitor"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParseLicense(test *testing.T) {
+	license, err := licensemonitor.ParseLicense(licenseJSON)
+	printDebug(test, err, license)
+	require.NoError(test, err)
+	require.Equal(test, "EVAL (Solely for non-productive use)", license.LicenseType)
+	require.Equal(test, int64(500), license.RecordLimit)
+
+	expiresAt, err := license.ExpiresAt()
+	require.NoError(test, err)
+	require.Equal(test, time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC), expiresAt)
+}
+
+func TestParseLicense_badJSON(test *testing.T) {
+	_, err := licensemonitor.ParseLicense("}{")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestLicense_ExpiresAt_missing(test *testing.T) {
+	license, err := licensemonitor.ParseLicense(`{"recordLimit": 500}`)
+	require.NoError(test, err)
+
+	_, err = license.ExpiresAt()
+	printDebug(test, err)
+	require.ErrorContains(test, err, "expireDate")
+}
+
+func TestMonitor_Check(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 100)
+
+	status, err := monitor.Check(ctx)
+	printDebug(test, err, status)
+	require.NoError(test, err)
+	require.Empty(test, status.Warnings)
+	require.Equal(test, 100*24*time.Hour, status.ExpiresIn)
+	require.Equal(test, int64(100), status.RecordCount)
+	require.Equal(test, []licensemonitor.DataStore{{ID: "CORE", Location: "/tmp/sqlite/G2C.db", Type: "sqlite3"}},
+		status.DataStores)
+}
+
+func TestMonitor_Check_expiring(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(10), 100)
+
+	status, err := monitor.Check(ctx)
+	printDebug(test, err, status)
+	require.NoError(test, err)
+	require.Len(test, status.Warnings, 1)
+	require.Equal(test, licensemonitor.MessageLicenseExpiring, status.Warnings[0].MessageID)
+	require.Equal(test, "Senzing license expires on 2026-10-11, in 10 days.", status.Warnings[0].Text)
+
+	// A shorter warning period silences the warning.
+
+	monitor.ExpiryWarning = 7 * 24 * time.Hour
+
+	status, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	require.Empty(test, status.Warnings)
+}
+
+func TestMonitor_Check_expired(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(-1), 100)
+
+	status, err := monitor.Check(ctx)
+	printDebug(test, err, status)
+	require.NoError(test, err)
+	require.Len(test, status.Warnings, 1)
+	require.Equal(test, licensemonitor.MessageLicenseExpired, status.Warnings[0].MessageID)
+	require.Negative(test, status.ExpiresIn)
+}
+
+func TestMonitor_Check_capacity(test *testing.T) {
+	ctx := test.Context()
+	testCases := []struct {
+		name            string
+		recordCount     int64
+		capacityWarning float64
+		expected        []int
+	}{
+		{name: "below", recordCount: 449, expected: []int{}},
+		{name: "approaching", recordCount: 460, expected: []int{licensemonitor.MessageCapacityApproaching}},
+		{name: "at limit", recordCount: 500, expected: []int{licensemonitor.MessageCapacityExceeded}},
+		{name: "over limit", recordCount: 501, expected: []int{licensemonitor.MessageCapacityExceeded}},
+		{name: "custom threshold", recordCount: 260, capacityWarning: 0.5, expected: []int{
+			licensemonitor.MessageCapacityApproaching,
+		}},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			monitor := newMonitor(daysBeforeExpiry(100), testCase.recordCount)
+			monitor.CapacityWarning = testCase.capacityWarning
+
+			status, err := monitor.Check(ctx)
+			printDebug(test, err, status)
+			require.NoError(test, err)
+			require.Equal(test, testCase.expected, messageIDs(status.Warnings))
+		})
+	}
+}
+
+func TestMonitor_Check_capacityText(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 460)
+
+	status, err := monitor.Check(ctx)
+	require.NoError(test, err)
+	require.Len(test, status.Warnings, 1)
+	require.Equal(test, "Senzing repository holds 460 records, 92% of the license limit of 500.", status.Warnings[0].Text)
+}
+
+func TestMonitor_Check_noRecordCount(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 1000)
+	monitor.RecordCount = nil
+	monitor.SzDiagnostic = nil
+
+	status, err := monitor.Check(ctx)
+	printDebug(test, err, status)
+	require.NoError(test, err)
+	require.Empty(test, status.Warnings)
+	require.Empty(test, status.DataStores)
+	require.Zero(test, status.RecordCount)
+}
+
+func TestMonitor_Check_error(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 100)
+	monitor.SzProduct = &fakeSzProduct{
+		err: szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`),
+	} //exhaustruct:ignore
+	observer := newTestObserver()
+	require.NoError(test, monitor.RegisterObserver(ctx, observer))
+
+	_, err := monitor.Check(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+
+	message := observer.waitFor(test, licensemonitor.MessageCheckFailed)
+	require.Contains(test, message["error"], "SENZ0010")
+}
+
+func TestMonitor_Check_recordCountError(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 100)
+	monitor.RecordCount = func(_ context.Context) (int64, error) {
+		return 0, context.DeadlineExceeded
+	}
+
+	_, err := monitor.Check(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "RecordCount")
+}
+
+func TestMonitor_Check_observer(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(10), 500)
+	observer := newTestObserver()
+	require.NoError(test, monitor.RegisterObserver(ctx, observer))
+	monitor.SetObserverOrigin(ctx, "worker-1")
+	require.Equal(test, "worker-1", monitor.GetObserverOrigin(ctx))
+
+	_, err := monitor.Check(ctx)
+	require.NoError(test, err)
+
+	message := observer.waitFor(test, licensemonitor.MessageLicenseExpiring)
+	printDebug(test, nil, message)
+	require.Equal(test, "worker-1", message["origin"])
+	require.Equal(test, "2026-10-11", message["expireDate"])
+
+	message = observer.waitFor(test, licensemonitor.MessageCapacityExceeded)
+	require.Equal(test, "500", message["recordCount"])
+	require.Equal(test, "500", message["recordLimit"])
+
+	require.NoError(test, monitor.UnregisterObserver(ctx, observer))
+}
+
+func TestMonitor_Run(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	monitor := newMonitor(daysBeforeExpiry(100), 100)
+	monitor.PollInterval = time.Millisecond
+
+	var checks atomic.Int64
+
+	monitor.RecordCount = func(_ context.Context) (int64, error) {
+		checks.Add(1)
+
+		return 100, nil
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		monitor.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(test, func() bool { return checks.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestMonitor_SetLogLevel(test *testing.T) {
+	ctx := test.Context()
+	monitor := newMonitor(daysBeforeExpiry(100), 100)
+	require.NoError(test, monitor.SetLogLevel(ctx, "ERROR"))
+
+	err := monitor.SetLogLevel(ctx, "BADLEVEL")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+const (
+	licenseJSON = `{"advSearch": 0, "billing": "", "contract": "", "customer": "", "expireDate": "2026-10-11",` +
+		` "issueDate": "2025-10-10", "licenseLevel": "", "licenseType": "EVAL (Solely for non-productive use)",` +
+		` "recordLimit": 500}`
+	repositoryInfoJSON = `{"dataStores": [{"id": "CORE", "location": "/tmp/sqlite/G2C.db", "type": "sqlite3"}]}`
+)
+
+func daysBeforeExpiry(days int) time.Time {
+	return time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -days)
+}
+
+func messageIDs(warnings []licensemonitor.Warning) []int {
+	result := []int{}
+	for _, warning := range warnings {
+		result = append(result, warning.MessageID)
+	}
+
+	return result
+}
+
+func newMonitor(now time.Time, recordCount int64) *licensemonitor.Monitor {
+	return &licensemonitor.Monitor{ //exhaustruct:ignore
+		SzDiagnostic: &fakeSzDiagnostic{},                  //exhaustruct:ignore
+		SzProduct:    &fakeSzProduct{license: licenseJSON}, //exhaustruct:ignore
+		RecordCount: func(_ context.Context) (int64, error) {
+			return recordCount, nil
+		},
+		Now: func() time.Time { return now },
+	}
+}
+
+type fakeSzDiagnostic struct {
+	senzing.SzDiagnostic
+}
+
+func (diagnostic *fakeSzDiagnostic) GetRepositoryInfo(_ context.Context) (string, error) {
+	return repositoryInfoJSON, nil
+}
+
+type fakeSzProduct struct {
+	senzing.SzProduct
+
+	err     error
+	license string
+}
+
+func (product *fakeSzProduct) GetLicense(_ context.Context) (string, error) {
+	return product.license, product.err
+}
+
+type testObserver struct {
+	messages chan map[string]string
+	received map[string]map[string]string // Messages read by waitFor but not yet returned, by messageId.
+}
+
+func newTestObserver() *testObserver {
+	return &testObserver{
+		messages: make(chan map[string]string, 100),
+		received: map[string]map[string]string{},
+	}
+}
+
+func (observer *testObserver) GetObserverID(_ context.Context) string {
+	return "testObserver"
+}
+
+func (observer *testObserver) UpdateObserver(_ context.Context, message string) {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.messages <- details
+}
+
+func (observer *testObserver) waitFor(t *testing.T, messageID int) map[string]string {
+	t.Helper()
+
+	key := strconv.Itoa(messageID)
+	timeout := time.After(time.Second)
+
+	for {
+		if message, isOK := observer.received[key]; isOK {
+			delete(observer.received, key)
+
+			return message
+		}
+
+		select {
+		case message := <-observer.messages:
+			observer.received[message["messageId"]] = message
+		case <-timeout:
+			t.Fatalf("no message %d", messageID)
+
+			return nil
+		}
+	}
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
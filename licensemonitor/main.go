@@ -0,0 +1,67 @@
+package licensemonitor
+
+import (
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ComponentID is the identifier of the licensemonitor package.
+Package licensemonitor messages will have the format "SZSDK6028eeee" where "eeee" is the error identifier.
+*/
+const ComponentID = 6028
+
+/*
+DefaultCapacityWarning is the fraction of the record limit at which a warning is raised
+when Monitor.CapacityWarning is not set.
+*/
+const DefaultCapacityWarning = 0.9
+
+/*
+DefaultExpiryWarning is how long before the license expires a warning is raised
+when Monitor.ExpiryWarning is not set.
+*/
+const DefaultExpiryWarning = 30 * 24 * time.Hour
+
+/*
+DefaultPollInterval is the time between checks when Monitor.PollInterval is not set.
+*/
+const DefaultPollInterval = time.Hour
+
+// Message identifiers, used for both log and Observer messages.
+// Identifiers in the 3000s are logged at WARN level; those in the 4000s at ERROR level.
+const (
+	MessageLicenseExpiring     = 3001
+	MessageLicenseExpired      = 3002
+	MessageCapacityApproaching = 3003
+	MessageCapacityExceeded    = 3004
+	MessageCheckFailed         = 4001
+)
+
+const (
+	baseCallerSkip = 3
+	dateLayout     = "2006-01-02"
+	day            = 24 * time.Hour
+	percent        = 100
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+IDMessages are the templates of the messages logged by a Monitor.
+*/
+var IDMessages = map[int]string{
+	MessageLicenseExpiring:     "Senzing license expires on %s, in %d days.",
+	MessageLicenseExpired:      "Senzing license expired on %s.",
+	MessageCapacityApproaching: "Senzing repository holds %d records, %.0f%% of the license limit of %d.",
+	MessageCapacityExceeded:    "Senzing repository holds %d records, at or over the license limit of %d.",
+	MessageCheckFailed:         "Senzing license check failed: %v",
+}
+
+var errForPackage = errors.New("licensemonitor")
@@ -0,0 +1,27 @@
+/*
+Package loadtest drives a Senzing environment with a mix of concurrent SzEngine calls
+and reports how it held up.
+
+A [Runner] adds seed records, then for Runner.Duration sends a random mix of operations to Runner.Concurrency workers:
+
+  - SzEngine.AddRecord of a new record from a [synthetic.Generator].
+  - SzEngine.SearchByAttributes with the attributes of an added record.
+  - SzEngine.GetEntityByRecordID of an added record.
+  - SzEngine.WhyEntities of the entities of two added records.
+
+Runner.Mix sets how often each operation is chosen; [ParseMix] reads it from text such as "AddRecord=1,WhyEntities=2".
+With Runner.TargetQPS, operations are started at that rate; operations due while all workers are busy are skipped
+and counted as missed, so a run that cannot keep up shows it.
+
+The [Report] has latency percentiles per operation and counts errors by szerror type; see [ErrorType].
+It can be saved as JSON and read back with [ParseReport].
+
+The loadtest command in cmd/loadtest runs a Runner against a Senzing gRPC server.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package loadtest
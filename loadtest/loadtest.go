@@ -0,0 +1,583 @@
+package loadtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/synthetic"
+	"github.com/senzing-garage/sz-sdk-go-grpc/withinfo"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Runner runs a load test and produces a [Report].
+*/
+type Runner struct {
+	SzAbstractFactory senzing.SzAbstractFactory
+
+	// Optional. The number of workers, and so of concurrent calls. If 0, DefaultConcurrency is used.
+	Concurrency int
+
+	// Optional. The data source of added records. If empty, DefaultDataSourceCode is used.
+	DataSourceCode string
+
+	// Optional. How long operations are sent. If 0, DefaultDuration is used.
+	Duration time.Duration
+
+	// Optional. If true, records added are left in the repository. Otherwise they are deleted after the run.
+	KeepRecords bool
+
+	// Optional. The relative frequency of each operation, keyed by the Operation* constants.
+	// Operations not listed are not run. If empty, DefaultMix is used.
+	Mix map[string]int
+
+	// Optional. A label for the report, such as the name of the workload profile.
+	Name string
+
+	// Optional. The prefix of added record identifiers. If empty, one is made from the start time.
+	RecordIDPrefix string
+
+	// Optional. The seed of the random choices, including the records added. If 0, one is made from the start time.
+	Seed uint64
+
+	// Optional. The number of records added before the run, so that reads have records to find.
+	// If 0, DefaultSeedRecords is used.
+	SeedRecords int
+
+	// Optional. The operations started per second, across all workers. If 0, operations are sent as fast as
+	// the workers take them.
+	TargetQPS float64
+}
+
+/*
+A record added by the run, with the entity it was last seen in.
+*/
+type addedRecord struct {
+	entityID int64
+	record   synthetic.Record
+}
+
+/*
+The state of one run.
+*/
+type run struct {
+	addedRecords []addedRecord
+	generator    *synthetic.Generator
+	mutex        sync.Mutex // Guards addedRecords, random and samples.
+	random       *rand.Rand
+	samples      map[string]*samples
+	szEngine     senzing.SzEngine
+}
+
+/*
+The outcomes of the calls of one operation.
+*/
+type samples struct {
+	errorTypes map[string]int
+	latencies  []time.Duration
+}
+
+/*
+Chooses operations at random, in proportion to their weights.
+*/
+type chooser struct {
+	operations []string
+	total      int
+	weights    []int
+}
+
+type getEntityByRecordIDResponse struct {
+	ResolvedEntity struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"RESOLVED_ENTITY"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ErrorType function names the szerror type of an error, such as "SzNotFoundError".
+If the error is of several types, the most specific is named.
+
+Input
+  - err: An error returned by a Senzing call.
+
+Output
+  - The name of the type, or ErrorTypeOther if the error is not an szerror error.
+*/
+func ErrorType(err error) string {
+	for _, errorType := range errorTypes {
+		if errors.Is(err, errorType.err) {
+			return errorType.name
+		}
+	}
+
+	return ErrorTypeOther
+}
+
+/*
+The ParseMix function reads a mix of operations from text such as "AddRecord=1,GetEntityByRecordID=4".
+Operations may be named with or without the "SzEngine." prefix.
+
+Input
+  - mix: Comma-separated operation=weight pairs.
+
+Output
+  - The mix, keyed by the Operation* constants, as used by Runner.Mix.
+*/
+func ParseMix(mix string) (map[string]int, error) {
+	result := map[string]int{}
+
+	for pair := range strings.SplitSeq(mix, ",") {
+		name, weightText, isOK := strings.Cut(strings.TrimSpace(pair), "=")
+		if !isOK {
+			return nil, wraperror.Errorf(errForPackage, "%q is not operation=weight", pair)
+		}
+
+		operation := strings.TrimSpace(name)
+		if !strings.HasPrefix(operation, "SzEngine.") {
+			operation = "SzEngine." + operation
+		}
+
+		if !slices.Contains(operations, operation) {
+			return nil, wraperror.Errorf(errForPackage, "unknown operation %q", name)
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(weightText))
+		if err != nil || weight < 0 {
+			return nil, wraperror.Errorf(errForPackage, "weight of %s is not a whole number: %q", name, weightText)
+		}
+
+		result[operation] = weight
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Runner methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Run runs the load test: adds seed records, sends the mix of operations for Runner.Duration,
+then deletes the records added unless Runner.KeepRecords is set.
+Failed calls are counted and do not stop the run.
+
+Input
+  - ctx: A context to control lifecycle. Canceling it ends the run early.
+
+Output
+  - The report.
+*/
+func (runner *Runner) Run(ctx context.Context) (*Report, error) {
+	startedAt := time.Now()
+
+	chooser, err := newChooser(runner.mix())
+	if err != nil {
+		return nil, err
+	}
+
+	szEngine, err := runner.SzAbstractFactory.CreateEngine(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "CreateEngine")
+	}
+
+	aRun := runner.newRun(szEngine, startedAt)
+
+	seedRecords := runner.SeedRecords
+	if seedRecords <= 0 {
+		seedRecords = DefaultSeedRecords
+	}
+
+	err = aRun.addSeedRecords(ctx, seedRecords)
+	if err != nil {
+		if !runner.KeepRecords {
+			aRun.deleteRecords(context.WithoutCancel(ctx))
+		}
+
+		return nil, err
+	}
+
+	concurrency := runner.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	duration := runner.Duration
+	if duration <= 0 {
+		duration = DefaultDuration
+	}
+
+	runStartedAt := time.Now()
+	jobs := make(chan string)
+	missed := 0
+
+	var waitGroup sync.WaitGroup
+
+	for range concurrency {
+		waitGroup.Go(func() {
+			for operation := range jobs {
+				aRun.call(ctx, operation)
+			}
+		})
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	if runner.TargetQPS > 0 {
+		missed = aRun.sendAtRate(sendCtx, jobs, chooser, runner.TargetQPS)
+	} else {
+		aRun.send(sendCtx, jobs, chooser)
+	}
+
+	close(jobs)
+	waitGroup.Wait()
+
+	result := newReport(runner.Name, startedAt, time.Since(runStartedAt), runner.mix(), aRun.samples)
+	result.Concurrency = concurrency
+	result.Missed = missed
+	result.SeedRecords = seedRecords
+	result.TargetQPS = runner.TargetQPS
+
+	if !runner.KeepRecords {
+		// Records are deleted even if the context has been canceled, so that the repository is left as it was found.
+		result.DeleteErrors = aRun.deleteRecords(context.WithoutCancel(ctx))
+	}
+
+	result.Duration = time.Since(startedAt)
+
+	return result, wraperror.Errorf(ctx.Err(), "load test")
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (runner *Runner) mix() map[string]int {
+	if len(runner.Mix) == 0 {
+		return DefaultMix
+	}
+
+	return runner.Mix
+}
+
+func (runner *Runner) newRun(szEngine senzing.SzEngine, startedAt time.Time) *run {
+	seed := runner.Seed
+	if seed == 0 {
+		seed = uint64(startedAt.UnixNano()) //nolint:gosec // Nanoseconds since 1970 are positive.
+	}
+
+	dataSourceCode := runner.DataSourceCode
+	if len(dataSourceCode) == 0 {
+		dataSourceCode = DefaultDataSourceCode
+	}
+
+	recordIDPrefix := runner.RecordIDPrefix
+	if len(recordIDPrefix) == 0 {
+		recordIDPrefix = "LOADTEST-" + strconv.FormatInt(startedAt.UnixNano(), 10) + "-"
+	}
+
+	result := &run{
+		generator: &synthetic.Generator{
+			AddressVariantRate: variationRate,
+			DataSourceCode:     dataSourceCode,
+			DuplicateRate:      duplicateRate,
+			RecordIDPrefix:     recordIDPrefix,
+			Seed:               seed,
+			SwappedNameRate:    variationRate,
+			TypoRate:           variationRate,
+		}, //exhaustruct:ignore
+		random:   rand.New(rand.NewPCG(seed, seed)), //nolint:gosec // Repeatable by design.
+		samples:  map[string]*samples{},
+		szEngine: szEngine,
+	} //exhaustruct:ignore
+
+	for _, operation := range operations {
+		result.samples[operation] = &samples{errorTypes: map[string]int{}, latencies: []time.Duration{}}
+	}
+
+	return result
+}
+
+func (aRun *run) addSeedRecords(ctx context.Context, count int) error {
+	for range count {
+		aRecord := aRun.generator.Next()
+
+		infoJSON, err := aRun.szEngine.AddRecord(ctx, aRecord.DataSourceCode, aRecord.RecordID,
+			aRecord.RecordDefinition, senzing.SzWithInfo)
+		if err != nil {
+			return wraperror.Errorf(err, "AddRecord %s", aRecord.RecordID)
+		}
+
+		aRun.addRecord(aRecord, infoJSON)
+	}
+
+	return nil
+}
+
+func (aRun *run) addRecord(aRecord synthetic.Record, infoJSON string) {
+	var entityID int64
+
+	info, err := withinfo.Parse(infoJSON)
+	if err == nil && len(info.AffectedEntities) > 0 {
+		entityID = info.AffectedEntities[0].EntityID
+	}
+
+	aRun.mutex.Lock()
+	defer aRun.mutex.Unlock()
+
+	aRun.addedRecords = append(aRun.addedRecords, addedRecord{entityID: entityID, record: aRecord})
+}
+
+/*
+Call an operation and record its outcome.
+*/
+func (aRun *run) call(ctx context.Context, operation string) {
+	var (
+		err     error
+		latency time.Duration
+	)
+
+	switch operation {
+	case OperationAddRecord:
+		latency, err = aRun.callAddRecord(ctx)
+	case OperationGetEntityByRecordID:
+		latency, err = aRun.callGetEntityByRecordID(ctx)
+	case OperationSearchByAttributes:
+		latency, err = aRun.callSearchByAttributes(ctx)
+	case OperationWhyEntities:
+		latency, err = aRun.callWhyEntities(ctx)
+	}
+
+	aRun.mutex.Lock()
+	defer aRun.mutex.Unlock()
+
+	operationSamples := aRun.samples[operation]
+
+	if err != nil {
+		operationSamples.errorTypes[ErrorType(err)]++
+
+		return
+	}
+
+	operationSamples.latencies = append(operationSamples.latencies, latency)
+}
+
+func (aRun *run) callAddRecord(ctx context.Context) (time.Duration, error) {
+	aRecord := aRun.generator.Next()
+	start := time.Now()
+
+	infoJSON, err := aRun.szEngine.AddRecord(ctx, aRecord.DataSourceCode, aRecord.RecordID,
+		aRecord.RecordDefinition, senzing.SzWithInfo)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	latency := time.Since(start)
+	aRun.addRecord(aRecord, infoJSON)
+
+	return latency, nil
+}
+
+/*
+Look up a record, and note its entity for later WhyEntities calls.
+*/
+func (aRun *run) callGetEntityByRecordID(ctx context.Context) (time.Duration, error) {
+	index, anAddedRecord := aRun.pickRecord()
+	start := time.Now()
+
+	response, err := aRun.szEngine.GetEntityByRecordID(ctx, anAddedRecord.record.DataSourceCode,
+		anAddedRecord.record.RecordID, senzing.SzEntityDefaultFlags)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	latency := time.Since(start)
+	parsedResponse := &getEntityByRecordIDResponse{} //exhaustruct:ignore
+
+	if json.Unmarshal([]byte(response), parsedResponse) == nil && parsedResponse.ResolvedEntity.EntityID != 0 {
+		aRun.mutex.Lock()
+		aRun.addedRecords[index].entityID = parsedResponse.ResolvedEntity.EntityID
+		aRun.mutex.Unlock()
+	}
+
+	return latency, nil
+}
+
+/*
+Search with the attributes of a record, less its DATA_SOURCE and RECORD_ID.
+*/
+func (aRun *run) callSearchByAttributes(ctx context.Context) (time.Duration, error) {
+	_, anAddedRecord := aRun.pickRecord()
+	attributes := map[string]string{}
+
+	err := json.Unmarshal([]byte(anAddedRecord.record.RecordDefinition), &attributes)
+	if err != nil {
+		return 0, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	delete(attributes, "DATA_SOURCE")
+	delete(attributes, "RECORD_ID")
+
+	attributesJSON, _ := json.Marshal(attributes) //nolint:errchkjson
+	start := time.Now()
+
+	_, err = aRun.szEngine.SearchByAttributes(ctx, string(attributesJSON), "", senzing.SzSearchByAttributesDefaultFlags)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return time.Since(start), nil
+}
+
+/*
+Explain the entities of two records. The entities are as last seen, so merges since may give SzNotFoundError.
+*/
+func (aRun *run) callWhyEntities(ctx context.Context) (time.Duration, error) {
+	_, first := aRun.pickRecord()
+	_, second := aRun.pickRecord()
+	start := time.Now()
+
+	_, err := aRun.szEngine.WhyEntities(ctx, first.entityID, second.entityID, senzing.SzWhyEntitiesDefaultFlags)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return time.Since(start), nil
+}
+
+func (aRun *run) chooseOperation(chooser *chooser) string {
+	aRun.mutex.Lock()
+	defer aRun.mutex.Unlock()
+
+	return chooser.choose(aRun.random)
+}
+
+/*
+Delete the records added, and count the failures.
+*/
+func (aRun *run) deleteRecords(ctx context.Context) int {
+	result := 0
+
+	for _, anAddedRecord := range aRun.addedRecords {
+		_, err := aRun.szEngine.DeleteRecord(ctx, anAddedRecord.record.DataSourceCode, anAddedRecord.record.RecordID,
+			senzing.SzNoFlags)
+		if err != nil {
+			result++
+		}
+	}
+
+	return result
+}
+
+func (aRun *run) pickRecord() (int, addedRecord) {
+	aRun.mutex.Lock()
+	defer aRun.mutex.Unlock()
+
+	index := aRun.random.IntN(len(aRun.addedRecords))
+
+	return index, aRun.addedRecords[index]
+}
+
+/*
+Send operations as fast as the workers take them, until ctx is done.
+*/
+func (aRun *run) send(ctx context.Context, jobs chan<- string, chooser *chooser) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case jobs <- aRun.chooseOperation(chooser):
+		}
+	}
+}
+
+/*
+Send operations at a rate until ctx is done. Operations due while all workers are busy are skipped.
+Returns the number skipped.
+*/
+func (aRun *run) sendAtRate(ctx context.Context, jobs chan<- string, chooser *chooser, rate float64) int {
+	result := 0
+	ticker := time.NewTicker(max(time.Duration(float64(time.Second)/rate), time.Nanosecond))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return result
+		case <-ticker.C:
+			select {
+			case jobs <- aRun.chooseOperation(chooser):
+			default:
+				result++
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// chooser methods
+// ----------------------------------------------------------------------------
+
+func (chooser *chooser) choose(random *rand.Rand) string {
+	draw := random.IntN(chooser.total)
+
+	for index, weight := range chooser.weights {
+		if draw < weight {
+			return chooser.operations[index]
+		}
+
+		draw -= weight
+	}
+
+	return chooser.operations[len(chooser.operations)-1]
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func newChooser(mix map[string]int) (*chooser, error) {
+	result := &chooser{operations: []string{}, total: 0, weights: []int{}}
+
+	for operation, weight := range mix {
+		if !slices.Contains(operations, operation) {
+			return nil, wraperror.Errorf(errForPackage, "unknown operation %q in mix", operation)
+		}
+
+		if weight < 0 {
+			return nil, wraperror.Errorf(errForPackage, "negative weight of %s in mix", operation)
+		}
+	}
+
+	// Operations are taken in a fixed order, so that the same seed makes the same choices.
+
+	for _, operation := range operations {
+		if mix[operation] > 0 {
+			result.operations = append(result.operations, operation)
+			result.weights = append(result.weights, mix[operation])
+			result.total += mix[operation]
+		}
+	}
+
+	if result.total == 0 {
+		return nil, wraperror.Errorf(errForPackage, "mix has no operations")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package loadtest_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/loadtest"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleParseMix() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/loadtest/loadtest_test.go
+	mix, err := loadtest.ParseMix("AddRecord=1,GetEntityByRecordID=4")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(mix[loadtest.OperationAddRecord], mix[loadtest.OperationGetEntityByRecordID])
+	// Output: 1 4
+}
+
+func ExampleErrorType() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/loadtest/loadtest_test.go
+	err := szerror.New(10, "SENZ0010|Retry timeout exceeded")
+
+	fmt.Println(loadtest.ErrorType(err))
+	// Output: SzRetryTimeoutExceededError
+}
@@ -0,0 +1,431 @@
+package loadtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/loadtest"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRunner_Run(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Concurrency:       3,
+		Duration:          200 * time.Millisecond,
+		Name:              "mixed",
+		RecordIDPrefix:    "LOAD-",
+		Seed:              1,
+		SeedRecords:       10,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Equal(test, "mixed", report.Name)
+	require.Equal(test, 3, report.Concurrency)
+	require.Equal(test, 10, report.SeedRecords)
+	require.Len(test, report.Operations, 4)
+	require.Positive(test, report.QPS)
+	require.GreaterOrEqual(test, report.RunDuration, 200*time.Millisecond)
+	require.GreaterOrEqual(test, report.Duration, report.RunDuration)
+
+	for _, name := range []string{
+		loadtest.OperationAddRecord,
+		loadtest.OperationGetEntityByRecordID,
+		loadtest.OperationSearchByAttributes,
+		loadtest.OperationWhyEntities,
+	} {
+		operation, isOK := report.Operation(name)
+		require.True(test, isOK, name)
+		require.Positive(test, operation.Count, name)
+		require.Zero(test, operation.Errors, name)
+		require.LessOrEqual(test, operation.Min, operation.P50, name)
+		require.LessOrEqual(test, operation.P50, operation.P95, name)
+		require.LessOrEqual(test, operation.P95, operation.P99, name)
+		require.LessOrEqual(test, operation.P99, operation.Max, name)
+	}
+
+	addRecord, _ := report.Operation(loadtest.OperationAddRecord)
+	require.Equal(test, 10+addRecord.Count, engine.added)
+	require.Empty(test, engine.records, "added records are deleted")
+	require.Zero(test, report.DeleteErrors)
+}
+
+func TestRunner_Run_mix(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Duration:          50 * time.Millisecond,
+		KeepRecords:       true,
+		Mix:               map[string]int{loadtest.OperationGetEntityByRecordID: 1},
+		SeedRecords:       5,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Len(test, report.Operations, 1)
+	require.Equal(test, loadtest.OperationGetEntityByRecordID, report.Operations[0].Name)
+	require.Equal(test, 5, engine.added, "only seed records are added")
+	require.Len(test, engine.records, 5, "records are kept")
+
+	_, isOK := report.Operation(loadtest.OperationAddRecord)
+	require.False(test, isOK)
+}
+
+func TestRunner_Run_badMix(test *testing.T) {
+	ctx := test.Context()
+
+	for _, mix := range []map[string]int{
+		{"SzEngine.DeleteRecord": 1},
+		{loadtest.OperationAddRecord: -1},
+		{loadtest.OperationAddRecord: 0},
+	} {
+		runner := &loadtest.Runner{ //exhaustruct:ignore
+			SzAbstractFactory: &fakeSzAbstractFactory{szEngine: newFakeSzEngine()}, //exhaustruct:ignore
+			Mix:               mix,
+		}
+
+		_, err := runner.Run(ctx)
+		printDebug(test, err)
+		require.Error(test, err, mix)
+	}
+}
+
+func TestRunner_Run_targetQPS(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Duration:          300 * time.Millisecond,
+		SeedRecords:       5,
+		TargetQPS:         50,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.InDelta(test, 50.0, report.TargetQPS, 0)
+
+	count := 0
+	for _, operation := range report.Operations {
+		count += operation.Count + operation.Errors
+	}
+
+	require.Positive(test, count)
+	require.LessOrEqual(test, count+report.Missed, 16)
+}
+
+func TestRunner_Run_missed(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	engine.delay = 50 * time.Millisecond
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Concurrency:       1,
+		Duration:          200 * time.Millisecond,
+		Mix:               map[string]int{loadtest.OperationSearchByAttributes: 1},
+		SeedRecords:       1,
+		TargetQPS:         200,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+	require.Positive(test, report.Missed)
+}
+
+func TestRunner_Run_errors(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	engine.whyErr = szerror.New(37, `{"reason":"SENZ0037|Unknown resolved entity value '0'"}`)
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Duration:          50 * time.Millisecond,
+		Mix:               map[string]int{loadtest.OperationWhyEntities: 1},
+		SeedRecords:       2,
+	}
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.NoError(test, err)
+
+	whyEntities, isOK := report.Operation(loadtest.OperationWhyEntities)
+	require.True(test, isOK)
+	require.Zero(test, whyEntities.Count)
+	require.Positive(test, whyEntities.Errors)
+	require.Equal(test, map[string]int{"SzNotFoundError": whyEntities.Errors}, whyEntities.ErrorTypes)
+	require.Contains(test, report.String(), "SzNotFoundError")
+}
+
+func TestRunner_Run_seedError(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeSzEngine()
+	engine.failAfter = 3
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		SeedRecords:       10,
+	}
+
+	_, err := runner.Run(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+	require.Empty(test, engine.records, "seed records are deleted")
+}
+
+func TestRunner_Run_canceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	engine := newFakeSzEngine()
+	runner := &loadtest.Runner{ //exhaustruct:ignore
+		SzAbstractFactory: &fakeSzAbstractFactory{szEngine: engine}, //exhaustruct:ignore
+		Duration:          time.Minute,
+		SeedRecords:       5,
+	}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	report, err := runner.Run(ctx)
+	printDebug(test, err, report)
+	require.ErrorContains(test, err, context.Canceled.Error())
+	require.NotNil(test, report)
+	require.Less(test, report.RunDuration, time.Minute)
+	require.Empty(test, engine.records, "added records are deleted after cancellation")
+}
+
+func TestErrorType(test *testing.T) {
+	testCases := []struct {
+		err      error
+		expected string
+	}{
+		{err: szerror.New(2, "SENZ0002|Invalid Message"), expected: "SzBadInputError"},
+		{err: szerror.New(10, "SENZ0010|Retry timeout exceeded"), expected: "SzRetryTimeoutExceededError"},
+		{err: szerror.New(33, "SENZ0033|Unknown record"), expected: "SzNotFoundError"},
+		{err: fmt.Errorf("wrapped: %w", szerror.New(33, "SENZ0033|Unknown record")), expected: "SzNotFoundError"},
+		{err: errors.New("connection refused"), expected: loadtest.ErrorTypeOther},
+	}
+
+	for _, testCase := range testCases {
+		require.Equal(test, testCase.expected, loadtest.ErrorType(testCase.err), testCase.err.Error())
+	}
+}
+
+func TestParseMix(test *testing.T) {
+	mix, err := loadtest.ParseMix(" AddRecord=1, SzEngine.WhyEntities = 2,GetEntityByRecordID=0")
+	printDebug(test, err, mix)
+	require.NoError(test, err)
+	require.Equal(test, map[string]int{
+		loadtest.OperationAddRecord:           1,
+		loadtest.OperationGetEntityByRecordID: 0,
+		loadtest.OperationWhyEntities:         2,
+	}, mix)
+
+	for _, badMix := range []string{"", "AddRecord", "DeleteRecord=1", "AddRecord=x", "AddRecord=-1"} {
+		_, err := loadtest.ParseMix(badMix)
+		printDebug(test, err)
+		require.Error(test, err, badMix)
+	}
+}
+
+func TestReport_JSON(test *testing.T) {
+	report := getReport()
+
+	reportJSON, err := report.JSON()
+	printDebug(test, err, reportJSON)
+	require.NoError(test, err)
+	require.True(test, json.Valid([]byte(reportJSON)))
+
+	parsed, err := loadtest.ParseReport(reportJSON)
+	require.NoError(test, err)
+	require.Equal(test, report, parsed)
+
+	_, err = loadtest.ParseReport("}{")
+	require.Error(test, err)
+}
+
+func TestReport_String(test *testing.T) {
+	text := getReport().String()
+	printDebug(test, nil, text)
+	require.Contains(test, text, `Load test "mixed" started 2026-10-18T18:00:00Z`)
+	require.Contains(test, text, "8 workers for 1m0s: 95.0 calls/s, target 100.0, 3 missed")
+	require.Regexp(test, `SzEngine.AddRecord\s+1200\s+2\s+1ms\s+4ms\s+8ms\s+9ms\s+12ms\s+20.0`, text)
+	require.Regexp(test, `SzEngine.AddRecord\s+SzRetryTimeoutExceededError\s+2`, text)
+	require.NotContains(test, text, "could not be deleted")
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzAbstractFactory struct {
+	senzing.SzAbstractFactory
+
+	szEngine senzing.SzEngine
+}
+
+func (factory *fakeSzAbstractFactory) CreateEngine(_ context.Context) (senzing.SzEngine, error) {
+	return factory.szEngine, nil
+}
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	added     int
+	delay     time.Duration
+	failAfter int // If not 0, AddRecord fails after this many records.
+	mutex     sync.Mutex
+	records   map[string]int64
+	whyErr    error
+}
+
+func newFakeSzEngine() *fakeSzEngine {
+	return &fakeSzEngine{records: map[string]int64{}} //exhaustruct:ignore
+}
+
+func (engine *fakeSzEngine) AddRecord(
+	_ context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	_ int64,
+) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	if engine.failAfter > 0 && engine.added >= engine.failAfter {
+		return "", szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)
+	}
+
+	record := map[string]any{}
+	if err := json.Unmarshal([]byte(recordDefinition), &record); err != nil || record["RECORD_ID"] != recordID {
+		return "", errors.New("bad record definition")
+	}
+
+	engine.added++
+	entityID := int64(engine.added)
+	engine.records[recordID] = entityID
+
+	return fmt.Sprintf(`{"DATA_SOURCE": %q, "RECORD_ID": %q, "AFFECTED_ENTITIES": [{"ENTITY_ID": %d}]}`,
+		dataSourceCode, recordID, entityID), nil
+}
+
+func (engine *fakeSzEngine) DeleteRecord(_ context.Context, _ string, recordID string, _ int64) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	delete(engine.records, recordID)
+
+	return "", nil
+}
+
+func (engine *fakeSzEngine) GetEntityByRecordID(
+	_ context.Context,
+	_ string,
+	recordID string,
+	_ int64,
+) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	entityID, isOK := engine.records[recordID]
+	if !isOK {
+		return "", szerror.New(33, `{"reason":"SENZ0033|Unknown record"}`)
+	}
+
+	return fmt.Sprintf(`{"RESOLVED_ENTITY": {"ENTITY_ID": %d}}`, entityID), nil
+}
+
+func (engine *fakeSzEngine) SearchByAttributes(_ context.Context, attributes string, _ string, _ int64) (string, error) {
+	time.Sleep(engine.delay)
+
+	parsedAttributes := map[string]any{}
+	if err := json.Unmarshal([]byte(attributes), &parsedAttributes); err != nil {
+		return "", szerror.New(2, `{"reason":"SENZ0002|Invalid Message"}`)
+	}
+
+	if _, isOK := parsedAttributes["RECORD_ID"]; isOK {
+		return "", errors.New("search attributes include RECORD_ID")
+	}
+
+	return `{"RESOLVED_ENTITIES": []}`, nil
+}
+
+func (engine *fakeSzEngine) WhyEntities(_ context.Context, entityID1 int64, entityID2 int64, _ int64) (string, error) {
+	if engine.whyErr != nil {
+		return "", engine.whyErr
+	}
+
+	if entityID1 == 0 || entityID2 == 0 {
+		return "", errors.New("unknown entity")
+	}
+
+	return `{"WHY_RESULTS": []}`, nil
+}
+
+func getReport() *loadtest.Report {
+	return &loadtest.Report{
+		Concurrency:  8,
+		DeleteErrors: 0,
+		Duration:     70 * time.Second,
+		Missed:       3,
+		Name:         "mixed",
+		Operations: []loadtest.OperationStats{
+			{
+				Count:      1200,
+				Errors:     2,
+				ErrorTypes: map[string]int{"SzRetryTimeoutExceededError": 2},
+				Max:        12 * time.Millisecond,
+				Mean:       4 * time.Millisecond,
+				Min:        time.Millisecond,
+				Name:       loadtest.OperationAddRecord,
+				P50:        4 * time.Millisecond,
+				P95:        8 * time.Millisecond,
+				P99:        9 * time.Millisecond,
+				PerSecond:  20,
+			},
+		},
+		QPS:         95,
+		RunDuration: time.Minute,
+		SeedRecords: 100,
+		StartedAt:   time.Date(2026, time.October, 18, 18, 0, 0, 0, time.UTC),
+		TargetQPS:   100,
+	}
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package loadtest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultConcurrency is the number of workers when Runner.Concurrency is not set.
+*/
+const DefaultConcurrency = 4
+
+/*
+DefaultDataSourceCode is the data source of added records when Runner.DataSourceCode is not set.
+*/
+const DefaultDataSourceCode = "TEST"
+
+/*
+DefaultDuration is how long operations are sent when Runner.Duration is not set.
+*/
+const DefaultDuration = 30 * time.Second
+
+/*
+DefaultSeedRecords is the number of records added before the run when Runner.SeedRecords is not set.
+*/
+const DefaultSeedRecords = 100
+
+/*
+ErrorTypeOther is the error type of errors that are not szerror errors. See ErrorType.
+*/
+const ErrorTypeOther = "Other"
+
+// Names of the operations.
+const (
+	OperationAddRecord           = "SzEngine.AddRecord"
+	OperationGetEntityByRecordID = "SzEngine.GetEntityByRecordID"
+	OperationSearchByAttributes  = "SzEngine.SearchByAttributes"
+	OperationWhyEntities         = "SzEngine.WhyEntities"
+)
+
+const (
+	duplicateRate = 0.2
+	variationRate = 0.5
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+DefaultMix is the mix of operations when Runner.Mix is not set: mostly reads, with some writes.
+*/
+var DefaultMix = map[string]int{
+	OperationAddRecord:           2,
+	OperationGetEntityByRecordID: 5,
+	OperationSearchByAttributes:  2,
+	OperationWhyEntities:         1,
+}
+
+var errForPackage = errors.New("loadtest")
+
+var operations = []string{
+	OperationAddRecord,
+	OperationGetEntityByRecordID,
+	OperationSearchByAttributes,
+	OperationWhyEntities,
+}
+
+/*
+szerror types, most specific first, as an error can be of several.
+For example, an SzRetryTimeoutExceededError is also an SzRetryableError.
+*/
+var errorTypes = []struct {
+	err  error
+	name string
+}{
+	{err: szerror.ErrSzRetryTimeoutExceeded, name: "SzRetryTimeoutExceededError"},
+	{err: szerror.ErrSzDatabaseConnectionLost, name: "SzDatabaseConnectionLostError"},
+	{err: szerror.ErrSzDatabaseTransient, name: "SzDatabaseTransientError"},
+	{err: szerror.ErrSzUnknownDataSource, name: "SzUnknownDataSourceError"},
+	{err: szerror.ErrSzNotFound, name: "SzNotFoundError"},
+	{err: szerror.ErrSzReplaceConflict, name: "SzReplaceConflictError"},
+	{err: szerror.ErrSzRetryable, name: "SzRetryableError"},
+	{err: szerror.ErrSzBadInput, name: "SzBadInputError"},
+	{err: szerror.ErrSzConfiguration, name: "SzConfigurationError"},
+	{err: szerror.ErrSzDatabase, name: "SzDatabaseError"},
+	{err: szerror.ErrSzLicense, name: "SzLicenseError"},
+	{err: szerror.ErrSzNotInitialized, name: "SzNotInitializedError"},
+	{err: szerror.ErrSzUnhandled, name: "SzUnhandledError"},
+	{err: szerror.ErrSzUnrecoverable, name: "SzUnrecoverableError"},
+	{err: szerror.ErrSzGeneral, name: "SzGeneralError"},
+	{err: szerror.ErrSzSdk, name: "SzSdkError"},
+	{err: szerror.ErrSz, name: "SzError"},
+}
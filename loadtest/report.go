@@ -0,0 +1,197 @@
+package loadtest
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/operationstats"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Report is the result of [Runner.Run].
+*/
+type Report struct {
+	Concurrency  int              `json:"CONCURRENCY"`
+	DeleteErrors int              `json:"DELETE_ERRORS"` // Added records that could not be deleted after the run.
+	Duration     time.Duration    `json:"DURATION"`      // The whole run, with seed records and deletes.
+	Missed       int              `json:"MISSED"`        // Operations skipped because all workers were busy.
+	Name         string           `json:"NAME"`
+	Operations   []OperationStats `json:"OPERATIONS"` // In the order of the Operation* constants.
+	QPS          float64          `json:"QPS"`        // Calls completed per second, across operations.
+	RunDuration  time.Duration    `json:"RUN_DURATION"`
+	SeedRecords  int              `json:"SEED_RECORDS"`
+	StartedAt    time.Time        `json:"STARTED_AT"`
+	TargetQPS    float64          `json:"TARGET_QPS"`
+}
+
+/*
+OperationStats summarizes the calls of an operation.
+Failed calls are counted in Errors and, keyed by ErrorType, in ErrorTypes, and not timed.
+PerSecond is the rate of calls completed in the run.
+*/
+type OperationStats = operationstats.Stats
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ParseReport function reads a report saved with Report.JSON.
+
+Input
+  - reportJSON: The JSON document.
+
+Output
+  - The report.
+*/
+func ParseReport(reportJSON string) (*Report, error) {
+	result := &Report{} //exhaustruct:ignore
+
+	err := operationstats.ParseReport(reportJSON, result)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method JSON returns the report as a JSON document.
+
+Output
+  - The JSON document.
+*/
+func (report *Report) JSON() (string, error) {
+	return operationstats.MarshalReport(report) //nolint:wrapcheck
+}
+
+/*
+Method Operation returns the statistics of an operation.
+
+Input
+  - name: One of the Operation* constants.
+
+Output
+  - The statistics, and whether the operation was in the mix.
+*/
+func (report *Report) Operation(name string) (OperationStats, bool) {
+	return operationstats.Find(report.Operations, name)
+}
+
+/*
+Method String returns the report as text.
+
+Output
+  - The report, as aligned columns.
+*/
+func (report *Report) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Load test %q started %s, took %s\n",
+		report.Name, report.StartedAt.Format(time.RFC3339), report.Duration.Round(time.Millisecond))
+
+	target := "unlimited"
+	if report.TargetQPS > 0 {
+		target = fmt.Sprintf("%.1f", report.TargetQPS)
+	}
+
+	fmt.Fprintf(&builder, "%d workers for %s: %.1f calls/s, target %s, %d missed\n",
+		report.Concurrency, report.RunDuration.Round(time.Millisecond), report.QPS, target, report.Missed)
+
+	builder.WriteString("\nOperations\n")
+
+	operationstats.WriteTable(&builder, report.Operations)
+
+	if report.errorCount() > 0 {
+		builder.WriteString("\nErrors\n")
+
+		writer := operationstats.NewTabWriter(&builder)
+		fmt.Fprintln(writer, "  Operation\tType\tCount")
+
+		for _, operation := range report.Operations {
+			for _, errorType := range slices.Sorted(maps.Keys(operation.ErrorTypes)) {
+				fmt.Fprintf(writer, "  %s\t%s\t%d\n", operation.Name, errorType, operation.ErrorTypes[errorType])
+			}
+		}
+
+		_ = writer.Flush()
+	}
+
+	if report.DeleteErrors > 0 {
+		fmt.Fprintf(&builder, "\n%d added records could not be deleted\n", report.DeleteErrors)
+	}
+
+	return builder.String()
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (report *Report) errorCount() int {
+	result := 0
+	for _, operation := range report.Operations {
+		result += operation.Errors
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Summarize the samples of the operations in the mix.
+*/
+func newReport(name string, startedAt time.Time, runDuration time.Duration, mix map[string]int,
+	allSamples map[string]*samples,
+) *Report {
+	result := &Report{
+		Name:        name,
+		Operations:  []OperationStats{},
+		RunDuration: runDuration,
+		StartedAt:   startedAt.UTC(),
+	} //exhaustruct:ignore
+
+	count := 0
+
+	for _, operation := range operations {
+		if mix[operation] <= 0 {
+			continue
+		}
+
+		operationStats := newOperationStats(operation, allSamples[operation], runDuration)
+		count += operationStats.Count
+		result.Operations = append(result.Operations, operationStats)
+	}
+
+	if runDuration > 0 {
+		result.QPS = float64(count) / runDuration.Seconds()
+	}
+
+	return result
+}
+
+func newOperationStats(name string, operationSamples *samples, runDuration time.Duration) OperationStats {
+	errorCount := 0
+	for _, count := range operationSamples.errorTypes {
+		errorCount += count
+	}
+
+	result := operationstats.New(name, operationSamples.latencies, errorCount, runDuration)
+	result.ErrorTypes = maps.Clone(operationSamples.errorTypes)
+
+	return result
+}
@@ -0,0 +1,34 @@
+/*
+Package reconcile manages the data sources of the Senzing default configuration declaratively.
+
+A [Spec] lists the desired data sources.
+It can be written in YAML or JSON, or built in Go.
+[Reconciler.Plan] compares the spec with the data sources of the current default configuration
+and reports what would be registered and unregistered.
+[Reconciler.Apply] makes those changes in a copy of the default configuration, registers the result,
+and promotes it with SzConfigManager.ReplaceDefaultConfigID.
+If another deployer changed the default configuration in the meantime, the promotion is rejected
+and Apply starts again from the new default configuration.
+A configuration must be registered before it is promoted, so each rejected promotion leaves
+a configuration registered that is never the default.
+
+When there is no default configuration yet, the first one is promoted with SetDefaultConfigID,
+which is not conditional. Apply reads the default back and starts again if another deployer's configuration
+is found there, but a deployer that bootstraps just before this one is overwritten without notice.
+Bootstrap the first configuration from a single deployer.
+
+Example spec:
+
+	DATA_SOURCES:
+	  - CUSTOMERS
+	  - REFERENCE
+	  - WATCHLIST
+	PRUNE: true
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package reconcile
@@ -0,0 +1,23 @@
+package reconcile
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultMaxAttempts is the number of times Apply tries to promote a configuration when Reconciler.MaxAttempts is not set.
+*/
+const DefaultMaxAttempts = 3
+
+// The Senzing error code of a rejected ReplaceDefaultConfigID, reported when a bootstrap is overtaken.
+const replaceConflictCode = 7245
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+SystemDataSources are data sources defined by the Senzing configuration template.
+They are never unregistered, even when Spec.Prune is set.
+*/
+var SystemDataSources = []string{"SEARCH", "TEST"}
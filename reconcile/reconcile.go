@@ -0,0 +1,375 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"gopkg.in/yaml.v3"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Spec is the desired state of the data sources in the Senzing default configuration.
+*/
+type Spec struct {
+	DataSources []string `json:"DATA_SOURCES"`
+	Prune       bool     `json:"PRUNE"` // If true, data sources not listed are unregistered.
+}
+
+/*
+Plan lists the changes needed to bring the default configuration to a [Spec].
+*/
+type Plan struct {
+	BaseConfigID int64 // The default configuration the plan was computed from. 0 if there was none.
+	Register     []string
+	Unchanged    []string
+	Unregister   []string
+}
+
+/*
+Result describes the outcome of [Reconciler.Apply].
+*/
+type Result struct {
+	Attempts int
+	ConfigID int64 // The default configuration after Apply.
+	Plan     Plan  // The plan that was applied.
+}
+
+/*
+Reconciler brings the data sources of the Senzing default configuration to a desired state.
+*/
+type Reconciler struct {
+	SzConfigManager senzing.SzConfigManager
+	ConfigComment   string // Optional. If empty, a comment describing the changes is generated.
+	MaxAttempts     int    // Optional. If 0, DefaultMaxAttempts is used.
+}
+
+type dataSourceRegistry struct {
+	DataSources []struct {
+		DsrcCode string `json:"DSRC_CODE"`
+		DsrcID   int64  `json:"DSRC_ID"`
+	} `json:"DATA_SOURCES"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoadSpec function reads a [Spec] from a YAML or JSON file.
+
+Input
+  - path: The path of the file.
+
+Output
+  - The spec.
+*/
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile: %s", path)
+	}
+
+	return ParseSpec(data)
+}
+
+/*
+The ParseSpec function parses a [Spec] from a YAML or JSON document.
+Both formats use the same keys; see the package documentation.
+
+Input
+  - data: The YAML or JSON document.
+
+Output
+  - The spec.
+*/
+func ParseSpec(data []byte) (*Spec, error) {
+	var document any
+
+	err := yaml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "yaml.Unmarshal")
+	}
+
+	// JSON is a subset of YAML, so both are decoded by yaml.Unmarshal, then mapped onto Spec using its JSON tags.
+
+	jsonBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	result := &Spec{} //exhaustruct:ignore
+
+	err = json.Unmarshal(jsonBytes, result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Plan methods
+// ----------------------------------------------------------------------------
+
+/*
+Method HasChanges reports whether applying the plan would change the default configuration.
+
+Output
+  - True if any data source would be registered or unregistered.
+*/
+func (plan *Plan) HasChanges() bool {
+	return len(plan.Register) > 0 || len(plan.Unregister) > 0
+}
+
+/*
+Method String describes the plan in a single line.
+
+Output
+  - A description such as "register [CUSTOMERS]; unregister [OLD]; unchanged [TEST]".
+*/
+func (plan *Plan) String() string {
+	return fmt.Sprintf("register %v; unregister %v; unchanged %v", plan.Register, plan.Unregister, plan.Unchanged)
+}
+
+// ----------------------------------------------------------------------------
+// Reconciler methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Apply brings the default configuration to the spec and promotes the result.
+If the default configuration changes while Apply is running, Apply starts again from the new default,
+up to MaxAttempts times.
+
+When there is no default configuration yet, the result is promoted with SetDefaultConfigID,
+which is not conditional. Apply reads the default back and starts again if another deployer's
+configuration is found, but a bootstrap by another deployer that sets its configuration just
+before this one is overwritten. Bootstrap the first configuration from a single deployer.
+
+Input
+  - ctx: A context to control lifecycle.
+  - spec: The desired state.
+
+Output
+  - The outcome. If the plan has no changes, the default configuration is left as is.
+*/
+func (reconciler *Reconciler) Apply(ctx context.Context, spec Spec) (*Result, error) {
+	var err error
+
+	maxAttempts := reconciler.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var result *Result
+
+		result, err = reconciler.applyOnce(ctx, spec)
+		if err == nil {
+			result.Attempts = attempt
+
+			return result, nil
+		}
+
+		if !errors.Is(err, szerror.ErrSzReplaceConflict) {
+			return nil, wraperror.Errorf(err, wraperror.NoMessage)
+		}
+	}
+
+	return nil, wraperror.Errorf(err, "default configuration changed concurrently %d times", maxAttempts)
+}
+
+/*
+Method Plan compares the spec with the current default configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - spec: The desired state.
+
+Output
+  - The changes needed.
+*/
+func (reconciler *Reconciler) Plan(ctx context.Context, spec Spec) (*Plan, error) {
+	plan, _, err := reconciler.plan(ctx, spec)
+
+	return plan, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (reconciler *Reconciler) applyOnce(ctx context.Context, spec Spec) (*Result, error) {
+	plan, szConfig, err := reconciler.plan(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Attempts: 0,
+		ConfigID: plan.BaseConfigID,
+		Plan:     *plan,
+	}
+
+	if !plan.HasChanges() && plan.BaseConfigID != 0 {
+		return result, nil
+	}
+
+	for _, dataSourceCode := range plan.Register {
+		_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "RegisterDataSource: %s", dataSourceCode)
+		}
+	}
+
+	for _, dataSourceCode := range plan.Unregister {
+		_, err = szConfig.UnregisterDataSource(ctx, dataSourceCode)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "UnregisterDataSource: %s", dataSourceCode)
+		}
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "Export")
+	}
+
+	result.ConfigID, err = reconciler.SzConfigManager.RegisterConfig(ctx, configDefinition, reconciler.comment(plan))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "RegisterConfig")
+	}
+
+	if plan.BaseConfigID == 0 {
+		err = reconciler.setFirstDefaultConfigID(ctx, result.ConfigID)
+	} else {
+		err = reconciler.SzConfigManager.ReplaceDefaultConfigID(ctx, plan.BaseConfigID, result.ConfigID)
+	}
+
+	if err != nil {
+		return nil, wraperror.Errorf(err, "promote config %d; it stays registered, but is not the default", result.ConfigID)
+	}
+
+	return result, nil
+}
+
+func (reconciler *Reconciler) comment(plan *Plan) string {
+	if len(reconciler.ConfigComment) > 0 {
+		return reconciler.ConfigComment
+	}
+
+	return "reconcile: " + plan.String()
+}
+
+func (reconciler *Reconciler) plan(ctx context.Context, spec Spec) (*Plan, senzing.SzConfig, error) {
+	var szConfig senzing.SzConfig
+
+	configID, err := reconciler.SzConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, nil, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if configID == 0 {
+		szConfig, err = reconciler.SzConfigManager.CreateConfigFromTemplate(ctx)
+	} else {
+		szConfig, err = reconciler.SzConfigManager.CreateConfigFromConfigID(ctx, configID)
+	}
+
+	if err != nil {
+		return nil, nil, wraperror.Errorf(err, "create config from %d", configID)
+	}
+
+	registryJSON, err := szConfig.GetDataSourceRegistry(ctx)
+	if err != nil {
+		return nil, nil, wraperror.Errorf(err, "GetDataSourceRegistry")
+	}
+
+	registry := dataSourceRegistry{} //exhaustruct:ignore
+
+	err = json.Unmarshal([]byte(registryJSON), &registry)
+	if err != nil {
+		return nil, nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	current := map[string]bool{}
+	for _, dataSource := range registry.DataSources {
+		current[strings.ToUpper(dataSource.DsrcCode)] = true
+	}
+
+	desired := map[string]bool{}
+	for _, dataSourceCode := range spec.DataSources {
+		desired[strings.ToUpper(strings.TrimSpace(dataSourceCode))] = true
+	}
+
+	delete(desired, "")
+
+	result := &Plan{
+		BaseConfigID: configID,
+		Register:     []string{},
+		Unchanged:    []string{},
+		Unregister:   []string{},
+	}
+
+	for dataSourceCode := range desired {
+		if current[dataSourceCode] {
+			result.Unchanged = append(result.Unchanged, dataSourceCode)
+		} else {
+			result.Register = append(result.Register, dataSourceCode)
+		}
+	}
+
+	for dataSourceCode := range current {
+		if desired[dataSourceCode] {
+			continue
+		}
+
+		if spec.Prune && !slices.Contains(SystemDataSources, dataSourceCode) {
+			result.Unregister = append(result.Unregister, dataSourceCode)
+		} else {
+			result.Unchanged = append(result.Unchanged, dataSourceCode)
+		}
+	}
+
+	sort.Strings(result.Register)
+	sort.Strings(result.Unchanged)
+	sort.Strings(result.Unregister)
+
+	return result, szConfig, nil
+}
+
+/*
+Promote the first default configuration.
+SetDefaultConfigID has no compare-and-swap form, so read the default back and report a replace conflict
+if another deployer set its configuration after ours.
+A configuration can only be promoted once registered, so on a conflict ours stays registered, unused.
+*/
+func (reconciler *Reconciler) setFirstDefaultConfigID(ctx context.Context, configID int64) error {
+	err := reconciler.SzConfigManager.SetDefaultConfigID(ctx, configID)
+	if err != nil {
+		return wraperror.Errorf(err, "SetDefaultConfigID")
+	}
+
+	defaultConfigID, err := reconciler.SzConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if defaultConfigID != configID {
+		return szerror.New(replaceConflictCode, fmt.Sprintf(
+			`{"reason":"SENZ%d|Default configuration ID %d was set concurrently instead of registered configuration ID %d"}`,
+			replaceConflictCode, defaultConfigID, configID))
+	}
+
+	return nil
+}
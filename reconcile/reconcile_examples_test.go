@@ -0,0 +1,58 @@
+package reconcile_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/reconcile"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleParseSpec() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/reconcile/reconcile_test.go
+	spec, err := reconcile.ParseSpec([]byte(`
+DATA_SOURCES:
+  - CUSTOMERS
+  - REFERENCE
+  - WATCHLIST
+PRUNE: true
+`))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(spec.DataSources, spec.Prune)
+	// Output: [CUSTOMERS REFERENCE WATCHLIST] true
+}
+
+func ExampleReconciler_Apply() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/reconcile/reconcile_test.go
+	ctx := context.TODO()
+	reconciler := &reconcile.Reconciler{ //exhaustruct:ignore
+		SzConfigManager: getSzConfigManager(ctx),
+	}
+	spec := reconcile.Spec{
+		DataSources: []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"},
+		Prune:       true,
+	}
+
+	result, err := reconciler.Apply(ctx, spec)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(result.Plan.String())
+	// Output: register [REFERENCE WATCHLIST]; unregister [OLD]; unchanged [CUSTOMERS SEARCH TEST]
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzConfigManager(_ context.Context) senzing.SzConfigManager {
+	return newFakeSzConfigManager("TEST", "SEARCH", "CUSTOMERS", "OLD")
+}
@@ -0,0 +1,333 @@
+package reconcile_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/reconcile"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParseSpec_yaml(test *testing.T) {
+	spec, err := reconcile.ParseSpec([]byte("DATA_SOURCES:\n  - CUSTOMERS\n  - watchlist\nPRUNE: true\n"))
+	printDebug(test, err, spec)
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "watchlist"}, spec.DataSources)
+	require.True(test, spec.Prune)
+}
+
+func TestParseSpec_json(test *testing.T) {
+	spec, err := reconcile.ParseSpec([]byte(`{"DATA_SOURCES": ["CUSTOMERS"]}`))
+	printDebug(test, err, spec)
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS"}, spec.DataSources)
+	require.False(test, spec.Prune)
+}
+
+func TestParseSpec_badInput(test *testing.T) {
+	_, err := reconcile.ParseSpec([]byte("DATA_SOURCES: [\n"))
+	printDebug(test, err)
+	require.Error(test, err)
+
+	_, err = reconcile.ParseSpec([]byte(`{"DATA_SOURCES": "CUSTOMERS"}`))
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestLoadSpec(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "datasources.yaml")
+	require.NoError(test, os.WriteFile(path, []byte("DATA_SOURCES: [CUSTOMERS, REFERENCE]\n"), 0o600))
+
+	spec, err := reconcile.LoadSpec(path)
+	printDebug(test, err, spec)
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "REFERENCE"}, spec.DataSources)
+
+	_, err = reconcile.LoadSpec(filepath.Join(test.TempDir(), "missing.yaml"))
+	require.Error(test, err)
+}
+
+func TestReconciler_Plan(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager("TEST", "SEARCH", "CUSTOMERS", "OLD")
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager} //exhaustruct:ignore
+
+	plan, err := reconciler.Plan(ctx, reconcile.Spec{DataSources: []string{"customers", "WATCHLIST"}, Prune: false})
+	printDebug(test, err, plan)
+	require.NoError(test, err)
+	require.Equal(test, []string{"WATCHLIST"}, plan.Register)
+	require.Empty(test, plan.Unregister)
+	require.Equal(test, []string{"CUSTOMERS", "OLD", "SEARCH", "TEST"}, plan.Unchanged)
+
+	plan, err = reconciler.Plan(ctx, reconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: true})
+	printDebug(test, err, plan)
+	require.NoError(test, err)
+	require.Empty(test, plan.Register)
+	require.Equal(test, []string{"OLD"}, plan.Unregister)
+	require.Equal(test, []string{"CUSTOMERS", "SEARCH", "TEST"}, plan.Unchanged)
+}
+
+func TestReconciler_Apply(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager("TEST", "SEARCH", "OLD")
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager} //exhaustruct:ignore
+	spec := reconcile.Spec{DataSources: []string{"CUSTOMERS", "REFERENCE"}, Prune: true}
+
+	result, err := reconciler.Apply(ctx, spec)
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, 1, result.Attempts)
+	require.Equal(test, szConfigManager.defaultConfigID, result.ConfigID)
+	require.Equal(test, []string{"CUSTOMERS", "REFERENCE", "SEARCH", "TEST"}, szConfigManager.defaultDataSources())
+	require.Contains(test, szConfigManager.comments[result.ConfigID], "unregister [OLD]")
+
+	// Applying again changes nothing.
+
+	configCount := len(szConfigManager.configs)
+	result, err = reconciler.Apply(ctx, spec)
+	require.NoError(test, err)
+	require.False(test, result.Plan.HasChanges())
+	require.Len(test, szConfigManager.configs, configCount)
+}
+
+func TestReconciler_Apply_noDefaultConfig(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager()
+	szConfigManager.defaultConfigID = 0
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager, ConfigComment: "bootstrap"} //exhaustruct:ignore
+
+	result, err := reconciler.Apply(ctx, reconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false})
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, int64(0), result.Plan.BaseConfigID)
+	require.Equal(test, []string{"CUSTOMERS", "SEARCH", "TEST"}, szConfigManager.defaultDataSources())
+	require.Equal(test, "bootstrap", szConfigManager.comments[result.ConfigID])
+}
+
+func TestReconciler_Apply_conflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager("TEST", "SEARCH")
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager} //exhaustruct:ignore
+
+	// Another deployer registers WATCHLIST before the first promotion.
+
+	szConfigManager.beforeReplace = func() {
+		szConfigManager.beforeReplace = nil
+		szConfigManager.defaultConfigID = szConfigManager.addConfig("TEST", "SEARCH", "WATCHLIST")
+	}
+
+	result, err := reconciler.Apply(ctx, reconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false})
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, 2, result.Attempts)
+	require.Equal(test, []string{"CUSTOMERS", "SEARCH", "TEST", "WATCHLIST"}, szConfigManager.defaultDataSources())
+}
+
+func TestReconciler_Apply_noDefaultConfigConflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager()
+	szConfigManager.defaultConfigID = 0
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager} //exhaustruct:ignore
+
+	// Another deployer bootstraps with WATCHLIST right after the first SetDefaultConfigID.
+
+	szConfigManager.afterSet = func() {
+		szConfigManager.afterSet = nil
+		szConfigManager.defaultConfigID = szConfigManager.addConfig("TEST", "SEARCH", "WATCHLIST")
+	}
+
+	result, err := reconciler.Apply(ctx, reconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false})
+	printDebug(test, err, result)
+	require.NoError(test, err)
+	require.Equal(test, 2, result.Attempts)
+	require.NotEqual(test, int64(0), result.Plan.BaseConfigID)
+	require.Equal(test, []string{"CUSTOMERS", "SEARCH", "TEST", "WATCHLIST"}, szConfigManager.defaultDataSources())
+}
+
+func TestReconciler_Apply_conflictExhausted(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager("TEST", "SEARCH")
+	reconciler := &reconcile.Reconciler{SzConfigManager: szConfigManager, MaxAttempts: 2} //exhaustruct:ignore
+	szConfigManager.beforeReplace = func() {
+		szConfigManager.defaultConfigID = szConfigManager.addConfig("TEST", "SEARCH")
+	}
+
+	_, err := reconciler.Apply(ctx, reconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false})
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+	require.ErrorContains(test, err, "stays registered, but is not the default")
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzConfigManager struct {
+	senzing.SzConfigManager
+
+	afterSet        func()
+	beforeReplace   func()
+	comments        map[int64]string
+	configs         map[int64][]string
+	defaultConfigID int64
+}
+
+func newFakeSzConfigManager(dataSourceCodes ...string) *fakeSzConfigManager {
+	result := &fakeSzConfigManager{ //exhaustruct:ignore
+		comments: map[int64]string{},
+		configs:  map[int64][]string{},
+	}
+	result.defaultConfigID = result.addConfig(dataSourceCodes...)
+
+	return result
+}
+
+func (manager *fakeSzConfigManager) CreateConfigFromConfigID(
+	_ context.Context,
+	configID int64,
+) (senzing.SzConfig, error) {
+	return &fakeSzConfig{dataSourceCodes: slices.Clone(manager.configs[configID])}, nil //exhaustruct:ignore
+}
+
+func (manager *fakeSzConfigManager) CreateConfigFromTemplate(_ context.Context) (senzing.SzConfig, error) {
+	return &fakeSzConfig{dataSourceCodes: []string{"TEST", "SEARCH"}}, nil //exhaustruct:ignore
+}
+
+func (manager *fakeSzConfigManager) GetDefaultConfigID(_ context.Context) (int64, error) {
+	return manager.defaultConfigID, nil
+}
+
+func (manager *fakeSzConfigManager) RegisterConfig(
+	_ context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	var dataSourceCodes []string
+
+	err := json.Unmarshal([]byte(configDefinition), &dataSourceCodes)
+	if err != nil {
+		return 0, fmt.Errorf("RegisterConfig: %w", err)
+	}
+
+	configID := manager.addConfig(dataSourceCodes...)
+	manager.comments[configID] = configComment
+
+	return configID, nil
+}
+
+func (manager *fakeSzConfigManager) ReplaceDefaultConfigID(
+	_ context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	if manager.beforeReplace != nil {
+		manager.beforeReplace()
+	}
+
+	if currentDefaultConfigID != manager.defaultConfigID {
+		return szerror.New(7245, `{"reason":"SENZ7245|Current configuration ID does not match specified data ID"}`)
+	}
+
+	manager.defaultConfigID = newDefaultConfigID
+
+	return nil
+}
+
+func (manager *fakeSzConfigManager) SetDefaultConfigID(_ context.Context, configID int64) error {
+	manager.defaultConfigID = configID
+
+	if manager.afterSet != nil {
+		manager.afterSet()
+	}
+
+	return nil
+}
+
+func (manager *fakeSzConfigManager) addConfig(dataSourceCodes ...string) int64 {
+	configID := int64(len(manager.configs) + 1)
+	manager.configs[configID] = dataSourceCodes
+
+	return configID
+}
+
+func (manager *fakeSzConfigManager) defaultDataSources() []string {
+	result := slices.Clone(manager.configs[manager.defaultConfigID])
+	slices.Sort(result)
+
+	return result
+}
+
+type fakeSzConfig struct {
+	senzing.SzConfig
+
+	dataSourceCodes []string
+}
+
+func (config *fakeSzConfig) Export(_ context.Context) (string, error) {
+	result, err := json.Marshal(config.dataSourceCodes)
+
+	return string(result), err
+}
+
+func (config *fakeSzConfig) GetDataSourceRegistry(_ context.Context) (string, error) {
+	result := `{"DATA_SOURCES":[`
+
+	for index, dataSourceCode := range config.dataSourceCodes {
+		if index > 0 {
+			result += ","
+		}
+
+		result += fmt.Sprintf(`{"DSRC_CODE":%q,"DSRC_ID":%d}`, dataSourceCode, index+1)
+	}
+
+	return result + "]}", nil
+}
+
+func (config *fakeSzConfig) RegisterDataSource(_ context.Context, dataSourceCode string) (string, error) {
+	config.dataSourceCodes = append(config.dataSourceCodes, dataSourceCode)
+
+	return fmt.Sprintf(`{"DSRC_ID":%d}`, len(config.dataSourceCodes)), nil
+}
+
+func (config *fakeSzConfig) UnregisterDataSource(_ context.Context, dataSourceCode string) (string, error) {
+	config.dataSourceCodes = slices.DeleteFunc(config.dataSourceCodes, func(code string) bool {
+		return code == dataSourceCode
+	})
+
+	return "", nil
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
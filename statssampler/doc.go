@@ -0,0 +1,20 @@
+/*
+Package statssampler turns SzEngine.GetStats into a time series for capacity planning.
+
+A [Sampler] calls SzEngine.GetStats every interval and flattens the numbers in the "workload" document
+into counters named by their JSON path, such as "workload.processing.addedRecords".
+For each [Sample] it computes the per-interval delta of every counter and its rate per second,
+keeps the most recent samples in a bounded ring buffer, and hands each sample to its [Exporter] values,
+for example a [JSONLExporter] or an [ExporterFunc] that updates metrics.
+
+By default, SzEngine.GetStats resets the statistics on each call, so the reported values already are
+per-interval deltas. If the server reports cumulative counters instead, set Sampler.Cumulative;
+deltas are then the difference from the previous sample, and a counter that goes down is taken to have been reset.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package statssampler
@@ -0,0 +1,39 @@
+package statssampler
+
+import (
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultCapacity is the number of samples kept when Sampler.Capacity is not set.
+At DefaultInterval, it is one hour of samples.
+*/
+const DefaultCapacity = 360
+
+/*
+DefaultInterval is the time between samples when Sampler.Interval is not set.
+*/
+const DefaultInterval = 10 * time.Second
+
+// Well-known counters, for use with Sample.Delta and Sample.Rate.
+const (
+	CounterAddedRecords   = "workload.processing.addedRecords"
+	CounterDeletedRecords = "workload.processing.deletedRecords"
+	CounterLockWaits      = "workload.lockWaits.refreshLocks.count"
+	CounterLockWaitTime   = "workload.lockWaits.refreshLocks.totalMS"
+	CounterReevaluations  = "workload.processing.reevaluations"
+	CounterRetries        = "workload.processing.details.retries"
+)
+
+const keySeparator = "."
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("statssampler")
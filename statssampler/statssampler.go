@@ -0,0 +1,372 @@
+package statssampler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Exporter receives each sample as it is taken.
+*/
+type Exporter interface {
+	Export(ctx context.Context, sample Sample) error
+}
+
+/*
+ExporterFunc adapts a function, such as one that updates metrics, to the Exporter interface.
+*/
+type ExporterFunc func(ctx context.Context, sample Sample) error
+
+/*
+JSONLExporter writes each sample to Writer as a line of JSON.
+*/
+type JSONLExporter struct {
+	Writer io.Writer
+
+	mutex sync.Mutex
+}
+
+/*
+Sample is the statistics of one interval.
+Counters are named by their JSON path in the GetStats document; numbers inside lists are not sampled.
+*/
+type Sample struct {
+	Deltas   map[string]float64 `json:"DELTAS"`   // Change of each counter during the interval.
+	Interval time.Duration      `json:"INTERVAL"` // Time since the previous sample; 0 for the first sample.
+	Rates    map[string]float64 `json:"RATES"`    // Deltas per second; empty for the first sample.
+	Time     time.Time          `json:"TIME"`
+	Values   map[string]float64 `json:"VALUES"` // Counters as reported by GetStats.
+}
+
+/*
+Sampler polls SzEngine.GetStats and keeps the most recent samples.
+*/
+type Sampler struct {
+	SzEngine senzing.SzEngine
+
+	// Optional. The number of samples kept. If 0, DefaultCapacity is used.
+	Capacity int
+
+	// Optional. Set if GetStats reports cumulative counters rather than resetting them on each call.
+	Cumulative bool
+
+	// Optional. Each sample is passed to every exporter.
+	Exporters []Exporter
+
+	// Optional. If 0, DefaultInterval is used.
+	Interval time.Duration
+
+	// Optional. Returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	// Optional. Called by Run when a sample fails.
+	OnError func(ctx context.Context, err error)
+
+	mutex    sync.Mutex // Guards previous and samples; serializes Sample.
+	previous *Sample
+	samples  []Sample // Ring buffer, oldest first once rotated by next.
+	next     int      // Index in samples of the next sample to overwrite, once samples is full.
+}
+
+// ----------------------------------------------------------------------------
+// ExporterFunc methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Export calls the function.
+
+Input
+  - ctx: A context to control lifecycle.
+  - sample: The sample to export.
+*/
+func (exporterFunc ExporterFunc) Export(ctx context.Context, sample Sample) error {
+	return exporterFunc(ctx, sample)
+}
+
+// ----------------------------------------------------------------------------
+// JSONLExporter methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Export writes the sample as a line of JSON.
+
+Input
+  - ctx: A context to control lifecycle.
+  - sample: The sample to export.
+*/
+func (exporter *JSONLExporter) Export(ctx context.Context, sample Sample) error {
+	_ = ctx
+
+	line, err := json.Marshal(sample)
+	if err != nil {
+		return wraperror.Errorf(err, "json.Marshal")
+	}
+
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	_, err = exporter.Writer.Write(append(line, '\n'))
+
+	return wraperror.Errorf(err, "Write")
+}
+
+// ----------------------------------------------------------------------------
+// Sample methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Delta returns the change of a counter during the interval.
+
+Input
+  - counter: The JSON path of the counter, such as CounterAddedRecords.
+
+Output
+  - The change, or 0 if the counter was not reported.
+*/
+func (sample *Sample) Delta(counter string) float64 {
+	return sample.Deltas[counter]
+}
+
+/*
+Method Rate returns the change of a counter per second.
+
+Input
+  - counter: The JSON path of the counter, such as CounterAddedRecords.
+
+Output
+  - The rate, or 0 if the counter was not reported or this is the first sample.
+*/
+func (sample *Sample) Rate(counter string) float64 {
+	return sample.Rates[counter]
+}
+
+// ----------------------------------------------------------------------------
+// Sampler methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Run takes a sample every Interval until the context is canceled.
+Failed samples are passed to OnError and do not stop Run.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (sampler *Sampler) Run(ctx context.Context) {
+	interval := sampler.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := sampler.Sample(ctx)
+		if err != nil && sampler.OnError != nil {
+			sampler.OnError(ctx, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+Method Sample calls SzEngine.GetStats once, adds the sample to the buffer and exports it.
+If an exporter fails, the sample is still kept and returned with the error.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The sample.
+*/
+func (sampler *Sampler) Sample(ctx context.Context) (*Sample, error) {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	statsJSON, err := sampler.SzEngine.GetStats(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetStats")
+	}
+
+	now := time.Now
+	if sampler.Now != nil {
+		now = sampler.Now
+	}
+
+	values, err := flatten(statsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	result := sampler.compare(now(), values)
+	sampler.previous = &result
+	sampler.store(result)
+
+	for _, exporter := range sampler.Exporters {
+		err = exporter.Export(ctx, result)
+		if err != nil {
+			return &result, wraperror.Errorf(err, "Export")
+		}
+	}
+
+	return &result, nil
+}
+
+/*
+Method Samples returns the samples in the buffer.
+
+Output
+  - The samples, oldest first.
+*/
+func (sampler *Sampler) Samples() []Sample {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	result := make([]Sample, 0, len(sampler.samples))
+	result = append(result, sampler.samples[sampler.next:]...)
+	result = append(result, sampler.samples[:sampler.next]...)
+
+	return result
+}
+
+/*
+Method WriteJSONL writes the samples in the buffer, oldest first, as lines of JSON.
+
+Input
+  - writer: Where to write the samples, such as an os.File.
+*/
+func (sampler *Sampler) WriteJSONL(writer io.Writer) error {
+	exporter := &JSONLExporter{Writer: writer} //exhaustruct:ignore
+
+	for _, sample := range sampler.Samples() {
+		err := exporter.Export(context.Background(), sample)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Requires sampler.mutex.
+func (sampler *Sampler) compare(now time.Time, values map[string]float64) Sample {
+	result := Sample{
+		Deltas:   make(map[string]float64, len(values)),
+		Interval: 0,
+		Rates:    map[string]float64{},
+		Time:     now,
+		Values:   values,
+	}
+
+	previous := sampler.previous
+
+	for counter, value := range values {
+		switch {
+		case !sampler.Cumulative:
+			result.Deltas[counter] = value
+		case previous == nil:
+			// There is nothing to compare cumulative counters with.
+		default:
+			previousValue, isOK := previous.Values[counter]
+			if isOK && value >= previousValue {
+				result.Deltas[counter] = value - previousValue
+			} else {
+				result.Deltas[counter] = value // New, or reset since the previous sample.
+			}
+		}
+	}
+
+	if previous == nil {
+		return result
+	}
+
+	result.Interval = now.Sub(previous.Time)
+	if result.Interval <= 0 {
+		return result
+	}
+
+	for counter, delta := range result.Deltas {
+		result.Rates[counter] = delta / result.Interval.Seconds()
+	}
+
+	return result
+}
+
+// Requires sampler.mutex.
+func (sampler *Sampler) store(sample Sample) {
+	capacity := sampler.Capacity
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	if len(sampler.samples) < capacity {
+		sampler.samples = append(sampler.samples, sample)
+
+		return
+	}
+
+	sampler.samples[sampler.next] = sample
+	sampler.next = (sampler.next + 1) % len(sampler.samples)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Flatten the numbers in a GetStats document into counters named by their JSON path.
+*/
+func flatten(statsJSON string) (map[string]float64, error) {
+	var document map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(statsJSON)))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, wraperror.Errorf(errForPackage, "statistics are not a JSON object: %s", err.Error())
+	}
+
+	result := map[string]float64{}
+	flattenInto(result, "", document)
+
+	return result, nil
+}
+
+func flattenInto(result map[string]float64, prefix string, value any) {
+	switch typedValue := value.(type) {
+	case json.Number:
+		number, err := typedValue.Float64()
+		if err == nil {
+			result[prefix] = number
+		}
+	case map[string]any:
+		for key, child := range typedValue {
+			if len(prefix) > 0 {
+				key = prefix + keySeparator + key
+			}
+
+			flattenInto(result, key, child)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package statssampler_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/statssampler"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSampler_Sample() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/statssampler/statssampler_test.go
+	ctx := context.TODO()
+	sampler := getSampler(ctx)
+
+	for range 2 {
+		sample, err := sampler.Sample(ctx)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		fmt.Printf("%.0f records loaded, %.1f/s\n",
+			sample.Delta(statssampler.CounterAddedRecords), sample.Rate(statssampler.CounterAddedRecords))
+	}
+	// Output:
+	// 159 records loaded, 0.0/s
+	// 300 records loaded, 30.0/s
+}
+
+func ExampleExporterFunc() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/statssampler/statssampler_test.go
+	ctx := context.TODO()
+	sampler := getSampler(ctx)
+	sampler.Exporters = []statssampler.Exporter{
+		statssampler.ExporterFunc(func(_ context.Context, sample statssampler.Sample) error {
+			fmt.Printf("retries: %.0f\n", sample.Delta(statssampler.CounterRetries)) // e.g. update a metrics gauge.
+
+			return nil
+		}),
+	}
+
+	for range 2 {
+		_, err := sampler.Sample(ctx)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+	// Output:
+	// retries: 0
+	// retries: 2
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSampler(_ context.Context) *statssampler.Sampler {
+	return newSampler(getStats(159, 0, 10), getStats(300, 2, 14))
+}
@@ -0,0 +1,314 @@
+package statssampler_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/statssampler"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSampler_Sample(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(159, 0, 10), getStats(300, 2, 14))
+
+	sample, err := sampler.Sample(ctx)
+	printDebug(test, err, sample)
+	require.NoError(test, err)
+	require.InDelta(test, 159.0, sample.Values[statssampler.CounterAddedRecords], 0)
+	require.InDelta(test, 159.0, sample.Delta(statssampler.CounterAddedRecords), 0)
+	require.Zero(test, sample.Interval)
+	require.Empty(test, sample.Rates)
+
+	// GetStats resets the statistics, so the second sample's values are its deltas.
+
+	sample, err = sampler.Sample(ctx)
+	printDebug(test, err, sample)
+	require.NoError(test, err)
+	require.Equal(test, 10*time.Second, sample.Interval)
+	require.InDelta(test, 300.0, sample.Delta(statssampler.CounterAddedRecords), 0)
+	require.InDelta(test, 30.0, sample.Rate(statssampler.CounterAddedRecords), 0)
+	require.InDelta(test, 0.2, sample.Rate(statssampler.CounterRetries), 1e-9)
+	require.InDelta(test, 1.4, sample.Rate(statssampler.CounterLockWaits), 1e-9)
+	require.Len(test, sampler.Samples(), 2)
+}
+
+func TestSampler_Sample_cumulative(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(100, 1, 10), getStats(250, 3, 10), getStats(40, 0, 2))
+	sampler.Cumulative = true
+
+	sample, err := sampler.Sample(ctx)
+	require.NoError(test, err)
+	require.Empty(test, sample.Deltas)
+
+	sample, err = sampler.Sample(ctx)
+	printDebug(test, err, sample)
+	require.NoError(test, err)
+	require.InDelta(test, 150.0, sample.Delta(statssampler.CounterAddedRecords), 0)
+	require.InDelta(test, 2.0, sample.Delta(statssampler.CounterRetries), 0)
+	require.InDelta(test, 0.0, sample.Delta(statssampler.CounterLockWaits), 0)
+	require.InDelta(test, 15.0, sample.Rate(statssampler.CounterAddedRecords), 0)
+
+	// A counter that goes down has been reset.
+
+	sample, err = sampler.Sample(ctx)
+	printDebug(test, err, sample)
+	require.NoError(test, err)
+	require.InDelta(test, 40.0, sample.Delta(statssampler.CounterAddedRecords), 0)
+	require.InDelta(test, 2.0, sample.Delta(statssampler.CounterLockWaits), 0)
+}
+
+func TestSampler_Sample_flatten(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(`{"workload": {"apiVersion": "4.1.1.25283", "loadedRecords": -1,` +
+		` "expressedFeatures": {"calls": [{"EFCALL_ID": 1, "numCalls": 5749}]},` +
+		` "license": {"status": "ok"}, "caches": {"libFeatCacheHit": 5777363}}}`)
+
+	sample, err := sampler.Sample(ctx)
+	printDebug(test, err, sample)
+	require.NoError(test, err)
+	require.Equal(test, map[string]float64{
+		"workload.caches.libFeatCacheHit": 5777363,
+		"workload.loadedRecords":          -1,
+	}, sample.Values)
+}
+
+func TestSampler_Sample_error(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler()
+	sampler.SzEngine = &fakeSzEngine{err: szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)} //exhaustruct:ignore
+
+	_, err := sampler.Sample(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+	require.Empty(test, sampler.Samples())
+}
+
+func TestSampler_Sample_badJSON(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler("[]")
+
+	_, err := sampler.Sample(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "JSON object")
+}
+
+func TestSampler_Samples_capacity(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(1, 0, 0), getStats(2, 0, 0), getStats(3, 0, 0), getStats(4, 0, 0),
+		getStats(5, 0, 0))
+	sampler.Capacity = 3
+
+	for range 5 {
+		_, err := sampler.Sample(ctx)
+		require.NoError(test, err)
+	}
+
+	samples := sampler.Samples()
+	require.Len(test, samples, 3)
+
+	for index, expected := range []float64{3, 4, 5} {
+		require.InDelta(test, expected, samples[index].Delta(statssampler.CounterAddedRecords), 0)
+	}
+}
+
+func TestSampler_Exporters(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(10, 0, 0), getStats(20, 0, 0))
+
+	var (
+		buffer       bytes.Buffer
+		addedRecords []float64
+	)
+
+	sampler.Exporters = []statssampler.Exporter{
+		&statssampler.JSONLExporter{Writer: &buffer}, //exhaustruct:ignore
+		statssampler.ExporterFunc(func(_ context.Context, sample statssampler.Sample) error {
+			addedRecords = append(addedRecords, sample.Delta(statssampler.CounterAddedRecords))
+
+			return nil
+		}),
+	}
+
+	for range 2 {
+		_, err := sampler.Sample(ctx)
+		require.NoError(test, err)
+	}
+
+	require.Equal(test, []float64{10, 20}, addedRecords)
+	require.Equal(test, 2, countLines(test, buffer.String()))
+}
+
+func TestSampler_Exporters_error(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(10, 0, 0))
+	sampler.Exporters = []statssampler.Exporter{
+		statssampler.ExporterFunc(func(_ context.Context, _ statssampler.Sample) error {
+			return errors.New("metrics unavailable")
+		}),
+	}
+
+	sample, err := sampler.Sample(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "metrics unavailable")
+	require.NotNil(test, sample)
+	require.Len(test, sampler.Samples(), 1)
+}
+
+func TestSampler_WriteJSONL(test *testing.T) {
+	ctx := test.Context()
+	sampler := newSampler(getStats(10, 0, 0), getStats(20, 0, 0))
+
+	for range 2 {
+		_, err := sampler.Sample(ctx)
+		require.NoError(test, err)
+	}
+
+	var buffer bytes.Buffer
+
+	require.NoError(test, sampler.WriteJSONL(&buffer))
+	printDebug(test, nil, buffer.String())
+
+	scanner := bufio.NewScanner(&buffer)
+	require.True(test, scanner.Scan())
+
+	sample := statssampler.Sample{} //exhaustruct:ignore
+	require.NoError(test, json.Unmarshal(scanner.Bytes(), &sample))
+	require.InDelta(test, 10.0, sample.Values[statssampler.CounterAddedRecords], 0)
+}
+
+func TestSampler_Run(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	sampler := newSampler()
+	sampler.Interval = time.Millisecond
+	sampler.Now = nil
+
+	var (
+		mutex  sync.Mutex
+		errs   []error
+		engine = &fakeSzEngine{err: errors.New("unavailable")} //exhaustruct:ignore
+	)
+
+	sampler.SzEngine = engine
+	sampler.OnError = func(_ context.Context, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		errs = append(errs, err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		sampler.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(test, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(errs) >= 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	err   error
+	mutex sync.Mutex
+	stats []string
+}
+
+func (engine *fakeSzEngine) GetStats(_ context.Context) (string, error) {
+	if engine.err != nil {
+		return "", engine.err
+	}
+
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+
+	result := engine.stats[0]
+	engine.stats = engine.stats[1:]
+
+	return result, nil
+}
+
+func countLines(t *testing.T, text string) int {
+	t.Helper()
+
+	result := 0
+	scanner := bufio.NewScanner(bytes.NewBufferString(text))
+
+	for scanner.Scan() {
+		result++
+	}
+
+	return result
+}
+
+func getStats(addedRecords int, retries int, lockWaits int) string {
+	return fmt.Sprintf(`{"workload": {"processing": {"addedRecords": %d, "details": {"retries": %d}},`+
+		` "lockWaits": {"refreshLocks": {"count": %d, "maxMS": 0, "totalMS": 0}}}}`, addedRecords, retries, lockWaits)
+}
+
+/*
+A sampler whose clock advances 10 seconds on each sample.
+*/
+func newSampler(stats ...string) *statssampler.Sampler {
+	clock := time.Date(2025, time.October, 21, 18, 0, 0, 0, time.UTC)
+
+	return &statssampler.Sampler{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{stats: stats}, //exhaustruct:ignore
+		Now: func() time.Time {
+			clock = clock.Add(10 * time.Second)
+
+			return clock
+		},
+	}
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
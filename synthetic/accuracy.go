@@ -0,0 +1,145 @@
+package synthetic
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Accuracy compares how records were resolved with their entity keys, counting pairs of records.
+A pair is resolved correctly if both records are in the same entity exactly when they have the same entity key.
+*/
+type Accuracy struct {
+	F1               float64 `json:"F1"`
+	Precision        float64 `json:"PRECISION"` // Share of pairs resolved together that have the same key.
+	Recall           float64 `json:"RECALL"`    // Share of pairs with the same key that are resolved together.
+	Records          int     `json:"RECORDS"`
+	ResolvedEntities int     `json:"RESOLVED_ENTITIES"`
+	TrueEntities     int     `json:"TRUE_ENTITIES"`
+}
+
+type getEntityByRecordIDResponse struct {
+	ResolvedEntity struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"RESOLVED_ENTITY"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The MeasureAccuracy function looks up the entity of each record and scores the resolution.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine that resolved the records.
+  - records: Records added to the repository, as by Generator.AddRecords.
+
+Output
+  - The accuracy.
+*/
+func MeasureAccuracy(ctx context.Context, szEngine senzing.SzEngine, records []Record) (Accuracy, error) {
+	entityIDs := make([]int64, 0, len(records))
+
+	for _, aRecord := range records {
+		response, err := szEngine.GetEntityByRecordID(ctx, aRecord.DataSourceCode, aRecord.RecordID,
+			senzing.SzNoFlags)
+		if err != nil {
+			return Accuracy{}, wraperror.Errorf(err, "GetEntityByRecordID %s", aRecord.RecordID)
+		}
+
+		parsedResponse := &getEntityByRecordIDResponse{} //exhaustruct:ignore
+
+		err = json.Unmarshal([]byte(response), parsedResponse)
+		if err != nil {
+			return Accuracy{}, wraperror.Errorf(err, "json.Unmarshal")
+		}
+
+		entityIDs = append(entityIDs, parsedResponse.ResolvedEntity.EntityID)
+	}
+
+	return ScoreAccuracy(records, entityIDs)
+}
+
+/*
+The ScoreAccuracy function scores a resolution.
+
+Input
+  - records: The records.
+  - entityIDs: The entity of each record, in the order of records.
+
+Output
+  - The accuracy. Precision and recall are 1 when there are no pairs to score.
+*/
+func ScoreAccuracy(records []Record, entityIDs []int64) (Accuracy, error) {
+	if len(records) != len(entityIDs) {
+		return Accuracy{}, wraperror.Errorf(errForPackage, "%d records but %d entity IDs",
+			len(records), len(entityIDs))
+	}
+
+	type cell struct {
+		entityID  int64
+		entityKey int
+	}
+
+	keyCounts := map[int]int{}
+	entityCounts := map[int64]int{}
+	cellCounts := map[cell]int{}
+
+	for index, aRecord := range records {
+		keyCounts[aRecord.EntityKey]++
+		entityCounts[entityIDs[index]]++
+		cellCounts[cell{entityID: entityIDs[index], entityKey: aRecord.EntityKey}]++
+	}
+
+	truePairs := pairs(keyCounts)
+	resolvedPairs := pairs(entityCounts)
+	correctPairs := pairs(cellCounts)
+
+	result := Accuracy{
+		F1:               0,
+		Precision:        ratio(correctPairs, resolvedPairs),
+		Recall:           ratio(correctPairs, truePairs),
+		Records:          len(records),
+		ResolvedEntities: len(entityCounts),
+		TrueEntities:     len(keyCounts),
+	}
+
+	if result.Precision+result.Recall > 0 {
+		result.F1 = 2 * result.Precision * result.Recall / (result.Precision + result.Recall)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+The number of pairs within groups of the given sizes.
+*/
+func pairs[K comparable](counts map[K]int) int {
+	result := 0
+	for _, count := range counts {
+		result += count * (count - 1) / 2
+	}
+
+	return result
+}
+
+func ratio(numerator int, denominator int) float64 {
+	if denominator == 0 {
+		return 1
+	}
+
+	return float64(numerator) / float64(denominator)
+}
@@ -0,0 +1,22 @@
+/*
+Package synthetic generates Senzing records of people and organizations, for load and accuracy tests.
+
+A [Generator] makes a repeatable sequence of records from its Seed.
+Each record describes an entity, identified by Record.EntityKey.
+With Generator.DuplicateRate, some records describe an entity that an earlier record described,
+with variations that entity resolution should see through:
+
+  - Generator.TypoRate: a letter of a name is changed, dropped or swapped with the next one.
+  - Generator.SwappedNameRate: the first and last names of a person are swapped.
+  - Generator.AddressVariantRate: "Street" becomes "St", a unit is added, or the address is given as one line.
+
+Records can be written as JSON lines with Generator.WriteJSONL, or added with Generator.AddRecords.
+After adding them, [MeasureAccuracy] compares how Senzing resolved them with their entity keys.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package synthetic
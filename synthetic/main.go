@@ -0,0 +1,112 @@
+package synthetic
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultDataSourceCode is the data source of records when Generator.DataSourceCode is not set.
+*/
+const DefaultDataSourceCode = "TEST"
+
+/*
+DefaultRecordIDPrefix is the prefix of record identifiers when Generator.RecordIDPrefix is not set.
+*/
+const DefaultRecordIDPrefix = "SYNTHETIC-"
+
+// Kinds of record. See Record.Kind.
+const (
+	KindOrganization = "ORGANIZATION"
+	KindPerson       = "PERSON"
+)
+
+// Variations of duplicate records. See Record.Variations.
+const (
+	VariationAddress     = "ADDRESS"
+	VariationSwappedName = "SWAPPED_NAME"
+	VariationTypo        = "TYPO"
+)
+
+const (
+	birthYears         = 65
+	daysPerMonth       = 28
+	firstBirthYear     = 1940
+	firstPhoneExchange = 200
+	monthsPerYear      = 12
+	phoneExchanges     = 800
+	phoneLines         = 10000
+	postalSuffixes     = 100
+	streetNumbers      = 9999
+	unitNumbers        = 40
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("synthetic")
+
+var firstNames = []string{
+	"Aaron", "Alice", "Andrea", "Benjamin", "Carmen", "Charles", "Daniel", "Diana", "Edward", "Elena",
+	"Francis", "Grace", "Henry", "Irene", "James", "Julia", "Kevin", "Laura", "Marcus", "Maria",
+	"Nathan", "Olivia", "Patrick", "Rachel", "Robert", "Sarah", "Thomas", "Teresa", "Victor", "Wendy",
+}
+
+var lastNames = []string{
+	"Anderson", "Baker", "Castillo", "Dawson", "Edwards", "Fischer", "Garcia", "Hoffman", "Ingram", "Jensen",
+	"Kowalski", "Lambert", "Martinez", "Nguyen", "Okafor", "Patel", "Quinn", "Robinson", "Schneider", "Thompson",
+	"Underwood", "Vasquez", "Walker", "Xiong", "Yamamoto", "Zimmerman", "Bennett", "Collins", "Morrison", "Sullivan",
+}
+
+var organizationNames = []string{
+	"Acme", "Blue Ridge", "Cascade", "Evergreen", "Granite", "Harbor", "Keystone", "Lakeside", "Meridian", "Northwind",
+	"Pinnacle", "Redwood", "Silver Creek", "Summit", "Trident", "Vanguard",
+}
+
+var organizationSuffixes = []string{
+	"Consulting", "Holdings", "Industries", "Logistics", "Manufacturing", "Partners", "Supply", "Technologies",
+}
+
+var organizationForms = []string{"Inc", "LLC", "Corp", "Ltd"}
+
+var streetNames = []string{
+	"Adams", "Birch", "Cedar", "Elm", "Franklin", "Highland", "Jefferson", "Lincoln", "Madison", "Maple",
+	"Oak", "Park", "Pine", "Ridge", "Spring", "Sunset", "Valley", "Washington", "Willow", "Main",
+}
+
+var streetSuffixes = []string{"Street", "Avenue", "Road", "Drive", "Lane", "Boulevard", "Court"}
+
+// Abbreviations of street suffixes, as used by address variants.
+var streetSuffixAbbreviations = map[string]string{
+	"Avenue":    "Ave",
+	"Boulevard": "Blvd",
+	"Court":     "Ct",
+	"Drive":     "Dr",
+	"Lane":      "Ln",
+	"Road":      "Rd",
+	"Street":    "St",
+}
+
+var unitDesignators = []string{"Apt", "Unit", "Suite", "#"}
+
+var cities = []struct {
+	name             string
+	state            string
+	postalCodePrefix string
+	areaCode         string
+}{
+	{name: "Las Vegas", state: "NV", postalCodePrefix: "891", areaCode: "702"},
+	{name: "Phoenix", state: "AZ", postalCodePrefix: "850", areaCode: "602"},
+	{name: "Denver", state: "CO", postalCodePrefix: "802", areaCode: "303"},
+	{name: "Austin", state: "TX", postalCodePrefix: "787", areaCode: "512"},
+	{name: "Portland", state: "OR", postalCodePrefix: "972", areaCode: "503"},
+	{name: "Sacramento", state: "CA", postalCodePrefix: "958", areaCode: "916"},
+	{name: "Columbus", state: "OH", postalCodePrefix: "432", areaCode: "614"},
+	{name: "Raleigh", state: "NC", postalCodePrefix: "276", areaCode: "919"},
+}
+
+var emailDomains = []string{"example.com", "example.net", "example.org"}
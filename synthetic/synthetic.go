@@ -0,0 +1,377 @@
+package synthetic
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Generator generates records. The zero value generates distinct people.
+*/
+type Generator struct {
+	// Optional. For duplicates, the chance, from 0 to 1, of an address variant.
+	AddressVariantRate float64
+
+	// Optional. If empty, DefaultDataSourceCode is used.
+	DataSourceCode string
+
+	// Optional. The share of records, from 0 to 1, that describe an entity described by an earlier record.
+	DuplicateRate float64
+
+	// Optional. The share of new entities, from 0 to 1, that are organizations. The others are people.
+	OrganizationRate float64
+
+	// Optional. If empty, DefaultRecordIDPrefix is used.
+	RecordIDPrefix string
+
+	// Optional. The seed of the random draws. The same seed and settings generate the same records.
+	Seed uint64
+
+	// Optional. For duplicate people, the chance, from 0 to 1, that first and last names are swapped.
+	SwappedNameRate float64
+
+	// Optional. If set, record definitions hold Record.EntityKey in a field of this name, such as "TRUTH_KEY",
+	// so that a JSON lines file carries its own ground truth.
+	TruthField string
+
+	// Optional. For duplicates, the chance, from 0 to 1, of a typo in a name.
+	TypoRate float64
+
+	entities []entity
+	mutex    sync.Mutex // Guards entities, records and random.
+	random   *rand.Rand
+	records  int
+}
+
+/*
+Record is a generated record.
+*/
+type Record struct {
+	DataSourceCode   string
+	EntityKey        int    // Records with the same key describe the same entity.
+	Kind             string // KindPerson or KindOrganization.
+	RecordDefinition string
+	RecordID         string
+	Variations       []string // For duplicates, such as VariationTypo.
+}
+
+/*
+The attributes of an entity, before variations.
+*/
+type entity struct {
+	city             string
+	dateOfBirth      string
+	email            string
+	firstName        string
+	key              int
+	kind             string
+	lastName         string
+	organizationName string
+	phoneNumber      string
+	postalCode       string
+	state            string
+	streetName       string
+	streetNumber     int
+	streetSuffix     string
+}
+
+// ----------------------------------------------------------------------------
+// Generator methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AddRecords generates records and adds them to the repository.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine that adds the records.
+  - count: The number of records.
+
+Output
+  - The records added, which are all the records unless there is an error.
+*/
+func (generator *Generator) AddRecords(ctx context.Context, szEngine senzing.SzEngine, count int) ([]Record, error) {
+	result := make([]Record, 0, count)
+
+	for range count {
+		aRecord := generator.Next()
+
+		_, err := szEngine.AddRecord(ctx, aRecord.DataSourceCode, aRecord.RecordID, aRecord.RecordDefinition,
+			senzing.SzWithoutInfo)
+		if err != nil {
+			return result, wraperror.Errorf(err, "AddRecord %s", aRecord.RecordID)
+		}
+
+		result = append(result, aRecord)
+	}
+
+	return result, nil
+}
+
+/*
+Method Next generates the next record.
+
+Output
+  - The record.
+*/
+func (generator *Generator) Next() Record {
+	generator.mutex.Lock()
+	defer generator.mutex.Unlock()
+
+	if generator.random == nil {
+		generator.random = rand.New(rand.NewPCG(generator.Seed, generator.Seed)) //nolint:gosec // Repeatable by design.
+	}
+
+	generator.records++
+
+	var (
+		anEntity   entity
+		variations []string
+	)
+
+	if len(generator.entities) > 0 && generator.random.Float64() < generator.DuplicateRate {
+		anEntity = generator.entities[generator.random.IntN(len(generator.entities))]
+		variations = generator.vary(&anEntity)
+	} else {
+		anEntity = generator.newEntity(len(generator.entities) + 1)
+		generator.entities = append(generator.entities, anEntity)
+	}
+
+	dataSourceCode := generator.DataSourceCode
+	if len(dataSourceCode) == 0 {
+		dataSourceCode = DefaultDataSourceCode
+	}
+
+	recordIDPrefix := generator.RecordIDPrefix
+	if len(recordIDPrefix) == 0 {
+		recordIDPrefix = DefaultRecordIDPrefix
+	}
+
+	recordID := recordIDPrefix + strconv.Itoa(generator.records)
+	attributes := anEntity.attributes(variations, generator.random)
+	attributes["DATA_SOURCE"] = dataSourceCode
+	attributes["RECORD_ID"] = recordID
+
+	if len(generator.TruthField) > 0 {
+		attributes[generator.TruthField] = strconv.Itoa(anEntity.key)
+	}
+
+	recordDefinition, _ := json.Marshal(attributes) //nolint:errchkjson
+
+	return Record{
+		DataSourceCode:   dataSourceCode,
+		EntityKey:        anEntity.key,
+		Kind:             anEntity.kind,
+		RecordDefinition: string(recordDefinition),
+		RecordID:         recordID,
+		Variations:       variations,
+	}
+}
+
+/*
+Method WriteJSONL generates records and writes their definitions as JSON lines.
+
+Input
+  - writer: Where the lines are written.
+  - count: The number of records.
+*/
+func (generator *Generator) WriteJSONL(writer io.Writer, count int) error {
+	bufferedWriter := bufio.NewWriter(writer)
+
+	for range count {
+		_, err := bufferedWriter.WriteString(generator.Next().RecordDefinition + "\n")
+		if err != nil {
+			return wraperror.Errorf(err, "WriteString")
+		}
+	}
+
+	return wraperror.Errorf(bufferedWriter.Flush(), "Flush")
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Requires generator.mutex.
+func (generator *Generator) newEntity(key int) entity {
+	random := generator.random
+	city := cities[random.IntN(len(cities))]
+	result := entity{
+		city: city.name,
+		key:  key,
+		kind: KindPerson,
+		phoneNumber: fmt.Sprintf("%s-%03d-%04d",
+			city.areaCode, firstPhoneExchange+random.IntN(phoneExchanges), random.IntN(phoneLines)),
+		postalCode:   fmt.Sprintf("%s%02d", city.postalCodePrefix, random.IntN(postalSuffixes)),
+		state:        city.state,
+		streetName:   pick(random, streetNames),
+		streetNumber: 1 + random.IntN(streetNumbers),
+		streetSuffix: pick(random, streetSuffixes),
+	} //exhaustruct:ignore
+
+	if random.Float64() < generator.OrganizationRate {
+		result.kind = KindOrganization
+		result.organizationName = fmt.Sprintf("%s %s %s",
+			pick(random, organizationNames), pick(random, organizationSuffixes), pick(random, organizationForms))
+
+		return result
+	}
+
+	result.firstName = pick(random, firstNames)
+	result.lastName = pick(random, lastNames)
+	result.dateOfBirth = fmt.Sprintf("%d-%02d-%02d",
+		firstBirthYear+random.IntN(birthYears), 1+random.IntN(monthsPerYear), 1+random.IntN(daysPerMonth))
+	result.email = fmt.Sprintf("%s.%s%d@%s", strings.ToLower(result.firstName), strings.ToLower(result.lastName),
+		key, pick(random, emailDomains))
+
+	return result
+}
+
+/*
+Apply variations to a copy of an entity, and list them.
+Requires generator.mutex.
+*/
+func (generator *Generator) vary(anEntity *entity) []string {
+	random := generator.random
+
+	var result []string
+
+	if random.Float64() < generator.TypoRate {
+		switch {
+		case anEntity.kind == KindOrganization:
+			anEntity.organizationName = typo(random, anEntity.organizationName)
+		case random.IntN(2) == 0:
+			anEntity.firstName = typo(random, anEntity.firstName)
+		default:
+			anEntity.lastName = typo(random, anEntity.lastName)
+		}
+
+		result = append(result, VariationTypo)
+	}
+
+	if anEntity.kind == KindPerson && random.Float64() < generator.SwappedNameRate {
+		anEntity.firstName, anEntity.lastName = anEntity.lastName, anEntity.firstName
+		result = append(result, VariationSwappedName)
+	}
+
+	if random.Float64() < generator.AddressVariantRate {
+		result = append(result, VariationAddress)
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// entity methods
+// ----------------------------------------------------------------------------
+
+/*
+The Senzing attributes of the entity. An address variant is drawn if the variations include VariationAddress.
+*/
+func (anEntity *entity) attributes(variations []string, random *rand.Rand) map[string]string {
+	result := map[string]string{
+		"ADDR_TYPE":    "HOME",
+		"PHONE_NUMBER": anEntity.phoneNumber,
+		"RECORD_TYPE":  anEntity.kind,
+	}
+
+	if anEntity.kind == KindOrganization {
+		result["ADDR_TYPE"] = "BUSINESS"
+		result["NAME_ORG"] = anEntity.organizationName
+	} else {
+		result["DATE_OF_BIRTH"] = anEntity.dateOfBirth
+		result["EMAIL_ADDRESS"] = anEntity.email
+		result["NAME_FIRST"] = anEntity.firstName
+		result["NAME_LAST"] = anEntity.lastName
+	}
+
+	streetSuffix := anEntity.streetSuffix
+	unit := ""
+	fullAddress := false
+
+	if slices.Contains(variations, VariationAddress) {
+		const kindsOfAddressVariant = 3
+
+		switch random.IntN(kindsOfAddressVariant) {
+		case 0:
+			streetSuffix = streetSuffixAbbreviations[streetSuffix]
+		case 1:
+			unit = fmt.Sprintf(" %s %d", pick(random, unitDesignators), 1+random.IntN(unitNumbers))
+		default:
+			fullAddress = true
+		}
+	}
+
+	addressLine := fmt.Sprintf("%d %s %s%s", anEntity.streetNumber, anEntity.streetName, streetSuffix, unit)
+
+	if fullAddress {
+		result["ADDR_FULL"] = fmt.Sprintf("%s, %s, %s %s", addressLine, anEntity.city, anEntity.state, anEntity.postalCode)
+	} else {
+		result["ADDR_LINE1"] = addressLine
+		result["ADDR_CITY"] = anEntity.city
+		result["ADDR_STATE"] = anEntity.state
+		result["ADDR_POSTAL_CODE"] = anEntity.postalCode
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func pick(random *rand.Rand, values []string) string {
+	return values[random.IntN(len(values))]
+}
+
+/*
+Change, drop or swap a letter, away from the first letter.
+*/
+func typo(random *rand.Rand, value string) string {
+	runes := []rune(value)
+
+	const minimumLength = 3
+	if len(runes) < minimumLength {
+		return value
+	}
+
+	position := 1 + random.IntN(len(runes)-2)
+
+	const kindsOfTypo = 3
+
+	switch random.IntN(kindsOfTypo) {
+	case 0:
+		replacement := 'a' + rune(random.IntN('z'-'a'))
+		if replacement >= runes[position] {
+			replacement++ // Never the letter replaced.
+		}
+
+		runes[position] = replacement
+	case 1:
+		runes = append(runes[:position], runes[position+1:]...)
+	default:
+		runes[position], runes[position+1] = runes[position+1], runes[position]
+	}
+
+	result := string(runes)
+	if result == value { // Swapped a double letter.
+		result = string(slices.Delete(runes, position, position+1))
+	}
+
+	return result
+}
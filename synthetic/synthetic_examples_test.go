@@ -0,0 +1,40 @@
+package synthetic_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/synthetic"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleGenerator_Next() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/synthetic/synthetic_test.go
+	generator := &synthetic.Generator{DuplicateRate: 0.5, Seed: 1, TypoRate: 1} //exhaustruct:ignore
+
+	for range 5 {
+		aRecord := generator.Next()
+		fmt.Println(aRecord.RecordID, aRecord.EntityKey, aRecord.Variations)
+	}
+	// Output:
+	// SYNTHETIC-1 1 []
+	// SYNTHETIC-2 2 []
+	// SYNTHETIC-3 1 [TYPO]
+	// SYNTHETIC-4 1 [TYPO]
+	// SYNTHETIC-5 3 []
+}
+
+func ExampleScoreAccuracy() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/synthetic/synthetic_test.go
+	records := []synthetic.Record{{EntityKey: 1}, {EntityKey: 1}, {EntityKey: 2}} //exhaustruct:ignore
+
+	accuracy, err := synthetic.ScoreAccuracy(records, []int64{100, 100, 100})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("precision %.2f, recall %.2f\n", accuracy.Precision, accuracy.Recall)
+	// Output: precision 0.33, recall 1.00
+}
@@ -0,0 +1,341 @@
+package synthetic_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/bufconntest"
+	"github.com/senzing-garage/sz-sdk-go-grpc/synthetic"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGenerator_Next(test *testing.T) {
+	generator := &synthetic.Generator{} //exhaustruct:ignore
+
+	for index := range 20 {
+		aRecord := generator.Next()
+		printDebug(test, nil, aRecord.RecordDefinition)
+		require.Equal(test, synthetic.DefaultDataSourceCode, aRecord.DataSourceCode)
+		require.Equal(test, fmt.Sprintf("%s%d", synthetic.DefaultRecordIDPrefix, index+1), aRecord.RecordID)
+		require.Equal(test, index+1, aRecord.EntityKey)
+		require.Equal(test, synthetic.KindPerson, aRecord.Kind)
+		require.Empty(test, aRecord.Variations)
+
+		attributes := parseAttributes(test, aRecord.RecordDefinition)
+		require.Equal(test, aRecord.DataSourceCode, attributes["DATA_SOURCE"])
+		require.Equal(test, aRecord.RecordID, attributes["RECORD_ID"])
+		require.Equal(test, synthetic.KindPerson, attributes["RECORD_TYPE"])
+		require.NotEmpty(test, attributes["NAME_FIRST"])
+		require.NotEmpty(test, attributes["NAME_LAST"])
+		require.NotEmpty(test, attributes["DATE_OF_BIRTH"])
+	}
+}
+
+func TestGenerator_Next_seed(test *testing.T) {
+	newGenerator := func(seed uint64) *synthetic.Generator {
+		return &synthetic.Generator{
+			AddressVariantRate: 0.5,
+			DuplicateRate:      0.5,
+			OrganizationRate:   0.3,
+			Seed:               seed,
+			SwappedNameRate:    0.5,
+			TypoRate:           0.5,
+		} //exhaustruct:ignore
+	}
+
+	first := records(newGenerator(42), 100)
+	require.Equal(test, first, records(newGenerator(42), 100))
+	require.NotEqual(test, first, records(newGenerator(43), 100))
+}
+
+func TestGenerator_Next_duplicates(test *testing.T) {
+	generator := &synthetic.Generator{
+		AddressVariantRate: 1,
+		DataSourceCode:     "CUSTOMERS",
+		DuplicateRate:      0.4,
+		OrganizationRate:   0.25,
+		RecordIDPrefix:     "C-",
+		Seed:               7,
+		SwappedNameRate:    1,
+		TruthField:         "TRUTH_KEY",
+		TypoRate:           1,
+	}
+
+	entityKeys := map[int]bool{}
+	kinds := map[string]int{}
+	duplicates := 0
+
+	const count = 1000
+
+	for range count {
+		aRecord := generator.Next()
+		attributes := parseAttributes(test, aRecord.RecordDefinition)
+		require.Equal(test, "CUSTOMERS", attributes["DATA_SOURCE"])
+		require.Equal(test, fmt.Sprint(aRecord.EntityKey), attributes["TRUTH_KEY"])
+
+		kinds[aRecord.Kind]++
+
+		if !entityKeys[aRecord.EntityKey] {
+			entityKeys[aRecord.EntityKey] = true
+
+			require.Empty(test, aRecord.Variations)
+
+			continue
+		}
+
+		duplicates++
+
+		require.Contains(test, aRecord.Variations, synthetic.VariationTypo)
+		require.Contains(test, aRecord.Variations, synthetic.VariationAddress)
+
+		if aRecord.Kind == synthetic.KindPerson {
+			require.Contains(test, aRecord.Variations, synthetic.VariationSwappedName)
+		} else {
+			require.NotEmpty(test, attributes["NAME_ORG"])
+		}
+	}
+
+	require.InDelta(test, 0.4, float64(duplicates)/count, 0.05)
+	organizations := float64(kinds[synthetic.KindOrganization])
+	require.InDelta(test, 0.25, organizations/(organizations+float64(kinds[synthetic.KindPerson])), 0.05)
+}
+
+func TestGenerator_WriteJSONL(test *testing.T) {
+	var buffer bytes.Buffer
+
+	generator := &synthetic.Generator{DuplicateRate: 0.2, Seed: 1}             //exhaustruct:ignore
+	expected := records(&synthetic.Generator{DuplicateRate: 0.2, Seed: 1}, 50) //exhaustruct:ignore
+
+	err := generator.WriteJSONL(&buffer, 50)
+	require.NoError(test, err)
+
+	lines := []string{}
+	scanner := bufio.NewScanner(&buffer)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	require.Len(test, lines, 50)
+
+	for index, line := range lines {
+		require.Equal(test, expected[index].RecordDefinition, line)
+	}
+}
+
+func TestGenerator_AddRecords(test *testing.T) {
+	ctx := test.Context()
+	server := &fakeServer{entityIDs: map[string]int64{}} //exhaustruct:ignore
+	szEngine := getTestObject(test, server)
+	generator := &synthetic.Generator{DuplicateRate: 0.5, Seed: 3, TruthField: "TRUTH_KEY"} //exhaustruct:ignore
+
+	added, err := generator.AddRecords(ctx, szEngine, 40)
+	require.NoError(test, err)
+	require.Len(test, added, 40)
+	require.Len(test, server.entityIDs, 40)
+
+	// The fake server resolves by entity key, so resolution is perfect.
+
+	accuracy, err := synthetic.MeasureAccuracy(ctx, szEngine, added)
+	printDebug(test, err, accuracy)
+	require.NoError(test, err)
+	require.InDelta(test, 1.0, accuracy.F1, 0)
+	require.Equal(test, 40, accuracy.Records)
+	require.Equal(test, accuracy.TrueEntities, accuracy.ResolvedEntities)
+	require.Less(test, accuracy.TrueEntities, 40)
+}
+
+func TestGenerator_AddRecords_error(test *testing.T) {
+	ctx := test.Context()
+	server := &fakeServer{entityIDs: map[string]int64{}, failAfter: 5} //exhaustruct:ignore
+	szEngine := getTestObject(test, server)
+	generator := &synthetic.Generator{} //exhaustruct:ignore
+
+	added, err := generator.AddRecords(ctx, szEngine, 10)
+	printDebug(test, err)
+	require.Error(test, err)
+	require.Len(test, added, 5)
+}
+
+func TestScoreAccuracy(test *testing.T) {
+	records := []synthetic.Record{{EntityKey: 1}, {EntityKey: 1}, {EntityKey: 1}, {EntityKey: 2}} //exhaustruct:ignore
+
+	testCases := []struct {
+		name      string
+		entityIDs []int64
+		expected  synthetic.Accuracy
+	}{
+		{
+			name:      "perfect",
+			entityIDs: []int64{10, 10, 10, 20},
+			expected:  synthetic.Accuracy{F1: 1, Precision: 1, Recall: 1, Records: 4, ResolvedEntities: 2, TrueEntities: 2},
+		},
+		{
+			name:      "overMerged",
+			entityIDs: []int64{10, 10, 10, 10},
+			expected:  synthetic.Accuracy{F1: 2 * 0.5 / 1.5, Precision: 0.5, Recall: 1, Records: 4, ResolvedEntities: 1, TrueEntities: 2},
+		},
+		{
+			name:      "underMerged",
+			entityIDs: []int64{10, 10, 11, 20},
+			expected:  synthetic.Accuracy{F1: 2 * (1.0 / 3) / (4.0 / 3), Precision: 1, Recall: 1.0 / 3, Records: 4, ResolvedEntities: 3, TrueEntities: 2},
+		},
+		{
+			name:      "noneMerged",
+			entityIDs: []int64{10, 11, 12, 13},
+			expected:  synthetic.Accuracy{F1: 0, Precision: 1, Recall: 0, Records: 4, ResolvedEntities: 4, TrueEntities: 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, err := synthetic.ScoreAccuracy(records, testCase.entityIDs)
+			printDebug(test, err, actual)
+			require.NoError(test, err)
+			require.InDelta(test, testCase.expected.F1, actual.F1, 1e-9)
+			require.InDelta(test, testCase.expected.Precision, actual.Precision, 1e-9)
+			require.InDelta(test, testCase.expected.Recall, actual.Recall, 1e-9)
+			require.Equal(test, testCase.expected.Records, actual.Records)
+			require.Equal(test, testCase.expected.ResolvedEntities, actual.ResolvedEntities)
+			require.Equal(test, testCase.expected.TrueEntities, actual.TrueEntities)
+		})
+	}
+}
+
+func TestScoreAccuracy_error(test *testing.T) {
+	_, err := synthetic.ScoreAccuracy([]synthetic.Record{{}}, []int64{}) //exhaustruct:ignore
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+/*
+A server that resolves records by their TRUTH_KEY, or each record to its own entity.
+*/
+type fakeServer struct {
+	szenginepb.UnimplementedSzEngineServer
+
+	entityIDs map[string]int64 // Record ID to entity ID.
+	failAfter int              // If not 0, AddRecord fails after this many records.
+	mutex     sync.Mutex
+}
+
+func (server *fakeServer) AddRecord(
+	_ context.Context,
+	request *szenginepb.AddRecordRequest,
+) (*szenginepb.AddRecordResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.failAfter > 0 && len(server.entityIDs) >= server.failAfter {
+		return nil, status.Error(codes.Unknown, `{"reason": "SENZ0010|Retry timeout exceeded"}`)
+	}
+
+	attributes := map[string]string{}
+
+	err := json.Unmarshal([]byte(request.GetRecordDefinition()), &attributes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var entityID int64
+
+	_, err = fmt.Sscan(attributes["TRUTH_KEY"], &entityID)
+	if err != nil {
+		entityID = int64(len(server.entityIDs) + 1)
+	}
+
+	server.entityIDs[request.GetRecordId()] = entityID
+
+	return &szenginepb.AddRecordResponse{Result: "{}"}, nil
+}
+
+func (server *fakeServer) GetEntityByRecordId(
+	_ context.Context,
+	request *szenginepb.GetEntityByRecordIdRequest,
+) (*szenginepb.GetEntityByRecordIdResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return &szenginepb.GetEntityByRecordIdResponse{
+		Result: fmt.Sprintf(`{"RESOLVED_ENTITY": {"ENTITY_ID": %d}}`, server.entityIDs[request.GetRecordId()]),
+	}, nil
+}
+
+/*
+Serve SzEngine over an in-memory connection.
+*/
+func getTestObject(t *testing.T, fake *fakeServer) senzing.SzEngine {
+	t.Helper()
+
+	connection := bufconntest.Connection(t, func(server *grpc.Server) {
+		szenginepb.RegisterSzEngineServer(server, fake)
+	})
+
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{GrpcConnection: connection} //exhaustruct:ignore
+
+	szEngine, err := szAbstractFactory.CreateEngine(t.Context())
+	require.NoError(t, err)
+
+	return szEngine
+}
+
+func parseAttributes(t *testing.T, recordDefinition string) map[string]string {
+	t.Helper()
+
+	result := map[string]string{}
+	require.NoError(t, json.Unmarshal([]byte(recordDefinition), &result))
+
+	return result
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}
+
+func records(generator *synthetic.Generator, count int) []synthetic.Record {
+	result := []synthetic.Record{}
+	for range count {
+		result = append(result, generator.Next())
+	}
+
+	return result
+}
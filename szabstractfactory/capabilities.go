@@ -0,0 +1,206 @@
+package szabstractfactory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/getversion"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szproduct"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Capability names a feature of the Senzing server that not every version has.
+*/
+type Capability string
+
+/*
+CapabilityRequirement describes what a server needs for a Capability.
+A Capability with both fields set requires both.
+*/
+type CapabilityRequirement struct {
+	MinVersion string // Optional. The earliest Senzing version with the capability, e.g. "4.2".
+	RPC        string // Optional. A gRPC method the server must implement, e.g. "/szengine.SzEngine/AddRecord".
+}
+
+/*
+The capabilities of a server, found once and then cached.
+The mutex is not held while the server is queried, so concurrent first calls may query it more than once.
+The zero value is ready to use.
+*/
+type capabilityRegistry struct {
+	mutex          sync.Mutex
+	requirements   map[Capability]CapabilityRequirement // Added by RegisterCapability.
+	rpcs           map[string]bool                      // Whether the server implements the gRPC method.
+	senzingVersion *getversion.Version
+}
+
+// The probeCodec sends a request that no protocol buffer message can decode.
+type probeCodec struct{}
+
+// ----------------------------------------------------------------------------
+// Capability methods
+// ----------------------------------------------------------------------------
+
+/*
+Method RegisterCapability adds a capability, or replaces a built-in one, for this factory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - capability: The name of the capability.
+  - requirement: What the server needs for the capability.
+*/
+func (factory *Szabstractfactory) RegisterCapability(
+	ctx context.Context,
+	capability Capability,
+	requirement CapabilityRequirement,
+) {
+	_ = ctx
+
+	factory.capabilities.mutex.Lock()
+	defer factory.capabilities.mutex.Unlock()
+
+	if factory.capabilities.requirements == nil {
+		factory.capabilities.requirements = map[Capability]CapabilityRequirement{}
+	}
+
+	factory.capabilities.requirements[capability] = requirement
+}
+
+/*
+Method Supports reports whether the server has a capability.
+
+The Senzing version is queried once; each gRPC method is probed once, with a request the server cannot decode,
+so that nothing is executed.
+A server that registers a method but does not implement it is reported as supporting it.
+
+Input
+  - ctx: A context to control lifecycle.
+  - capability: One of the Capability* constants, or a capability added with RegisterCapability.
+
+Output
+  - True if the server has the capability.
+*/
+func (factory *Szabstractfactory) Supports(ctx context.Context, capability Capability) (bool, error) {
+	factory.capabilities.mutex.Lock()
+	requirement, isOK := factory.capabilities.requirements[capability]
+	factory.capabilities.mutex.Unlock()
+
+	if !isOK {
+		requirement, isOK = defaultCapabilities[capability]
+	}
+
+	if !isOK {
+		return false, wraperror.Errorf(errForPackage, "unknown capability %s", capability)
+	}
+
+	if len(requirement.MinVersion) > 0 {
+		isSupported, err := factory.supportsVersion(ctx, requirement.MinVersion)
+		if err != nil || !isSupported {
+			return false, err
+		}
+	}
+
+	if len(requirement.RPC) > 0 {
+		return factory.supportsRPC(ctx, requirement.RPC)
+	}
+
+	return true, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (factory *Szabstractfactory) supportsRPC(ctx context.Context, method string) (bool, error) {
+	factory.capabilities.mutex.Lock()
+	result, isOK := factory.capabilities.rpcs[method]
+	factory.capabilities.mutex.Unlock()
+
+	if isOK {
+		return result, nil
+	}
+
+	var reply struct{}
+
+	err := factory.GrpcConnection.Invoke(ctx, method, struct{}{}, &reply, grpc.ForceCodec(probeCodec{}))
+
+	switch status.Code(err) {
+	case codes.Unimplemented:
+		result = false
+	case codes.OK, codes.Internal: // Internal: the method exists, but could not decode the probe.
+		result = true
+	default:
+		return false, wraperror.Errorf(err, "probe %s", method)
+	}
+
+	factory.capabilities.mutex.Lock()
+	defer factory.capabilities.mutex.Unlock()
+
+	if factory.capabilities.rpcs == nil {
+		factory.capabilities.rpcs = map[string]bool{}
+	}
+
+	factory.capabilities.rpcs[method] = result
+
+	return result, nil
+}
+
+func (factory *Szabstractfactory) supportsVersion(ctx context.Context, minVersion string) (bool, error) {
+	requiredVersion, err := getversion.ParseVersion(minVersion)
+	if err != nil {
+		return false, err
+	}
+
+	factory.capabilities.mutex.Lock()
+	senzingVersion := factory.capabilities.senzingVersion
+	factory.capabilities.mutex.Unlock()
+
+	if senzingVersion == nil {
+		szProduct := &szproduct.Szproduct{
+			GrpcClient: szproductpb.NewSzProductClient(factory.GrpcConnection),
+		}
+
+		versionInfo, err := getversion.GetVersion(ctx, szProduct)
+		if err != nil {
+			return false, err
+		}
+
+		senzingVersion = &versionInfo.Version
+
+		factory.capabilities.mutex.Lock()
+		factory.capabilities.senzingVersion = senzingVersion
+		factory.capabilities.mutex.Unlock()
+	}
+
+	return senzingVersion.AtLeast(requiredVersion), nil
+}
+
+// ----------------------------------------------------------------------------
+// probeCodec methods
+// ----------------------------------------------------------------------------
+
+func (codec probeCodec) Marshal(value any) ([]byte, error) {
+	_ = value
+
+	return []byte{0}, nil // Field number 0 is never valid.
+}
+
+func (codec probeCodec) Name() string {
+	return "proto"
+}
+
+func (codec probeCodec) Unmarshal(data []byte, value any) error {
+	_ = data
+	_ = value
+
+	return nil
+}
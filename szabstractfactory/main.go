@@ -1,5 +1,12 @@
 package szabstractfactory
 
+import (
+	"errors"
+	"time"
+
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
@@ -9,3 +16,46 @@ ComponentID is the identifier of the szabstractfactory package.
 Package abstractfactory messages will have the format "SZSDK6020eeee" where "eeee" is the error identifier.
 */
 const ComponentID = 6020
+
+// Capabilities known to every Szabstractfactory. See Szabstractfactory.Supports.
+const (
+	// The server implements SzEngine.StreamExportCsvEntityReport.
+	CapabilityStreamExportCsvEntityReport Capability = "StreamExportCsvEntityReport"
+
+	// The server implements SzEngine.StreamExportJsonEntityReport.
+	CapabilityStreamExportJSONEntityReport Capability = "StreamExportJsonEntityReport"
+)
+
+// Protection defaults. See Protection.
+const (
+	// The environment variable read when Protection.ConfirmationToken is empty.
+	ConfirmationEnvVar = "SENZING_TOOLS_CONFIRM_REPOSITORY"
+
+	DefaultDeleteWindow = time.Minute
+	DefaultMaxDeletes   = 100
+)
+
+const identityLength = 16 // Hexadecimal digits of the repository identity.
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var defaultCapabilities = map[Capability]CapabilityRequirement{
+	CapabilityStreamExportCsvEntityReport: {
+		MinVersion: "",
+		RPC:        szenginepb.SzEngine_StreamExportCsvEntityReport_FullMethodName,
+	},
+	CapabilityStreamExportJSONEntityReport: {
+		MinVersion: "",
+		RPC:        szenginepb.SzEngine_StreamExportJsonEntityReport_FullMethodName,
+	},
+}
+
+// ErrDestructiveOperationBlocked is wrapped by the szerror.ErrSzSdk error returned when Protection blocks an operation.
+var ErrDestructiveOperationBlocked = errors.New("destructive operation blocked")
+
+// ErrReadOnly is wrapped by the szerror.ErrSzSdk error returned by mutating methods of a ReadOnly factory's objects.
+var ErrReadOnly = errors.New("read-only")
+
+var errForPackage = errors.New("szabstractfactory")
@@ -0,0 +1,482 @@
+package szabstractfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-grpc/configdocument"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	szdiagnosticpb "github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Protection guards a repository against destructive operations made through the factory's objects.
+
+These operations are blocked unless confirmed:
+  - SzDiagnostic.PurgeRepository.
+  - SzConfigManager.SetDefaultConfig, SetDefaultConfigID and ReplaceDefaultConfigID,
+    when the new default configuration has fewer data sources than the current one.
+  - SzEngine.DeleteRecord, once more than MaxDeletes records are deleted within DeleteWindow.
+
+An operation is confirmed when the confirmation token equals the repository identity,
+which is returned by Szabstractfactory.RepositoryIdentity and named in the error of a blocked operation.
+A token meant for one repository does not unlock another.
+*/
+type Protection struct {
+	// Optional. If empty, the ConfirmationEnvVar environment variable is used.
+	ConfirmationToken string
+
+	// Optional. If 0, DefaultDeleteWindow is used.
+	DeleteWindow time.Duration
+
+	// Optional. If 0, DefaultMaxDeletes is used.
+	MaxDeletes int
+}
+
+/*
+The state of a factory's Protection.
+The zero value is ready to use.
+*/
+type protectionState struct {
+	mutex       sync.Mutex
+	deletes     int       // DeleteRecord calls since windowStart.
+	identity    string    // Found once by RepositoryIdentity.
+	windowStart time.Time // Start of the current DeleteWindow.
+}
+
+type protectedConfigManager struct {
+	senzing.SzConfigManager
+
+	factory *Szabstractfactory
+}
+
+type protectedDiagnostic struct {
+	senzing.SzDiagnostic
+
+	factory *Szabstractfactory
+}
+
+type protectedEngine struct {
+	senzing.SzEngine
+
+	factory *Szabstractfactory
+}
+
+// ----------------------------------------------------------------------------
+// Protection methods
+// ----------------------------------------------------------------------------
+
+/*
+Method RepositoryIdentity returns the identity of the repository the factory is connected to.
+The identity is a digest of the data stores reported by SzDiagnostic.GetRepositoryInfo;
+it is found once and then cached.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The repository identity, to be used as a Protection confirmation token.
+*/
+func (factory *Szabstractfactory) RepositoryIdentity(ctx context.Context) (string, error) {
+	factory.protection.mutex.Lock()
+	identity := factory.protection.identity
+	factory.protection.mutex.Unlock()
+
+	if len(identity) > 0 {
+		return identity, nil
+	}
+
+	szDiagnostic := &szdiagnostic.Szdiagnostic{
+		GrpcClient: szdiagnosticpb.NewSzDiagnosticClient(factory.GrpcConnection),
+	}
+
+	repositoryInfoJSON, err := szDiagnostic.GetRepositoryInfo(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "GetRepositoryInfo")
+	}
+
+	identity, err = repositoryIdentity(repositoryInfoJSON)
+	if err != nil {
+		return "", err
+	}
+
+	factory.protection.mutex.Lock()
+	defer factory.protection.mutex.Unlock()
+
+	factory.protection.identity = identity
+
+	return identity, nil
+}
+
+// ----------------------------------------------------------------------------
+// protectedConfigManager methods
+// ----------------------------------------------------------------------------
+
+/*
+Method ReplaceDefaultConfigID needs confirmation if the new configuration drops data sources.
+
+Input
+  - ctx: A context to control lifecycle.
+  - currentDefaultConfigID: The configuration identifier to replace.
+  - newDefaultConfigID: The configuration identifier to use as the default.
+*/
+func (configManager *protectedConfigManager) ReplaceDefaultConfigID(
+	ctx context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	newConfigDefinition, err := configManager.export(ctx, newDefaultConfigID)
+	if err != nil {
+		return err
+	}
+
+	err = configManager.confirmPromotion(ctx, "ReplaceDefaultConfigID", currentDefaultConfigID, newConfigDefinition)
+	if err != nil {
+		return err
+	}
+
+	return wraperror.Errorf(
+		configManager.SzConfigManager.ReplaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID),
+		wraperror.NoMessage,
+	)
+}
+
+/*
+Method SetDefaultConfig needs confirmation if the new configuration drops data sources.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configDefinition: The Senzing configuration JSON document.
+  - configComment: A free-form string describing the configuration document.
+
+Output
+  - configID: A configuration identifier.
+*/
+func (configManager *protectedConfigManager) SetDefaultConfig(
+	ctx context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	currentDefaultConfigID, err := configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return 0, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	err = configManager.confirmPromotion(ctx, "SetDefaultConfig", currentDefaultConfigID, configDefinition)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := configManager.SzConfigManager.SetDefaultConfig(ctx, configDefinition, configComment)
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method SetDefaultConfigID needs confirmation if the new configuration drops data sources.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The configuration identifier of the Senzing configuration JSON document to use as the default.
+*/
+func (configManager *protectedConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	currentDefaultConfigID, err := configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	newConfigDefinition, err := configManager.export(ctx, configID)
+	if err != nil {
+		return err
+	}
+
+	err = configManager.confirmPromotion(ctx, "SetDefaultConfigID", currentDefaultConfigID, newConfigDefinition)
+	if err != nil {
+		return err
+	}
+
+	return wraperror.Errorf(configManager.SzConfigManager.SetDefaultConfigID(ctx, configID), wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// protectedDiagnostic methods
+// ----------------------------------------------------------------------------
+
+/*
+Method PurgeRepository always needs confirmation.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (diagnostic *protectedDiagnostic) PurgeRepository(ctx context.Context) error {
+	err := diagnostic.factory.confirm(ctx, "PurgeRepository")
+	if err != nil {
+		return err
+	}
+
+	return wraperror.Errorf(diagnostic.SzDiagnostic.PurgeRepository(ctx), wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// protectedEngine methods
+// ----------------------------------------------------------------------------
+
+/*
+Method DeleteRecord needs confirmation once more than Protection.MaxDeletes records
+are deleted within Protection.DeleteWindow by the factory's engines.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (engine *protectedEngine) DeleteRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	if engine.factory.countDelete() {
+		err := engine.factory.confirm(ctx, "DeleteRecord")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	result, err := engine.SzEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Return an error wrapping ErrDestructiveOperationBlocked unless the confirmation token
+matches the repository identity.
+*/
+func (factory *Szabstractfactory) confirm(ctx context.Context, operation string) error {
+	identity, err := factory.RepositoryIdentity(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "%s: RepositoryIdentity", operation)
+	}
+
+	confirmationToken := factory.Protection.ConfirmationToken
+	if len(confirmationToken) == 0 {
+		confirmationToken = os.Getenv(ConfirmationEnvVar)
+	}
+
+	switch {
+	case confirmationToken == identity:
+		return nil
+	case len(confirmationToken) == 0:
+		return blockedError(operation, "no confirmation token was given", identity)
+	default:
+		return blockedError(operation, "the confirmation token is for a different repository", identity)
+	}
+}
+
+/*
+Count a DeleteRecord call and report whether it exceeds Protection.MaxDeletes within Protection.DeleteWindow.
+*/
+func (factory *Szabstractfactory) countDelete() bool {
+	deleteWindow := factory.Protection.DeleteWindow
+	if deleteWindow <= 0 {
+		deleteWindow = DefaultDeleteWindow
+	}
+
+	maxDeletes := factory.Protection.MaxDeletes
+	if maxDeletes <= 0 {
+		maxDeletes = DefaultMaxDeletes
+	}
+
+	factory.protection.mutex.Lock()
+	defer factory.protection.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(factory.protection.windowStart) >= deleteWindow {
+		factory.protection.deletes = 0
+		factory.protection.windowStart = now
+	}
+
+	factory.protection.deletes++
+
+	return factory.protection.deletes > maxDeletes
+}
+
+func (factory *Szabstractfactory) protectConfigManager(szConfigManager senzing.SzConfigManager) senzing.SzConfigManager {
+	switch {
+	case factory.ReadOnly:
+		return &readOnlyConfigManager{SzConfigManager: szConfigManager}
+	case factory.Protection != nil:
+		return &protectedConfigManager{SzConfigManager: szConfigManager, factory: factory}
+	default:
+		return szConfigManager
+	}
+}
+
+func (factory *Szabstractfactory) protectDiagnostic(szDiagnostic senzing.SzDiagnostic) senzing.SzDiagnostic {
+	switch {
+	case factory.ReadOnly:
+		return &readOnlyDiagnostic{SzDiagnostic: szDiagnostic}
+	case factory.Protection != nil:
+		return &protectedDiagnostic{SzDiagnostic: szDiagnostic, factory: factory}
+	default:
+		return szDiagnostic
+	}
+}
+
+func (factory *Szabstractfactory) protectEngine(szEngine senzing.SzEngine) senzing.SzEngine {
+	switch {
+	case factory.ReadOnly:
+		return &readOnlyEngine{SzEngine: szEngine}
+	case factory.Protection != nil:
+		return &protectedEngine{SzEngine: szEngine, factory: factory}
+	default:
+		return szEngine
+	}
+}
+
+/*
+Confirm a change of the default configuration if it drops data sources of the current default.
+*/
+func (configManager *protectedConfigManager) confirmPromotion(
+	ctx context.Context,
+	operation string,
+	currentDefaultConfigID int64,
+	newConfigDefinition string,
+) error {
+	if currentDefaultConfigID == 0 {
+		return nil // There is no current default to drop data sources from.
+	}
+
+	currentConfigDefinition, err := configManager.export(ctx, currentDefaultConfigID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := removedDataSources(currentConfigDefinition, newConfigDefinition)
+	if err != nil {
+		return wraperror.Errorf(err, operation)
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	return configManager.factory.confirm(ctx,
+		fmt.Sprintf("%s unregistering data sources %s", operation, strings.Join(removed, ", ")))
+}
+
+func (configManager *protectedConfigManager) export(ctx context.Context, configID int64) (string, error) {
+	szConfig, err := configManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateConfigFromConfigID(%d)", configID)
+	}
+
+	result, err := szConfig.Export(ctx)
+
+	return result, wraperror.Errorf(err, "Export(%d)", configID)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func blockedError(operation string, reason string, identity string) error {
+	return fmt.Errorf(
+		"%w%w%w: %s on a protected repository: %s; to confirm, set Protection.ConfirmationToken or %s to %q",
+		szerror.ErrSzSdk, szerror.ErrSz, ErrDestructiveOperationBlocked,
+		operation, reason, ConfirmationEnvVar, identity,
+	)
+}
+
+/*
+List the data sources of the current configuration that are not in the new configuration.
+*/
+func removedDataSources(currentConfigDefinition string, newConfigDefinition string) ([]string, error) {
+	currentDocument, err := configdocument.Parse(currentConfigDefinition)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "current configuration")
+	}
+
+	newDocument, err := configdocument.Parse(newConfigDefinition)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "new configuration")
+	}
+
+	kept := map[string]bool{}
+	for _, dataSource := range newDocument.DataSources() {
+		kept[dataSource.DsrcCode] = true
+	}
+
+	result := []string{}
+
+	for _, dataSource := range currentDocument.DataSources() {
+		if !kept[dataSource.DsrcCode] {
+			result = append(result, dataSource.DsrcCode)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result, nil
+}
+
+/*
+Digest the "dataStores" list returned by SzDiagnostic.GetRepositoryInfo.
+Locations may hold credentials, so only a digest is used.
+*/
+func repositoryIdentity(repositoryInfoJSON string) (string, error) {
+	type dataStore struct {
+		ID       string `json:"id"`
+		Location string `json:"location"`
+		Type     string `json:"type"`
+	}
+
+	repositoryInfo := struct {
+		DataStores []dataStore `json:"dataStores"`
+	}{
+		DataStores: []dataStore{},
+	}
+
+	err := json.Unmarshal([]byte(repositoryInfoJSON), &repositoryInfo)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Unmarshal: GetRepositoryInfo")
+	}
+
+	if len(repositoryInfo.DataStores) == 0 {
+		return "", wraperror.Errorf(errForPackage, "GetRepositoryInfo reported no data stores")
+	}
+
+	slices.SortFunc(repositoryInfo.DataStores, func(first, second dataStore) int {
+		return strings.Compare(first.ID, second.ID)
+	})
+
+	digest := sha256.New()
+	for _, aDataStore := range repositoryInfo.DataStores {
+		fmt.Fprintf(digest, "%s\x00%s\x00%s\n", aDataStore.ID, aDataStore.Type, aDataStore.Location)
+	}
+
+	return hex.EncodeToString(digest.Sum(nil))[:identityLength], nil
+}
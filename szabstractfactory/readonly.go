@@ -0,0 +1,174 @@
+package szabstractfactory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+The read-only objects of a factory with ReadOnly set.
+Their mutating methods fail with an error wrapping ErrReadOnly without calling the server.
+*/
+type readOnlyConfigManager struct {
+	senzing.SzConfigManager
+}
+
+type readOnlyDiagnostic struct {
+	senzing.SzDiagnostic
+}
+
+type readOnlyEngine struct {
+	senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// readOnlyConfigManager methods
+// ----------------------------------------------------------------------------
+
+func (configManager *readOnlyConfigManager) RegisterConfig(
+	ctx context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	_ = ctx
+	_ = configDefinition
+	_ = configComment
+
+	return 0, readOnlyError("RegisterConfig")
+}
+
+func (configManager *readOnlyConfigManager) ReplaceDefaultConfigID(
+	ctx context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	_ = ctx
+	_ = currentDefaultConfigID
+	_ = newDefaultConfigID
+
+	return readOnlyError("ReplaceDefaultConfigID")
+}
+
+func (configManager *readOnlyConfigManager) SetDefaultConfig(
+	ctx context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	_ = ctx
+	_ = configDefinition
+	_ = configComment
+
+	return 0, readOnlyError("SetDefaultConfig")
+}
+
+func (configManager *readOnlyConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	_ = ctx
+	_ = configID
+
+	return readOnlyError("SetDefaultConfigID")
+}
+
+// ----------------------------------------------------------------------------
+// readOnlyDiagnostic methods
+// ----------------------------------------------------------------------------
+
+// CheckRepositoryPerformance inserts records to time the repository.
+func (diagnostic *readOnlyDiagnostic) CheckRepositoryPerformance(ctx context.Context, secondsToRun int) (string, error) {
+	_ = ctx
+	_ = secondsToRun
+
+	return "", readOnlyError("CheckRepositoryPerformance")
+}
+
+func (diagnostic *readOnlyDiagnostic) PurgeRepository(ctx context.Context) error {
+	_ = ctx
+
+	return readOnlyError("PurgeRepository")
+}
+
+// ----------------------------------------------------------------------------
+// readOnlyEngine methods
+// ----------------------------------------------------------------------------
+
+func (engine *readOnlyEngine) AddRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordID
+	_ = recordDefinition
+	_ = flags
+
+	return "", readOnlyError("AddRecord")
+}
+
+func (engine *readOnlyEngine) DeleteRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordID
+	_ = flags
+
+	return "", readOnlyError("DeleteRecord")
+}
+
+// GetRedoRecord removes the record it returns from the redo queue.
+func (engine *readOnlyEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return "", readOnlyError("GetRedoRecord")
+}
+
+func (engine *readOnlyEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	_ = ctx
+	_ = redoRecord
+	_ = flags
+
+	return "", readOnlyError("ProcessRedoRecord")
+}
+
+func (engine *readOnlyEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = entityID
+	_ = flags
+
+	return "", readOnlyError("ReevaluateEntity")
+}
+
+func (engine *readOnlyEngine) ReevaluateRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordID
+	_ = flags
+
+	return "", readOnlyError("ReevaluateRecord")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func readOnlyError(operation string) error {
+	return fmt.Errorf("%w%w%w: %s is not allowed on a read-only Szabstractfactory",
+		szerror.ErrSzSdk, szerror.ErrSz, ErrReadOnly, operation)
+}
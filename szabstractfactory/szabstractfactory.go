@@ -24,6 +24,16 @@ Szabstractfactory is an implementation of the [senzing.SzAbstractFactory] interf
 */
 type Szabstractfactory struct {
 	GrpcConnection *grpc.ClientConn
+
+	// Optional. If set, destructive operations of the objects created by the factory need confirmation.
+	Protection *Protection
+
+	// Optional. If true, mutating methods of the objects created by the factory fail with ErrReadOnly,
+	// without calling the server. ReadOnly takes precedence over Protection.
+	ReadOnly bool
+
+	capabilities capabilityRegistry
+	protection   protectionState
 }
 
 // ----------------------------------------------------------------------------
@@ -47,6 +57,7 @@ func (factory *Szabstractfactory) Close(ctx context.Context) error {
 /*
 Method CreateConfigManager returns an SzConfigManager object
 implemented to use the Senzing native C binary, libSz.so.
+If ReadOnly is true, its mutating methods fail; if Protection is set, its destructive methods need confirmation.
 
 Input
   - ctx: A context to control lifecycle.
@@ -63,12 +74,13 @@ func (factory *Szabstractfactory) CreateConfigManager(ctx context.Context) (senz
 		GrpcClientSzConfig: szconfigpb.NewSzConfigClient(factory.GrpcConnection),
 	}
 
-	return result, wraperror.Errorf(err, wraperror.NoMessage)
+	return factory.protectConfigManager(result), wraperror.Errorf(err, wraperror.NoMessage)
 }
 
 /*
 Method CreateDiagnostic returns an SzDiagnostic object
 implemented to use the Senzing native C binary, libSz.so.
+If ReadOnly is true, its mutating methods fail; if Protection is set, its destructive methods need confirmation.
 
 Input
   - ctx: A context to control lifecycle.
@@ -84,12 +96,13 @@ func (factory *Szabstractfactory) CreateDiagnostic(ctx context.Context) (senzing
 		GrpcClient: szdiagnosticpb.NewSzDiagnosticClient(factory.GrpcConnection),
 	}
 
-	return result, wraperror.Errorf(err, wraperror.NoMessage)
+	return factory.protectDiagnostic(result), wraperror.Errorf(err, wraperror.NoMessage)
 }
 
 /*
 Method CreateEngine returns an SzEngine object
 implemented to use the Senzing native C binary, libSz.so.
+If ReadOnly is true, its mutating methods fail; if Protection is set, its destructive methods need confirmation.
 
 Input
   - ctx: A context to control lifecycle.
@@ -105,7 +118,7 @@ func (factory *Szabstractfactory) CreateEngine(ctx context.Context) (senzing.SzE
 		GrpcClient: szenginepb.NewSzEngineClient(factory.GrpcConnection),
 	}
 
-	return result, wraperror.Errorf(err, wraperror.NoMessage)
+	return factory.protectEngine(result), wraperror.Errorf(err, wraperror.NoMessage)
 }
 
 /*
@@ -4,6 +4,8 @@ package szabstractfactory_test
 
 import (
 	"context"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
 )
 
 // ----------------------------------------------------------------------------
@@ -91,6 +93,45 @@ func ExampleSzabstractfactory_CreateProduct() {
 	// Output:
 }
 
+func ExampleSzabstractfactory_Supports() {
+	// For more information, visit
+	// https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/szabstractfactory/szabstractfactory_examples_test.go
+	ctx := context.TODO()
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		GrpcConnection: getGrpcConnection(ctx),
+	}
+
+	defer func() { handleError(szAbstractFactory.Close(ctx)) }()
+
+	isSupported, err := szAbstractFactory.Supports(ctx, szabstractfactory.CapabilityStreamExportJSONEntityReport)
+	if err != nil {
+		handleError(err)
+	}
+
+	_ = isSupported // Choose between StreamExportJsonEntityReport and ExportJsonEntityReport.
+	// Output:
+}
+
+func ExampleSzabstractfactory_RepositoryIdentity() {
+	// For more information, visit
+	// https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/szabstractfactory/szabstractfactory_examples_test.go
+	ctx := context.TODO()
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		GrpcConnection: getGrpcConnection(ctx),
+		Protection:     &szabstractfactory.Protection{}, //exhaustruct:ignore
+	}
+
+	defer func() { handleError(szAbstractFactory.Close(ctx)) }()
+
+	repositoryIdentity, err := szAbstractFactory.RepositoryIdentity(ctx)
+	if err != nil {
+		handleError(err)
+	}
+
+	_ = repositoryIdentity // Set as Protection.ConfirmationToken, or SENZING_TOOLS_CONFIRM_REPOSITORY, to allow PurgeRepository.
+	// Output:
+}
+
 func ExampleSzabstractfactory_Reinitialize() {
 	// For more information, visit
 	// https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/szabstractfactory/szabstractfactory_examples_test.go
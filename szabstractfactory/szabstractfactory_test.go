@@ -3,14 +3,26 @@ package szabstractfactory_test
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
+	"github.com/senzing-garage/sz-sdk-go-grpc/internal/bufconntest"
 	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	szconfigpb "github.com/senzing-garage/sz-sdk-proto/go/szconfig"
+	szconfigmanagerpb "github.com/senzing-garage/sz-sdk-proto/go/szconfigmanager"
+	szdiagnosticpb "github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -19,6 +31,9 @@ const (
 	printResults      = false
 )
 
+// A capability that depends on the Senzing version, registered by getCapabilityTestObject.
+const capabilityJSONParsingFailure szabstractfactory.Capability = "JSONParsingFailure"
+
 var (
 	grpcAddress    = "0.0.0.0:8261"
 	grpcConnection *grpc.ClientConn
@@ -114,10 +129,639 @@ func TestSzAbstractFactory_Reinitialize(test *testing.T) {
 	require.NoError(test, err)
 }
 
+// ----------------------------------------------------------------------------
+// Capabilities - test
+// ----------------------------------------------------------------------------
+
+func TestSzAbstractFactory_Supports(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.1.1"} //exhaustruct:ignore
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	testCases := []struct {
+		capability szabstractfactory.Capability
+		expected   bool
+	}{
+		{capability: capabilityJSONParsingFailure, expected: false},
+		{capability: szabstractfactory.CapabilityStreamExportCsvEntityReport, expected: true},
+		{capability: szabstractfactory.CapabilityStreamExportJSONEntityReport, expected: true},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := szAbstractFactory.Supports(ctx, testCase.capability)
+		printDebug(test, err, testCase.capability, actual)
+		require.NoError(test, err)
+		require.Equal(test, testCase.expected, actual, testCase.capability)
+	}
+
+	// The version is queried once.
+
+	_, err := szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	require.NoError(test, err)
+	require.Equal(test, int64(1), productServer.getVersionCalls.Load())
+}
+
+func TestSzAbstractFactory_Supports_newerVersion(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.2.0"} //exhaustruct:ignore
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	actual, err := szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.True(test, actual)
+}
+
+func TestSzAbstractFactory_Supports_slowVersion(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.2.0", release: make(chan struct{})} //exhaustruct:ignore
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	versionResult := make(chan bool)
+
+	go func() {
+		actual, err := szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+		assert.NoError(test, err)
+
+		versionResult <- actual
+	}()
+
+	require.Eventually(test, func() bool { return productServer.getVersionCalls.Load() == 1 },
+		time.Second, time.Millisecond)
+
+	// While the version is queried, other capabilities are answered.
+
+	szAbstractFactory.RegisterCapability(ctx, "Always", szabstractfactory.CapabilityRequirement{}) //exhaustruct:ignore
+
+	actual, err := szAbstractFactory.Supports(ctx, szabstractfactory.CapabilityStreamExportJSONEntityReport)
+	require.NoError(test, err)
+	require.True(test, actual)
+
+	close(productServer.release)
+	require.True(test, <-versionResult)
+}
+
+func TestSzAbstractFactory_RegisterCapability(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.1.1"} //exhaustruct:ignore
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	// SzDiagnostic is not served by the test server.
+
+	szAbstractFactory.RegisterCapability(ctx, "RepositoryInfo", szabstractfactory.CapabilityRequirement{
+		MinVersion: "4.0",
+		RPC:        szdiagnosticpb.SzDiagnostic_GetRepositoryInfo_FullMethodName,
+	})
+
+	actual, err := szAbstractFactory.Supports(ctx, "RepositoryInfo")
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.False(test, actual)
+
+	// A registered capability can be replaced.
+
+	szAbstractFactory.RegisterCapability(ctx, capabilityJSONParsingFailure,
+		szabstractfactory.CapabilityRequirement{MinVersion: "4.1", RPC: ""})
+
+	actual, err = szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	require.NoError(test, err)
+	require.True(test, actual)
+
+	// So can a built-in one.
+
+	szAbstractFactory.RegisterCapability(ctx, szabstractfactory.CapabilityStreamExportJSONEntityReport,
+		szabstractfactory.CapabilityRequirement{MinVersion: "4.2", RPC: ""})
+
+	actual, err = szAbstractFactory.Supports(ctx, szabstractfactory.CapabilityStreamExportJSONEntityReport)
+	require.NoError(test, err)
+	require.False(test, actual)
+}
+
+func TestSzAbstractFactory_Supports_unknown(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.1.1"} //exhaustruct:ignore
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	_, err := szAbstractFactory.Supports(ctx, "NoSuchCapability")
+	printDebug(test, err)
+	require.ErrorContains(test, err, "NoSuchCapability")
+}
+
+func TestSzAbstractFactory_Supports_versionError(test *testing.T) {
+	ctx := test.Context()
+	productServer := &fakeSzProductServer{version: "4.1.1"} //exhaustruct:ignore
+	productServer.failing.Store(true)
+	szAbstractFactory := getCapabilityTestObject(test, productServer)
+
+	_, err := szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	printDebug(test, err)
+	require.Error(test, err)
+
+	// Failures are not cached.
+
+	productServer.failing.Store(false)
+
+	actual, err := szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	require.NoError(test, err)
+	require.False(test, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Protection - test
+// ----------------------------------------------------------------------------
+
+func TestSzAbstractFactory_Protection_PurgeRepository(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	err = szDiagnostic.PurgeRepository(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szabstractfactory.ErrDestructiveOperationBlocked)
+	require.ErrorIs(test, err, szerror.ErrSzSdk)
+	require.ErrorContains(test, err, "PurgeRepository")
+	require.Zero(test, servers.diagnostic.purges.Load())
+
+	identity, err := szAbstractFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+	require.Len(test, identity, 16)
+	szAbstractFactory.Protection.ConfirmationToken = identity
+
+	err = szDiagnostic.PurgeRepository(ctx)
+	require.NoError(test, err)
+	require.Equal(test, int64(1), servers.diagnostic.purges.Load())
+}
+
+func TestSzAbstractFactory_Protection_PurgeRepository_blockedErrorNamesIdentity(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	identity, err := szAbstractFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+
+	err = szDiagnostic.PurgeRepository(ctx)
+	require.ErrorContains(test, err, identity)
+	require.ErrorContains(test, err, szabstractfactory.ConfirmationEnvVar)
+}
+
+func TestSzAbstractFactory_Protection_PurgeRepository_otherRepository(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	otherServers := newProtectionServers()
+	otherServers.diagnostic.repositoryInfo = `{"dataStores": [{"id": "CORE", "location": "/tmp/other/G2C.db", "type": "sqlite3"}]}`
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+	otherFactory := getProtectionTestObject(test, otherServers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	otherIdentity, err := otherFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+	szAbstractFactory.Protection.ConfirmationToken = otherIdentity
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	err = szDiagnostic.PurgeRepository(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szabstractfactory.ErrDestructiveOperationBlocked)
+	require.ErrorContains(test, err, "different repository")
+	require.Zero(test, servers.diagnostic.purges.Load())
+}
+
+func TestSzAbstractFactory_Protection_PurgeRepository_environment(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	identity, err := szAbstractFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+	test.Setenv(szabstractfactory.ConfirmationEnvVar, identity)
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szDiagnostic.PurgeRepository(ctx))
+	require.Equal(test, int64(1), servers.diagnostic.purges.Load())
+}
+
+func TestSzAbstractFactory_Protection_unprotected(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, nil)
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szDiagnostic.PurgeRepository(ctx))
+	require.Equal(test, int64(1), servers.diagnostic.purges.Load())
+}
+
+func TestSzAbstractFactory_Protection_DeleteRecord(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	protection := &szabstractfactory.Protection{MaxDeletes: 2} //exhaustruct:ignore
+	szAbstractFactory := getProtectionTestObject(test, servers, protection)
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	otherEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	// Deletes are counted across the factory's engines.
+
+	_, err = szEngine.DeleteRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = otherEngine.DeleteRecord(ctx, "TEST", "2", senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	_, err = szEngine.DeleteRecord(ctx, "TEST", "3", senzing.SzNoFlags)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szabstractfactory.ErrDestructiveOperationBlocked)
+	require.Equal(test, int64(2), servers.engine.deletes.Load())
+
+	protection.ConfirmationToken, err = szAbstractFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+
+	_, err = szEngine.DeleteRecord(ctx, "TEST", "3", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Equal(test, int64(3), servers.engine.deletes.Load())
+}
+
+func TestSzAbstractFactory_Protection_DeleteRecord_window(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	protection := &szabstractfactory.Protection{DeleteWindow: time.Millisecond, MaxDeletes: 1} //exhaustruct:ignore
+	szAbstractFactory := getProtectionTestObject(test, servers, protection)
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	for _, recordID := range []string{"1", "2"} {
+		_, err = szEngine.DeleteRecord(ctx, "TEST", recordID, senzing.SzNoFlags)
+		require.NoError(test, err)
+		time.Sleep(2 * time.Millisecond)
+	}
+}
+
+func TestSzAbstractFactory_Protection_SetDefaultConfigID(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+
+	// Adding a data source needs no confirmation.
+
+	err = szConfigManager.SetDefaultConfigID(ctx, 2)
+	require.NoError(test, err)
+	require.Equal(test, int64(2), servers.configManager.defaultConfigID.Load())
+
+	// Dropping one does.
+
+	err = szConfigManager.SetDefaultConfigID(ctx, 1)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szabstractfactory.ErrDestructiveOperationBlocked)
+	require.ErrorContains(test, err, "CUSTOMERS")
+	require.Equal(test, int64(2), servers.configManager.defaultConfigID.Load())
+
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, 2, 1)
+	require.ErrorIs(test, err, szabstractfactory.ErrDestructiveOperationBlocked)
+	require.Equal(test, int64(2), servers.configManager.defaultConfigID.Load())
+
+	szAbstractFactory.Protection.ConfirmationToken, err = szAbstractFactory.RepositoryIdentity(ctx)
+	require.NoError(test, err)
+
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, 2, 1)
+	require.NoError(test, err)
+	require.Equal(test, int64(1), servers.configManager.defaultConfigID.Load())
+}
+
+func TestSzAbstractFactory_RepositoryIdentity_doesNotBlockDeletes(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	servers.diagnostic.repositoryInfoReceived = make(chan struct{})
+	servers.diagnostic.repositoryInfoRelease = make(chan struct{})
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	identityErr := make(chan error, 1)
+
+	go func() {
+		_, err := szAbstractFactory.RepositoryIdentity(ctx)
+		identityErr <- err
+	}()
+
+	<-servers.diagnostic.repositoryInfoReceived
+
+	deleteErr := make(chan error, 1)
+
+	go func() {
+		_, err := szEngine.DeleteRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+		deleteErr <- err
+	}()
+
+	select {
+	case err = <-deleteErr:
+		require.NoError(test, err)
+	case <-time.After(time.Second):
+		test.Error("DeleteRecord waited for RepositoryIdentity")
+	}
+
+	close(servers.diagnostic.repositoryInfoRelease)
+	require.NoError(test, <-identityErr)
+}
+
+func TestSzAbstractFactory_RepositoryIdentity_error(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	servers.diagnostic.repositoryInfo = `{"dataStores": []}`
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+
+	_, err := szAbstractFactory.RepositoryIdentity(ctx)
+	printDebug(test, err)
+	require.Error(test, err)
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+	require.Error(test, szDiagnostic.PurgeRepository(ctx))
+	require.Zero(test, servers.diagnostic.purges.Load())
+}
+
+// ----------------------------------------------------------------------------
+// Read-only - test
+// ----------------------------------------------------------------------------
+
+func TestSzAbstractFactory_ReadOnly(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, nil)
+	szAbstractFactory.ReadOnly = true
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	mutations := map[string]func() error{
+		"AddRecord": func() error {
+			_, err := szEngine.AddRecord(ctx, "TEST", "1", `{}`, senzing.SzNoFlags)
+
+			return err
+		},
+		"CheckRepositoryPerformance": func() error {
+			_, err := szDiagnostic.CheckRepositoryPerformance(ctx, 1)
+
+			return err
+		},
+		"DeleteRecord": func() error {
+			_, err := szEngine.DeleteRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+
+			return err
+		},
+		"GetRedoRecord": func() error {
+			_, err := szEngine.GetRedoRecord(ctx)
+
+			return err
+		},
+		"ProcessRedoRecord": func() error {
+			_, err := szEngine.ProcessRedoRecord(ctx, `{}`, senzing.SzNoFlags)
+
+			return err
+		},
+		"PurgeRepository": func() error { return szDiagnostic.PurgeRepository(ctx) },
+		"ReevaluateEntity": func() error {
+			_, err := szEngine.ReevaluateEntity(ctx, 1, senzing.SzNoFlags)
+
+			return err
+		},
+		"ReevaluateRecord": func() error {
+			_, err := szEngine.ReevaluateRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+
+			return err
+		},
+		"RegisterConfig": func() error {
+			_, err := szConfigManager.RegisterConfig(ctx, protectionConfigWithCustomers, "comment")
+
+			return err
+		},
+		"ReplaceDefaultConfigID": func() error { return szConfigManager.ReplaceDefaultConfigID(ctx, 1, 2) },
+		"SetDefaultConfig": func() error {
+			_, err := szConfigManager.SetDefaultConfig(ctx, protectionConfigWithCustomers, "comment")
+
+			return err
+		},
+		"SetDefaultConfigID": func() error { return szConfigManager.SetDefaultConfigID(ctx, 2) },
+	}
+
+	for operation, mutation := range mutations {
+		err := mutation()
+		printDebug(test, err)
+		require.ErrorIs(test, err, szabstractfactory.ErrReadOnly, operation)
+		require.ErrorIs(test, err, szerror.ErrSzSdk, operation)
+		require.ErrorContains(test, err, operation)
+	}
+
+	require.Equal(test, int64(1), servers.configManager.defaultConfigID.Load())
+	require.Zero(test, servers.diagnostic.purges.Load())
+	require.Zero(test, servers.engine.deletes.Load())
+
+	// Reads still reach the server.
+
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, int64(1), configID)
+
+	repositoryInfo, err := szDiagnostic.GetRepositoryInfo(ctx)
+	require.NoError(test, err)
+	require.JSONEq(test, protectionRepositoryInfo, repositoryInfo)
+}
+
+func TestSzAbstractFactory_ReadOnly_overridesProtection(test *testing.T) {
+	ctx := test.Context()
+	servers := newProtectionServers()
+	szAbstractFactory := getProtectionTestObject(test, servers, &szabstractfactory.Protection{}) //exhaustruct:ignore
+	szAbstractFactory.ReadOnly = true
+	szAbstractFactory.Protection.ConfirmationToken, _ = szAbstractFactory.RepositoryIdentity(ctx)
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	err = szDiagnostic.PurgeRepository(ctx)
+	require.ErrorIs(test, err, szabstractfactory.ErrReadOnly)
+	require.Zero(test, servers.diagnostic.purges.Load())
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
 
+const (
+	protectionConfigWithCustomers = `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST"},` +
+		` {"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"}]}}`
+	protectionConfigWithoutCustomers = `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST"}]}}`
+	protectionRepositoryInfo         = `{"dataStores": [{"id": "CORE", "location": "/tmp/sqlite/G2C.db", "type": "sqlite3"}]}`
+)
+
+type fakeSzConfigServer struct {
+	szconfigpb.UnimplementedSzConfigServer
+}
+
+func (server *fakeSzConfigServer) VerifyConfig(
+	_ context.Context,
+	_ *szconfigpb.VerifyConfigRequest,
+) (*szconfigpb.VerifyConfigResponse, error) {
+	return &szconfigpb.VerifyConfigResponse{Result: true}, nil
+}
+
+/*
+Config 1 has the TEST data source; config 2 adds CUSTOMERS.
+*/
+type fakeSzConfigManagerServer struct {
+	szconfigmanagerpb.UnimplementedSzConfigManagerServer
+
+	defaultConfigID atomic.Int64
+}
+
+func (server *fakeSzConfigManagerServer) GetConfig(
+	_ context.Context,
+	request *szconfigmanagerpb.GetConfigRequest,
+) (*szconfigmanagerpb.GetConfigResponse, error) {
+	if request.GetConfigId() == 2 {
+		return &szconfigmanagerpb.GetConfigResponse{Result: protectionConfigWithCustomers}, nil
+	}
+
+	return &szconfigmanagerpb.GetConfigResponse{Result: protectionConfigWithoutCustomers}, nil
+}
+
+func (server *fakeSzConfigManagerServer) GetDefaultConfigId(
+	_ context.Context,
+	_ *szconfigmanagerpb.GetDefaultConfigIdRequest,
+) (*szconfigmanagerpb.GetDefaultConfigIdResponse, error) {
+	return &szconfigmanagerpb.GetDefaultConfigIdResponse{Result: server.defaultConfigID.Load()}, nil
+}
+
+func (server *fakeSzConfigManagerServer) ReplaceDefaultConfigId(
+	_ context.Context,
+	request *szconfigmanagerpb.ReplaceDefaultConfigIdRequest,
+) (*szconfigmanagerpb.ReplaceDefaultConfigIdResponse, error) {
+	server.defaultConfigID.Store(request.GetNewDefaultConfigId())
+
+	return &szconfigmanagerpb.ReplaceDefaultConfigIdResponse{}, nil
+}
+
+func (server *fakeSzConfigManagerServer) SetDefaultConfigId(
+	_ context.Context,
+	request *szconfigmanagerpb.SetDefaultConfigIdRequest,
+) (*szconfigmanagerpb.SetDefaultConfigIdResponse, error) {
+	server.defaultConfigID.Store(request.GetConfigId())
+
+	return &szconfigmanagerpb.SetDefaultConfigIdResponse{}, nil
+}
+
+type fakeSzDiagnosticServer struct {
+	szdiagnosticpb.UnimplementedSzDiagnosticServer
+
+	purges                 atomic.Int64
+	repositoryInfo         string
+	repositoryInfoReceived chan struct{} // If not nil, closed when GetRepositoryInfo is called.
+	repositoryInfoRelease  chan struct{} // If not nil, GetRepositoryInfo waits for it to be closed.
+}
+
+func (server *fakeSzDiagnosticServer) GetRepositoryInfo(
+	_ context.Context,
+	_ *szdiagnosticpb.GetRepositoryInfoRequest,
+) (*szdiagnosticpb.GetRepositoryInfoResponse, error) {
+	if server.repositoryInfoReceived != nil {
+		close(server.repositoryInfoReceived)
+		<-server.repositoryInfoRelease
+	}
+
+	return &szdiagnosticpb.GetRepositoryInfoResponse{Result: server.repositoryInfo}, nil
+}
+
+func (server *fakeSzDiagnosticServer) PurgeRepository(
+	_ context.Context,
+	_ *szdiagnosticpb.PurgeRepositoryRequest,
+) (*szdiagnosticpb.PurgeRepositoryResponse, error) {
+	server.purges.Add(1)
+
+	return &szdiagnosticpb.PurgeRepositoryResponse{}, nil
+}
+
+type fakeSzEngineServer struct {
+	szenginepb.UnimplementedSzEngineServer
+
+	deletes atomic.Int64
+}
+
+func (server *fakeSzEngineServer) DeleteRecord(
+	_ context.Context,
+	_ *szenginepb.DeleteRecordRequest,
+) (*szenginepb.DeleteRecordResponse, error) {
+	server.deletes.Add(1)
+
+	return &szenginepb.DeleteRecordResponse{}, nil
+}
+
+type protectionServers struct {
+	config        *fakeSzConfigServer
+	configManager *fakeSzConfigManagerServer
+	diagnostic    *fakeSzDiagnosticServer
+	engine        *fakeSzEngineServer
+}
+
+type fakeSzProductServer struct {
+	szproductpb.UnimplementedSzProductServer
+
+	failing         atomic.Bool
+	getVersionCalls atomic.Int64
+	release         chan struct{} // If not nil, GetVersion waits for it to be closed.
+	version         string
+}
+
+func (server *fakeSzProductServer) GetVersion(
+	_ context.Context,
+	_ *szproductpb.GetVersionRequest,
+) (*szproductpb.GetVersionResponse, error) {
+	server.getVersionCalls.Add(1)
+
+	if server.release != nil {
+		<-server.release
+	}
+
+	if server.failing.Load() {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+
+	return &szproductpb.GetVersionResponse{Result: fmt.Sprintf(`{"VERSION": %q}`, server.version)}, nil
+}
+
+/*
+Serve SzEngine and SzProduct, but not SzDiagnostic, over an in-memory connection.
+*/
+func getCapabilityTestObject(t *testing.T, productServer *fakeSzProductServer) *szabstractfactory.Szabstractfactory {
+	t.Helper()
+
+	connection := bufconntest.Connection(t, func(server *grpc.Server) {
+		szenginepb.RegisterSzEngineServer(server, &fakeSzEngineServer{}) //exhaustruct:ignore
+		szproductpb.RegisterSzProductServer(server, productServer)
+	})
+
+	result := &szabstractfactory.Szabstractfactory{GrpcConnection: connection} //exhaustruct:ignore
+	result.RegisterCapability(t.Context(), capabilityJSONParsingFailure,
+		szabstractfactory.CapabilityRequirement{MinVersion: "4.2", RPC: ""})
+
+	return result
+}
+
 func getGrpcConnection(ctx context.Context) *grpc.ClientConn {
 	if grpcConnection == nil {
 		transportCredentials, err := helper.GetGrpcTransportCredentials(ctx)
@@ -134,6 +778,26 @@ func getGrpcConnection(ctx context.Context) *grpc.ClientConn {
 	return grpcConnection
 }
 
+/*
+Serve SzConfig, SzConfigManager, SzDiagnostic and SzEngine over an in-memory connection.
+*/
+func getProtectionTestObject(
+	t *testing.T,
+	servers *protectionServers,
+	protection *szabstractfactory.Protection,
+) *szabstractfactory.Szabstractfactory {
+	t.Helper()
+
+	connection := bufconntest.Connection(t, func(server *grpc.Server) {
+		szconfigpb.RegisterSzConfigServer(server, servers.config)
+		szconfigmanagerpb.RegisterSzConfigManagerServer(server, servers.configManager)
+		szdiagnosticpb.RegisterSzDiagnosticServer(server, servers.diagnostic)
+		szenginepb.RegisterSzEngineServer(server, servers.engine)
+	})
+
+	return &szabstractfactory.Szabstractfactory{GrpcConnection: connection, Protection: protection} //exhaustruct:ignore
+}
+
 func getSzAbstractFactory(ctx context.Context) senzing.SzAbstractFactory {
 	_ = ctx
 	result := &szabstractfactory.Szabstractfactory{
@@ -150,6 +814,18 @@ func getTestObject(t *testing.T) senzing.SzAbstractFactory {
 	return getSzAbstractFactory(ctx)
 }
 
+func newProtectionServers() *protectionServers {
+	result := &protectionServers{
+		config:        &fakeSzConfigServer{},                                             //exhaustruct:ignore
+		configManager: &fakeSzConfigManagerServer{},                                      //exhaustruct:ignore
+		diagnostic:    &fakeSzDiagnosticServer{repositoryInfo: protectionRepositoryInfo}, //exhaustruct:ignore
+		engine:        &fakeSzEngineServer{},                                             //exhaustruct:ignore
+	}
+	result.configManager.defaultConfigID.Store(1)
+
+	return result
+}
+
 func handleError(err error) {
 	if err != nil {
 		outputln("Error:", err)
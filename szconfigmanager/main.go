@@ -1,5 +1,9 @@
 package szconfigmanager
 
+import (
+	"errors"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
@@ -9,3 +13,9 @@ ComponentID is the identifier of the szconfigmanager package.
 Package szconfigmanager messages will have the format "SZSDK6022eeee" where "eeee" is the error identifier.
 */
 const ComponentID = 6022
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("szconfigmanager")
@@ -0,0 +1,284 @@
+package szconfigmanager
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/sz-sdk-go-grpc/szconfig"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+ConfigHistoryEntry is a registered configuration, as listed by GetConfigHistory.
+*/
+type ConfigHistoryEntry struct {
+	ConfigComment string
+	ConfigID      int64
+	CreatedAt     time.Time
+	IsDefault     bool
+}
+
+type promotion struct {
+	configID         int64
+	previousConfigID int64
+}
+
+type configRegistry struct {
+	Configs []struct {
+		ConfigComments string `json:"CONFIG_COMMENTS"`
+		ConfigID       int64  `json:"CONFIG_ID"`
+		SysCreateDt    string `json:"SYS_CREATE_DT"`
+	} `json:"CONFIGS"`
+}
+
+// ----------------------------------------------------------------------------
+// Public non-interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method GetConfigHistory lists the registered configurations, oldest first,
+and marks the current default configuration.
+SYS_CREATE_DT has a resolution of one second; the order of configurations registered in the same second
+is not defined.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The registered configurations, by registration time.
+*/
+func (client *Szconfigmanager) GetConfigHistory(ctx context.Context) ([]ConfigHistoryEntry, error) {
+	var (
+		err    error
+		result []ConfigHistoryEntry
+	)
+
+	result, err = client.getConfigHistoryChoreography(ctx)
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8012, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method RollbackDefaultConfig undoes the last change of the default configuration made by this client.
+
+The registry does not record which configurations were made the default, so only changes made
+with ReplaceDefaultConfigID and RollbackDefaultConfigTo on this client, since it was created, can be undone;
+each call undoes one more of them.
+Configurations that were registered but never made the default are never rolled back to.
+If no change was recorded, it fails; use RollbackDefaultConfigTo with an explicit configuration ID instead.
+If the default configuration was changed elsewhere since the last recorded change, it fails with
+szerror.ErrSzReplaceConflict.
+See RollbackDefaultConfigTo for how the change is made.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The new default configuration ID.
+*/
+func (client *Szconfigmanager) RollbackDefaultConfig(ctx context.Context) (int64, error) {
+	var (
+		err    error
+		result int64
+	)
+
+	result, err = client.rollbackDefaultConfigChoreography(ctx)
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"newDefaultConfigID": strconv.FormatInt(result, baseTen),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8013, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method RollbackDefaultConfigTo makes a registered configuration the default configuration.
+
+The configuration is checked with VerifyConfigDefinition before it is made the default.
+The change is made with ReplaceDefaultConfigID, so it fails with szerror.ErrSzReplaceConflict
+if the default configuration changes while the rollback is in progress.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The Senzing configuration JSON document identifier to use as the default.
+*/
+func (client *Szconfigmanager) RollbackDefaultConfigTo(ctx context.Context, configID int64) error {
+	var err error
+
+	err = client.rollbackDefaultConfigToChoreography(ctx, configID)
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"newDefaultConfigID": strconv.FormatInt(configID, baseTen),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8014, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (client *Szconfigmanager) getConfigHistoryChoreography(ctx context.Context) ([]ConfigHistoryEntry, error) {
+	registryJSON, err := client.getConfigRegistry(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "getConfigRegistry")
+	}
+
+	defaultConfigID, err := client.getDefaultConfigID(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "getDefaultConfigID")
+	}
+
+	registry := configRegistry{} //exhaustruct:ignore
+
+	err = json.Unmarshal([]byte(registryJSON), &registry)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	result := make([]ConfigHistoryEntry, 0, len(registry.Configs))
+
+	for _, config := range registry.Configs {
+		createdAt, err := time.Parse(time.RFC3339, config.SysCreateDt)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "config %d: SYS_CREATE_DT", config.ConfigID)
+		}
+
+		result = append(result, ConfigHistoryEntry{
+			ConfigComment: config.ConfigComments,
+			ConfigID:      config.ConfigID,
+			CreatedAt:     createdAt,
+			IsDefault:     config.ConfigID == defaultConfigID,
+		})
+	}
+
+	slices.SortFunc(result, func(a, b ConfigHistoryEntry) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return result, nil
+}
+
+func (client *Szconfigmanager) rollbackDefaultConfigChoreography(ctx context.Context) (int64, error) {
+	last, isOK := client.lastPromotion()
+	if !isOK {
+		return 0, wraperror.Errorf(errForPackage,
+			"no default configuration change recorded by this client; use RollbackDefaultConfigTo")
+	}
+
+	err := client.replaceDefaultConfigIDVerified(ctx, last.configID, last.previousConfigID)
+	if err != nil {
+		return 0, err
+	}
+
+	client.removePromotion(last)
+
+	return last.previousConfigID, nil
+}
+
+func (client *Szconfigmanager) rollbackDefaultConfigToChoreography(ctx context.Context, configID int64) error {
+	defaultConfigID, err := client.getDefaultConfigID(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getDefaultConfigID")
+	}
+
+	if defaultConfigID == configID {
+		return nil
+	}
+
+	err = client.replaceDefaultConfigIDVerified(ctx, defaultConfigID, configID)
+	if err != nil {
+		return err
+	}
+
+	client.pushPromotion(defaultConfigID, configID)
+
+	return nil
+}
+
+func (client *Szconfigmanager) lastPromotion() (promotion, bool) {
+	client.promotionsMutex.Lock()
+	defer client.promotionsMutex.Unlock()
+
+	if len(client.promotions) == 0 {
+		return promotion{}, false
+	}
+
+	return client.promotions[len(client.promotions)-1], true
+}
+
+func (client *Szconfigmanager) pushPromotion(previousConfigID int64, configID int64) {
+	if previousConfigID == configID {
+		return
+	}
+
+	client.promotionsMutex.Lock()
+	defer client.promotionsMutex.Unlock()
+
+	client.promotions = append(client.promotions, promotion{
+		configID:         configID,
+		previousConfigID: previousConfigID,
+	})
+}
+
+func (client *Szconfigmanager) removePromotion(target promotion) {
+	client.promotionsMutex.Lock()
+	defer client.promotionsMutex.Unlock()
+
+	for index := len(client.promotions) - 1; index >= 0; index-- {
+		if client.promotions[index] == target {
+			client.promotions = slices.Delete(client.promotions, index, index+1)
+
+			return
+		}
+	}
+}
+
+func (client *Szconfigmanager) replaceDefaultConfigIDVerified(
+	ctx context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	configDefinition, err := client.getConfig(ctx, newDefaultConfigID)
+	if err != nil {
+		return wraperror.Errorf(err, "getConfig")
+	}
+
+	szConfig := &szconfig.Szconfig{
+		GrpcClient: client.GrpcClientSzConfig,
+	}
+
+	err = szConfig.VerifyConfigDefinition(ctx, configDefinition)
+	if err != nil {
+		return wraperror.Errorf(err, "VerifyConfigDefinition")
+	}
+
+	err = client.replaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+
+	return wraperror.Errorf(err, "replaceDefaultConfigID")
+}
@@ -6,6 +6,7 @@ package szconfigmanager
 import (
 	"context"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/senzing-garage/go-helpers/wraperror"
@@ -26,10 +27,12 @@ type Szconfigmanager struct {
 	GrpcClient         szpb.SzConfigManagerClient
 	GrpcClientSzConfig szconfigpb.SzConfigClient
 
-	isTrace        bool
-	logger         logging.Logging
-	observerOrigin string
-	observers      subject.Subject
+	isTrace         bool
+	logger          logging.Logging
+	observerOrigin  string
+	observers       subject.Subject
+	promotions      []promotion // Default configuration changes made by this client, oldest first.
+	promotionsMutex sync.Mutex
 }
 
 const (
@@ -316,6 +319,7 @@ Method ReplaceDefaultConfigID replaces the existing default configuration ID wit
 The change is prevented if the current default configuration ID value is not as expected.
 
 Use this in place of setDefaultConfigID() to handle race conditions.
+The change is recorded so that RollbackDefaultConfig can undo it.
 
 Input
   - ctx: A context to control lifecycle.
@@ -338,6 +342,9 @@ func (client *Szconfigmanager) ReplaceDefaultConfigID(
 	}
 
 	err = client.replaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+	if err == nil {
+		client.pushPromotion(currentDefaultConfigID, newDefaultConfigID)
+	}
 
 	if client.observers != nil {
 		go func() {
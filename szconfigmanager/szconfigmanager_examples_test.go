@@ -293,6 +293,49 @@ func ExampleSzconfigmanager_SetDefaultConfigID() {
 	// Output:
 }
 
+// ----------------------------------------------------------------------------
+// Configuration history and rollback - Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSzconfigmanager_GetConfigHistory() {
+	// For more information, visit
+	// https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/szconfigmanager/szconfigmanager_examples_test.go
+	ctx := context.TODO()
+	szConfigManager := getSzConfigManager(ctx)
+
+	history, err := szConfigManager.GetConfigHistory(ctx)
+	if err != nil {
+		handleError(err)
+	}
+
+	for _, entry := range history {
+		if entry.IsDefault {
+			fmt.Println(entry.ConfigID > 0)
+		}
+	}
+	// Output: true
+}
+
+func ExampleSzconfigmanager_RollbackDefaultConfigTo() {
+	// For more information, visit
+	// https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/szconfigmanager/szconfigmanager_examples_test.go
+	ctx := context.TODO()
+	szConfigManager := getSzConfigManager(ctx)
+
+	currentDefaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		handleError(err)
+	}
+
+	// Roll back to a known-good configuration, for example one listed by GetConfigHistory.
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, currentDefaultConfigID)
+	if err != nil {
+		handleError(err)
+	}
+	// Output:
+}
+
 // ----------------------------------------------------------------------------
 // Logging and observing
 // ----------------------------------------------------------------------------
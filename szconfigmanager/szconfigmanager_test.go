@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -352,6 +355,189 @@ func TestSzconfigmanager_SetDefaultConfigID_nilConfigID(test *testing.T) {
 	require.JSONEq(test, expectedErr, err.Error())
 }
 
+// ----------------------------------------------------------------------------
+// Configuration history and rollback
+// ----------------------------------------------------------------------------
+
+func TestSzconfigmanager_GetConfigHistory(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := getTestObject(test)
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	actual, err := szConfigManager.GetConfigHistory(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual)
+
+	defaults := 0
+
+	for index, entry := range actual {
+		if entry.IsDefault {
+			defaults++
+
+			require.Equal(test, defaultConfigID, entry.ConfigID)
+		}
+
+		if index > 0 {
+			require.False(test, entry.CreatedAt.Before(actual[index-1].CreatedAt))
+		}
+	}
+
+	require.Equal(test, 1, defaults)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfig(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := getTestObject(test)
+	startingConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	originalConfigID := registerTestConfig(test, szConfigManager, startingConfigID)
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, startingConfigID, originalConfigID)
+	require.NoError(test, err)
+
+	newConfigID := registerTestConfig(test, szConfigManager, originalConfigID)
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, originalConfigID, newConfigID)
+	require.NoError(test, err)
+
+	actual, err := szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, originalConfigID, actual)
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, originalConfigID, defaultConfigID)
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, startingConfigID)
+	require.NoError(test, err)
+}
+
+func TestSzconfigmanager_GetConfigHistory_order(test *testing.T) {
+	ctx := test.Context()
+	grpcClient := newFakeGrpcClient(1002)
+	grpcClient.addConfig(1003, "2026-01-02T00:00:00Z")
+	grpcClient.addConfig(1001, "2026-01-01T00:00:00Z")
+	grpcClient.addConfig(1002, "2026-01-01T00:00:01Z")
+	szConfigManager := newFakeSzConfigManager(grpcClient)
+
+	actual, err := szConfigManager.GetConfigHistory(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Len(test, actual, 3)
+	require.Equal(test, []int64{1001, 1002, 1003}, historyConfigIDs(actual))
+	require.True(test, actual[1].IsDefault)
+	require.False(test, actual[2].IsDefault)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfig_recordedChanges(test *testing.T) {
+	ctx := test.Context()
+	grpcClient := newFakeGrpcClient(1001)
+	grpcClient.addConfig(1001, "2026-01-01T00:00:00Z")
+	grpcClient.addConfig(1002, "2026-01-02T00:00:00Z")
+	grpcClient.addConfig(1003, "2026-01-03T00:00:00Z")
+	szConfigManager := newFakeSzConfigManager(grpcClient)
+
+	err := szConfigManager.ReplaceDefaultConfigID(ctx, 1001, 1003)
+	require.NoError(test, err)
+
+	// 1004 is registered, but never made the default.
+
+	grpcClient.addConfig(1004, "2026-01-04T00:00:00Z")
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, 1002)
+	require.NoError(test, err)
+
+	actual, err := szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, int64(1003), actual)
+	require.Equal(test, int64(1003), grpcClient.defaultConfigID)
+
+	actual, err = szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, int64(1001), actual)
+	require.Equal(test, int64(1001), grpcClient.defaultConfigID)
+
+	_, err = szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "use RollbackDefaultConfigTo")
+	require.Equal(test, int64(1001), grpcClient.defaultConfigID)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfig_noRecordedChange(test *testing.T) {
+	ctx := test.Context()
+	grpcClient := newFakeGrpcClient(1002)
+	grpcClient.addConfig(1001, "2026-01-01T00:00:00Z")
+	grpcClient.addConfig(1002, "2026-01-02T00:00:00Z")
+	szConfigManager := newFakeSzConfigManager(grpcClient)
+
+	_, err := szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err)
+	require.ErrorContains(test, err, "use RollbackDefaultConfigTo")
+	require.Equal(test, int64(1002), grpcClient.defaultConfigID)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfig_changedElsewhere(test *testing.T) {
+	ctx := test.Context()
+	grpcClient := newFakeGrpcClient(1001)
+	grpcClient.addConfig(1001, "2026-01-01T00:00:00Z")
+	grpcClient.addConfig(1002, "2026-01-02T00:00:00Z")
+	grpcClient.addConfig(1003, "2026-01-03T00:00:00Z")
+	szConfigManager := newFakeSzConfigManager(grpcClient)
+
+	err := szConfigManager.ReplaceDefaultConfigID(ctx, 1001, 1002)
+	require.NoError(test, err)
+
+	grpcClient.defaultConfigID = 1003
+
+	_, err = szConfigManager.RollbackDefaultConfig(ctx)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+	require.Equal(test, int64(1003), grpcClient.defaultConfigID)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfigTo(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := getTestObject(test)
+	originalConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	newConfigID := registerTestConfig(test, szConfigManager, originalConfigID)
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, originalConfigID, newConfigID)
+	require.NoError(test, err)
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, originalConfigID)
+	printDebug(test, err)
+	require.NoError(test, err)
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, originalConfigID, defaultConfigID)
+
+	// Rolling back to the default configuration changes nothing.
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, originalConfigID)
+	require.NoError(test, err)
+}
+
+func TestSzconfigmanager_RollbackDefaultConfigTo_badConfigID(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := getTestObject(test)
+	originalConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	err = szConfigManager.RollbackDefaultConfigTo(ctx, badConfigID)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, originalConfigID, defaultConfigID)
+}
+
 // ----------------------------------------------------------------------------
 // Logging and observing
 // ----------------------------------------------------------------------------
@@ -532,6 +718,114 @@ func getTestObject(t *testing.T) *szconfigmanager.Szconfigmanager {
 	return getSzConfigManager(t.Context())
 }
 
+func registerTestConfig(t *testing.T, szConfigManager *szconfigmanager.Szconfigmanager, configID int64) int64 {
+	t.Helper()
+
+	ctx := t.Context()
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	require.NoError(t, err)
+
+	dataSourceCode := "GO_TEST_" + strconv.FormatInt(time.Now().UnixNano(), baseTen)
+	_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+	require.NoError(t, err)
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(t, err)
+	result, err := szConfigManager.RegisterConfig(ctx, configDefinition, "Added "+dataSourceCode)
+	require.NoError(t, err)
+
+	return result
+}
+
+func historyConfigIDs(history []szconfigmanager.ConfigHistoryEntry) []int64 {
+	result := make([]int64, 0, len(history))
+	for _, entry := range history {
+		result = append(result, entry.ConfigID)
+	}
+
+	return result
+}
+
+func newFakeSzConfigManager(grpcClient *fakeGrpcClient) *szconfigmanager.Szconfigmanager {
+	return &szconfigmanager.Szconfigmanager{ //exhaustruct:ignore
+		GrpcClient:         grpcClient,
+		GrpcClientSzConfig: &fakeGrpcClientSzConfig{}, //exhaustruct:ignore
+	}
+}
+
+type fakeGrpcClient struct {
+	szpb.SzConfigManagerClient
+
+	defaultConfigID int64
+	registry        []string
+}
+
+func newFakeGrpcClient(defaultConfigID int64) *fakeGrpcClient {
+	return &fakeGrpcClient{ //exhaustruct:ignore
+		defaultConfigID: defaultConfigID,
+	}
+}
+
+func (client *fakeGrpcClient) addConfig(configID int64, sysCreateDt string) {
+	client.registry = append(client.registry, fmt.Sprintf(
+		`{"CONFIG_COMMENTS":"","CONFIG_ID":%d,"SYS_CREATE_DT":%q}`,
+		configID,
+		sysCreateDt,
+	))
+}
+
+func (client *fakeGrpcClient) GetConfig(
+	_ context.Context,
+	_ *szpb.GetConfigRequest,
+	_ ...grpc.CallOption,
+) (*szpb.GetConfigResponse, error) {
+	return &szpb.GetConfigResponse{Result: `{"G2_CONFIG":{}}`}, nil //exhaustruct:ignore
+}
+
+func (client *fakeGrpcClient) GetConfigRegistry(
+	_ context.Context,
+	_ *szpb.GetConfigRegistryRequest,
+	_ ...grpc.CallOption,
+) (*szpb.GetConfigRegistryResponse, error) {
+	result := `{"CONFIGS":[` + strings.Join(client.registry, ",") + "]}"
+
+	return &szpb.GetConfigRegistryResponse{Result: result}, nil //exhaustruct:ignore
+}
+
+func (client *fakeGrpcClient) GetDefaultConfigId(
+	_ context.Context,
+	_ *szpb.GetDefaultConfigIdRequest,
+	_ ...grpc.CallOption,
+) (*szpb.GetDefaultConfigIdResponse, error) {
+	return &szpb.GetDefaultConfigIdResponse{Result: client.defaultConfigID}, nil //exhaustruct:ignore
+}
+
+func (client *fakeGrpcClient) ReplaceDefaultConfigId(
+	_ context.Context,
+	request *szpb.ReplaceDefaultConfigIdRequest,
+	_ ...grpc.CallOption,
+) (*szpb.ReplaceDefaultConfigIdResponse, error) {
+	if request.GetCurrentDefaultConfigId() != client.defaultConfigID {
+		return nil, status.Error(codes.Unknown,
+			`{"reason":"SENZ7245|Current configuration ID does not match specified data ID"}`)
+	}
+
+	client.defaultConfigID = request.GetNewDefaultConfigId()
+
+	return &szpb.ReplaceDefaultConfigIdResponse{}, nil //exhaustruct:ignore
+}
+
+type fakeGrpcClientSzConfig struct {
+	szconfigpb.SzConfigClient
+}
+
+func (client *fakeGrpcClientSzConfig) VerifyConfig(
+	_ context.Context,
+	_ *szconfigpb.VerifyConfigRequest,
+	_ ...grpc.CallOption,
+) (*szconfigpb.VerifyConfigResponse, error) {
+	return &szconfigpb.VerifyConfigResponse{}, nil //exhaustruct:ignore
+}
+
 func handleError(err error) {
 	if err != nil {
 		outputln("Error:", err)
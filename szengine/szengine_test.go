@@ -16,6 +16,7 @@ import (
 	"github.com/senzing-garage/go-helpers/testfixtures"
 	"github.com/senzing-garage/go-helpers/truthset"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-grpc/conformance"
 	"github.com/senzing-garage/sz-sdk-go-grpc/getversion"
 	"github.com/senzing-garage/sz-sdk-go-grpc/helper"
 	"github.com/senzing-garage/sz-sdk-go-grpc/szabstractfactory"
@@ -86,6 +87,9 @@ var (
 	nilSearchProfile       = nilSemaphoreString
 )
 
+// Bad JSON record definitions are reported as "SENZ3121|JSON Parsing Failure" rather than "SENZ0002|Invalid Message".
+const capabilityJSONParsingFailure szabstractfactory.Capability = "JSONParsingFailure"
+
 var (
 	defaultConfigID   int64
 	grpcAddress       = "0.0.0.0:8261"
@@ -95,10 +99,11 @@ var (
 		ID:       "Observer 1",
 		IsSilent: true,
 	}
-	senzingVersion           = 0
-	szConfigManagerSingleton *szconfigmanager.Szconfigmanager
-	szDiagnosticSingleton    *szdiagnostic.Szdiagnostic
-	szEngineSingleton        *szengine.Szengine
+	senzingVersion             = 0
+	supportsJSONParsingFailure bool
+	szConfigManagerSingleton   *szconfigmanager.Szconfigmanager
+	szDiagnosticSingleton      *szdiagnostic.Szdiagnostic
+	szEngineSingleton          *szengine.Szengine
 )
 
 type GetEntityByRecordIDResponse struct {
@@ -115,6 +120,18 @@ func TestSzEngine_PrintSenzingVersion(test *testing.T) {
 	test.Logf("Detected Senzing version: %d", senzingVersion)
 }
 
+/*
+The conformance suite checks the behavior of each method; the tests below check what is particular to this client,
+such as its error messages and the nil and empty parameters it accepts.
+*/
+func TestSzEngine_Conformance(test *testing.T) {
+	ctx := test.Context()
+	suite := &conformance.Suite{
+		Factory: getSzAbstractFactory(ctx),
+	} //exhaustruct:ignore
+	suite.Run(test)
+}
+
 func TestSzEngine_AddRecord(test *testing.T) {
 	ctx := test.Context()
 	testCases := getTestCasesForAddRecord()
@@ -155,8 +172,9 @@ func TestSzEngine_AddRecord(test *testing.T) {
 
 func TestSzEngine_CloseExportReport(test *testing.T) {
 	// Tested in:
+	//  - TestSzEngine_Conformance
 	//  - TestSzEngine_ExportCsvEntityReport
-	//  - TestSzEngine_ExportJSONEntityReport
+	//  - TestSzEngine_ExportJSONEntityReport_65536
 	_ = test
 }
 
@@ -385,61 +403,6 @@ func TestSzEngine_ExportCsvEntityReportIterator_nilCsvColumnList(test *testing.T
 	require.Equal(test, len(expected), actualCount)
 }
 
-func TestSzEngine_ExportJSONEntityReport(test *testing.T) {
-	ctx := test.Context()
-	records := []record.Record{
-		truthset.CustomerRecords["1001"],
-		truthset.CustomerRecords["1002"],
-		truthset.CustomerRecords["1003"],
-	}
-
-	defer func() { deleteRecords(ctx, records) }()
-
-	addRecords(ctx, records)
-
-	szEngine := getTestObject(ctx, test)
-	aRecord := testfixtures.FixtureRecords["65536-periods"]
-	flags := senzing.SzWithInfo
-	actual, err := szEngine.AddRecord(ctx, aRecord.DataSource, aRecord.ID, aRecord.JSON, flags)
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-
-	defer func() {
-		panicOnErrorWithString(szEngine.DeleteRecord(ctx, aRecord.DataSource, aRecord.ID, senzing.SzWithoutInfo))
-	}()
-
-	flags = senzing.SzExportDefaultFlags
-	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, flags)
-
-	defer func() {
-		err := szEngine.CloseExportReport(ctx, exportHandle)
-		require.NoError(test, err)
-	}()
-
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-
-	var jsonEntityReportBuilder strings.Builder
-
-	for {
-		jsonEntityReportFragment, err := szEngine.FetchNext(ctx, exportHandle)
-		printDebug(test, err, actual)
-		require.NoError(test, err)
-
-		if len(jsonEntityReportFragment) == 0 {
-			break
-		}
-
-		jsonEntityReportBuilder.WriteString(jsonEntityReportFragment)
-	}
-
-	jsonEntityReport := jsonEntityReportBuilder.String()
-
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-	require.NotEmpty(test, jsonEntityReport)
-}
-
 func TestSzEngine_ExportJSONEntityReport_65536(test *testing.T) {
 	ctx := test.Context()
 	szEngine := getTestObject(ctx, test)
@@ -490,36 +453,10 @@ func TestSzEngine_ExportJSONEntityReport_error(test *testing.T) {
 	_ = test
 }
 
-func TestSzEngine_ExportJSONEntityReportIterator(test *testing.T) {
-	ctx := test.Context()
-	records := []record.Record{
-		truthset.CustomerRecords["1001"],
-		truthset.CustomerRecords["1002"],
-		truthset.CustomerRecords["1003"],
-	}
-
-	defer func() { deleteRecords(ctx, records) }()
-
-	addRecords(ctx, records)
-
-	expected := 1
-	szEngine := getTestObject(ctx, test)
-	flags := senzing.SzExportIncludeAllEntities
-	actualCount := 0
-
-	for actual := range szEngine.ExportJSONEntityReportIterator(ctx, flags) {
-		printDebug(test, actual.Error, actual.Value)
-		require.NoError(test, actual.Error)
-
-		actualCount++
-	}
-
-	require.Equal(test, expected, actualCount)
-}
-
 func TestSzEngine_FetchNext(test *testing.T) {
 	// Tested in:
-	//  - TestSzEngine_ExportJSONEntityReport
+	//  - TestSzEngine_Conformance
+	//  - TestSzEngine_ExportJSONEntityReport_65536
 	_ = test
 }
 
@@ -858,14 +795,6 @@ func TestSzEngine_FindPathByRecordID(test *testing.T) {
 	}
 }
 
-func TestSzEngine_GetActiveConfigID(test *testing.T) {
-	ctx := test.Context()
-	szEngine := getTestObject(ctx, test)
-	actual, err := szEngine.GetActiveConfigID(ctx)
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-}
-
 func TestSzEngine_GetEntityByEntityID(test *testing.T) {
 	ctx := test.Context()
 	testCases := getTestCasesForGetEntityByEntityID()
@@ -982,22 +911,6 @@ func TestSzEngine_GetRecord(test *testing.T) {
 	}
 }
 
-func TestSzEngine_GetRedoRecord(test *testing.T) {
-	ctx := test.Context()
-	szEngine := getTestObject(ctx, test)
-	actual, err := szEngine.GetRedoRecord(ctx)
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-}
-
-func TestSzEngine_GetStats(test *testing.T) {
-	ctx := test.Context()
-	szEngine := getTestObject(ctx, test)
-	actual, err := szEngine.GetStats(ctx)
-	printDebug(test, err, actual)
-	require.NoError(test, err)
-}
-
 func TestSzEngine_GetVirtualEntityByRecordID(test *testing.T) {
 	ctx := test.Context()
 	testCases := getTestCasesForGetVirtualEntityByRecordID()
@@ -1119,14 +1032,6 @@ func TestSzEngine_GetRecordPreview(test *testing.T) {
 	}
 }
 
-func TestSzEngine_PrimeEngine(test *testing.T) {
-	ctx := test.Context()
-	szEngine := getTestObject(ctx, test)
-	err := szEngine.PrimeEngine(ctx)
-	printDebug(test, err)
-	require.NoError(test, err)
-}
-
 func TestSzEngine_ProcessRedoRecord(test *testing.T) {
 	ctx := test.Context()
 	testCases := getTestCasesForProcessRedoRecord()
@@ -1805,12 +1710,6 @@ func panicOnError(err error) {
 	}
 }
 
-func panicOnErrorWithString(aString string, err error) {
-	_ = aString
-
-	panicOnError(err)
-}
-
 func printDebug(t *testing.T, err error, items ...any) {
 	t.Helper()
 
@@ -1845,6 +1744,16 @@ func setup() {
 	grpcConnection := getGrpcConnection(ctx)
 	senzingVersion = getversion.GetSenzingVersion(ctx, grpcConnection)
 
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{GrpcConnection: grpcConnection} //exhaustruct:ignore
+
+	szAbstractFactory.RegisterCapability(ctx, capabilityJSONParsingFailure,
+		szabstractfactory.CapabilityRequirement{MinVersion: "4.2", RPC: ""})
+
+	var err error
+
+	supportsJSONParsingFailure, err = szAbstractFactory.Supports(ctx, capabilityJSONParsingFailure)
+	panicOnError(err)
+
 	setupSenzingConfiguration()
 	setupPurgeRepository()
 }
@@ -2136,9 +2045,6 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 			expectedErr:        szerror.ErrSzBadInput,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"function":"szengineserver.(*SzEngineServer).AddRecord","error":{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044001","reason":"SENZ0024|Conflicting RECORD_ID values 'BadRecordID' and '1001'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:           "nilDataSourceCode",
 			dataSourceCode: nilDataSourceCode,
@@ -2155,10 +2061,6 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 			name:     "nilRecordID",
 			recordID: nilRecordID,
 		},
-		{
-			name:  "withInfo",
-			flags: senzing.SzWithInfo,
-		},
 		{
 			name:               "withInfo_badDataSourceCode",
 			dataSourceCode:     badDataSourceCode,
@@ -2224,13 +2126,6 @@ func getTestCasesForDeleteRecord() []TestMetadataForDeleteRecord {
 			expectedErr:        szerror.ErrSz,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).DeleteRecord","error":{"function":"szengineserver.(*SzEngineServer).DeleteRecord","error":{"function":"szengine.(*Szengine).DeleteRecord","error":{"id":"SZSDK60044004","reason":"SENZ2207|Data source code [BADDATASOURCECODE] does not exist."}}}}`,
 		},
-		{
-			name:     "badRecordID",
-			recordID: badRecordID,
-		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -2243,10 +2138,6 @@ func getTestCasesForDeleteRecord() []TestMetadataForDeleteRecord {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).DeleteRecord","error":{"function":"szengineserver.(*SzEngineServer).DeleteRecord","error":{"function":"szengine.(*Szengine).DeleteRecord","error":{"id":"SZSDK60044004","reason":"SENZ0053|RECORD_ID must be provided"}}}}`,
 			recordID:           nilRecordID,
 		},
-		{
-			name:  "withInfo",
-			flags: senzing.SzWithInfo,
-		},
 		{
 			name:               "withInfo_badDataSourceCode",
 			flags:              senzing.SzWithInfo,
@@ -2286,9 +2177,6 @@ func getTestCasesForFindInterestingEntitiesByEntityID() []TestMetadataForFindInt
 			expectedErr:        szerror.ErrSzNotFound,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindInterestingEntitiesByEntityID","error":{"function":"szengineserver.(*SzEngineServer).FindInterestingEntitiesByEntityId","error":{"function":"szengine.(*Szengine).FindInterestingEntitiesByEntityID","error":{"id":"SZSDK60044010","reason":"SENZ0037|Unknown resolved entity value '-1'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilEntityID",
 			entityID:           nilEntityID,
@@ -2314,9 +2202,6 @@ func getTestCasesForFindInterestingEntitiesByRecordID() []TestMetadataForFindInt
 			expectedErr:        szerror.ErrSzNotFound,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindInterestingEntitiesByRecordID","error":{"function":"szengineserver.(*SzEngineServer).FindInterestingEntitiesByRecordId","error":{"function":"szengine.(*Szengine).FindInterestingEntitiesByRecordID","error":{"id":"SZSDK60044011","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -2359,9 +2244,6 @@ func getTestCasesForFindNetworkByEntityID() []TestMetadataForFindNetworkByEntity
 			expectedErr:        szerror.ErrSz,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindNetworkByEntityID","error":{"function":"szengineserver.(*SzEngineServer).FindNetworkByEntityId","error":{"function":"szengine.(*Szengine).FindNetworkByEntityID","error":{"id":"SZSDK60044013","reason":"SENZ0031|Invalid value of max degree '-1'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:            "nilBuildOutDegrees",
 			buildOutDegrees: nilBuildOutDegrees,
@@ -2411,9 +2293,6 @@ func getTestCasesForFindNetworkByRecordID() []TestMetadataForFindNetworkByRecord
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindNetworkByRecordID","error":{"function":"szengineserver.(*SzEngineServer).FindNetworkByRecordId","error":{"function":"szengine.(*Szengine).FindNetworkByRecordID","error":{"id":"SZSDK60044015","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordKeys:         recordKeysFuncBadRecordIDFunc,
 		},
-		{
-			name: "default",
-		},
 	}
 
 	return result
@@ -2468,13 +2347,6 @@ func getTestCasesForFindPathByEntityID() []TestMetadataForFindPathByEntityID {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"function":"szengineserver.(*SzEngineServer).FindPathByEntityId","error":{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"id":"SZSDK60044017","reason":"SENZ0037|Unknown resolved entity value '-1'"}}}}`,
 			startEntityID:      badEntityID,
 		},
-		{
-			name: "default",
-		},
-		{
-			name:                "including",
-			requiredDataSources: requiredDataSourcesFunc,
-		},
 		{
 			name:                "including_badStartEntityID",
 			expectedErr:         szerror.ErrSzNotFound,
@@ -2549,9 +2421,6 @@ func getTestCasesForFindPathByRecordID() []TestMetadataForFindPathByRecordID {
 			expectedErr:        szerror.ErrSzBadInput,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"function":"szengineserver.(*SzEngineServer).FindPathByRecordId","error":{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"id":"SZSDK60044023","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:                "including",
 			avoidRecordKeys:     avoidRecordIDsFunc,
@@ -2583,9 +2452,6 @@ func getTestCasesForGetEntityByEntityID() []TestMetadataForGetEntityByEntityID {
 			expectedErr:        szerror.ErrSzNotFound,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).GetEntityByEntityID","error":{"function":"szengineserver.(*SzEngineServer).GetEntityByEntityId","error":{"function":"szengine.(*Szengine).GetEntityByEntityID","error":{"id":"SZSDK60044030","reason":"SENZ0037|Unknown resolved entity value '-1'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilEntityID",
 			entityID:           nilEntityID,
@@ -2611,9 +2477,6 @@ func getTestCasesForGetEntityByRecordID() []TestMetadataForGetEntityByRecordID {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).GetEntityByRecordID","error":{"function":"szengineserver.(*SzEngineServer).GetEntityByRecordId","error":{"function":"szengine.(*Szengine).GetEntityByRecordID","error":{"id":"SZSDK60044032","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordID:           badRecordID,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -2645,9 +2508,6 @@ func getTestCasesForGetRecord() []TestMetadataForGetRecord {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecord","error":{"function":"szengineserver.(*SzEngineServer).GetRecord","error":{"function":"szengine.(*Szengine).GetRecord","error":{"id":"SZSDK60044035","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordID:           badRecordID,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -2679,9 +2539,6 @@ func getTestCasesForGetVirtualEntityByRecordID() []TestMetadataForGetVirtualEnti
 			expectedErrMessage: `{"function":"szengine.(*Szengine).GetVirtualEntityByRecordID","error":{"function":"szengineserver.(*SzEngineServer).GetVirtualEntityByRecordId","error":{"function":"szengine.(*Szengine).GetVirtualEntityByRecordID","error":{"id":"SZSDK60044038","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordKeys:         badRecordKeysFunc,
 		},
-		{
-			name: "default",
-		},
 	}
 
 	return result
@@ -2695,9 +2552,6 @@ func getTestCasesForHowEntityByEntityID() []TestMetadataForHowEntityByEntityID {
 			expectedErr:        szerror.ErrSzNotFound,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).HowEntityByEntityID","error":{"function":"szengineserver.(*SzEngineServer).HowEntityByEntityId","error":{"function":"szengine.(*Szengine).HowEntityByEntityID","error":{"id":"SZSDK60044040","reason":"SENZ0037|Unknown resolved entity value '-1'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilEntityID",
 			entityID:           nilEntityID,
@@ -2719,12 +2573,12 @@ func getTestCasesForGetRecordPreview() []TestMetadataForGetRecordPreview {
 	}
 
 	switch {
-	case senzingVersion < 40200:
+	case supportsJSONParsingFailure:
 		addendum = []TestMetadataForGetRecordPreview{
 			{
 				name:               "badRecordDefinition",
 				expectedErr:        szerror.ErrSzBadInput,
-				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"function":"szengineserver.(*SzEngineServer).GetRecordPreview","error":{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ0002|Invalid Message"}}}}`,
+				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"function":"szengineserver.(*SzEngineServer).GetRecordPreview","error":{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}}}`,
 				recordDefinition:   badRecordDefinition,
 			},
 		}
@@ -2733,7 +2587,7 @@ func getTestCasesForGetRecordPreview() []TestMetadataForGetRecordPreview {
 			{
 				name:               "badRecordDefinition",
 				expectedErr:        szerror.ErrSzBadInput,
-				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"function":"szengineserver.(*SzEngineServer).GetRecordPreview","error":{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}}}`,
+				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"function":"szengineserver.(*SzEngineServer).GetRecordPreview","error":{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ0002|Invalid Message"}}}}`,
 				recordDefinition:   badRecordDefinition,
 			},
 		}
@@ -2752,19 +2606,12 @@ func getTestCasesForProcessRedoRecord() []TestMetadataForProcessRedoRecord {
 			// expectedErrMessage: ``,
 			redoRecord: badRedoRecord,
 		},
-		{
-			name: "default",
-		},
 		{
 			name: "nilRedoRecord",
 			// expectedErr:        szerror.ErrSzBadInput,
 			// expectedErrMessage: ``,
 			redoRecord: nilRedoRecord,
 		},
-		{
-			name:  "withInfo",
-			flags: senzing.SzWithInfo,
-		},
 		{
 			name: "withInfo_badRedoRecord",
 			// expectedErr:        szerror.ErrSzConfiguration,
@@ -2786,21 +2633,10 @@ func getTestCasesForProcessRedoRecord() []TestMetadataForProcessRedoRecord {
 
 func getTestCasesForReevaluateEntity() []TestMetadataForReevaluateEntity {
 	result := []TestMetadataForReevaluateEntity{
-		{
-			name:     "badEntityID",
-			entityID: badEntityID,
-		},
-		{
-			name: "default",
-		},
 		{
 			name:     "nilEntityID",
 			entityID: nilEntityID,
 		},
-		{
-			name:  "withInfo",
-			flags: senzing.SzWithInfo,
-		},
 		{
 			name:     "withInfo_badEntityID",
 			entityID: badEntityID,
@@ -2824,13 +2660,6 @@ func getTestCasesForReevaluateRecord() []TestMetadataForReevaluateRecord {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).ReevaluateRecord","error":{"function":"szengineserver.(*SzEngineServer).ReevaluateRecord","error":{"function":"szengine.(*Szengine).ReevaluateRecord","error":{"id":"SZSDK60044048","reason":"SENZ2207|Data source code [BADDATASOURCECODE] does not exist."}}}}`,
 			dataSourceCode:     badDataSourceCode,
 		},
-		{
-			name:     "badRecordID",
-			recordID: badRecordID,
-		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -2843,10 +2672,6 @@ func getTestCasesForReevaluateRecord() []TestMetadataForReevaluateRecord {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).ReevaluateRecord","error":{"function":"szengineserver.(*SzEngineServer).ReevaluateRecord","error":{"function":"szengine.(*Szengine).ReevaluateRecord","error":{"id":"SZSDK60044048","reason":"SENZ0053|RECORD_ID must be provided"}}}}`,
 			recordID:           nilRecordID,
 		},
-		{
-			name:  "withInfo",
-			flags: senzing.SzWithInfo,
-		},
 		{
 			name:               "withInfo_badDataSourceCode",
 			dataSourceCode:     badDataSourceCode,
@@ -2892,9 +2717,6 @@ func getTestCasesForSearchByAttributes() []TestMetadataForSearchByAttributes {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).SearchByAttributes","error":{"function":"szengineserver.(*SzEngineServer).SearchByAttributes","error":{"function":"szengine.(*Szengine).SearchByAttributes","error":{"id":"SZSDK60044053","reason":"SENZ0088|Unknown search profile value '}{'"}}}}`,
 			searchProfile:      badSearchProfile,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilAttributes",
 			attributes:         nilAttributes,
@@ -2924,9 +2746,6 @@ func getTestCasesForWhyEntities() []TestMetadataForWhyEntities {
 			expectedErr:        szerror.ErrSzNotFound,
 			expectedErrMessage: `{"function":"szengine.(*Szengine).WhyEntities","error":{"function":"szengineserver.(*SzEngineServer).WhyEntities","error":{"function":"szengine.(*Szengine).WhyEntities","error":{"id":"SZSDK60044056","reason":"SENZ0037|Unknown resolved entity value '-1'"}}}}`,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilEnitity1",
 			expectedErr:        szerror.ErrSzNotFound,
@@ -2958,9 +2777,6 @@ func getTestCasesForWhyRecordInEntity() []TestMetadataForWhyRecordInEntity {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).WhyRecordInEntity","error":{"function":"szengineserver.(*SzEngineServer).WhyRecordInEntity","error":{"function":"szengine.(*Szengine).WhyRecordInEntity","error":{"id":"SZSDK60044058","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordID:           badRecordID,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode",
 			dataSourceCode:     nilDataSourceCode,
@@ -3004,9 +2820,6 @@ func getTestCasesForWhyRecords() []TestMetadataForWhyRecords {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).WhyRecords","error":{"function":"szengineserver.(*SzEngineServer).WhyRecords","error":{"function":"szengine.(*Szengine).WhyRecords","error":{"id":"SZSDK60044060","reason":"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[BadRecordID]"}}}}`,
 			recordID2:          badRecordID,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilDataSourceCode1",
 			dataSourceCode1:    nilDataSourceCode,
@@ -3056,9 +2869,6 @@ func getTestCasesForWhySearch() []TestMetadataForWhySearch {
 			expectedErrMessage: `{"function":"szengine.(*Szengine).WhySearch","error":{"function":"szengineserver.(*SzEngineServer).WhySearch","error":{"function":"szengine.(*Szengine).WhySearch","error":{"id":"SZSDK60044064","reason":"SENZ0088|Unknown search profile value '}{'"}}}}`,
 			searchProfile:      badSearchProfile,
 		},
-		{
-			name: "default",
-		},
 		{
 			name:               "nilAttributes",
 			attributes:         nilAttributes,
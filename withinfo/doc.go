@@ -0,0 +1,16 @@
+/*
+Package withinfo parses the "WithInfo" JSON returned by SzEngine methods called with the senzing.SzWithInfo flag
+and publishes typed events describing the entities affected by each change.
+
+The [Szengine] type decorates any senzing.SzEngine.
+After a successful AddRecord, DeleteRecord, ReevaluateEntity, ReevaluateRecord or ProcessRedoRecord,
+it parses the returned information and delivers an [Event] to a callback, a channel, or both.
+Downstream caches and search indexes can subscribe to these events instead of parsing the JSON themselves.
+
+# Related information
+
+  - [Senzing Go SDK API definitions]
+
+[Senzing Go SDK API definitions]: https://github.com/senzing-garage/sz-sdk-go
+*/
+package withinfo
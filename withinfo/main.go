@@ -0,0 +1,35 @@
+package withinfo
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Operation identifies the SzEngine method that produced an [Event].
+*/
+type Operation string
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+SzEngine methods that return "WithInfo" information.
+*/
+const (
+	OperationAddRecord         Operation = "AddRecord"
+	OperationDeleteRecord      Operation = "DeleteRecord"
+	OperationProcessRedoRecord Operation = "ProcessRedoRecord"
+	OperationReevaluateEntity  Operation = "ReevaluateEntity"
+	OperationReevaluateRecord  Operation = "ReevaluateRecord"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("withinfo")
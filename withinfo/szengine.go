@@ -0,0 +1,221 @@
+package withinfo
+
+import (
+	"context"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Event describes the entities affected by a single SzEngine operation.
+*/
+type Event struct {
+	AffectedEntityIDs   []int64
+	DataSource          string
+	Info                *Info
+	InterestingEntities []InterestingEntity
+	Operation           Operation
+	RecordID            string
+}
+
+/*
+EventHandler receives events synchronously, on the goroutine that called the SzEngine method.
+*/
+type EventHandler func(ctx context.Context, event Event)
+
+/*
+Szengine decorates a senzing.SzEngine, publishing an [Event] for each operation that returns "WithInfo" information.
+Methods that are not overridden are passed through to the wrapped SzEngine.
+*/
+type Szengine struct {
+	senzing.SzEngine
+
+	// If true, senzing.SzWithInfo is added to the flags of every write so that events are always published.
+	// The information is only returned to the caller if the caller requested it.
+	AlwaysWithInfo bool
+
+	// Optional. Called for each event before it is sent to Events.
+	Handler EventHandler
+
+	// Optional. Each event is sent to this channel. Sending blocks until received or until ctx is done.
+	Events chan<- Event
+
+	// Optional. Called when returned information cannot be parsed. The SzEngine result is still returned.
+	ErrorHandler func(ctx context.Context, err error)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AddRecord adds a record and publishes an [Event] for the affected entities.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - recordDefinition: A JSON document containing the record to be added to the Senzing repository.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) AddRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (string, error) {
+	return client.call(ctx, OperationAddRecord, flags, func(flags int64) (string, error) {
+		return client.SzEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+	})
+}
+
+/*
+Method DeleteRecord deletes a record and publishes an [Event] for the affected entities.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) DeleteRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return client.call(ctx, OperationDeleteRecord, flags, func(flags int64) (string, error) {
+		return client.SzEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+/*
+Method ProcessRedoRecord processes a redo record and publishes an [Event] for the affected entities.
+
+Input
+  - ctx: A context to control lifecycle.
+  - redoRecord: A redo record retrieved from GetRedoRecord.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	return client.call(ctx, OperationProcessRedoRecord, flags, func(flags int64) (string, error) {
+		return client.SzEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+	})
+}
+
+/*
+Method ReevaluateEntity reevaluates an entity and publishes an [Event] for the affected entities.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.call(ctx, OperationReevaluateEntity, flags, func(flags int64) (string, error) {
+		return client.SzEngine.ReevaluateEntity(ctx, entityID, flags)
+	})
+}
+
+/*
+Method ReevaluateRecord reevaluates a record and publishes an [Event] for the affected entities.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document containing metadata as specified by the flags.
+*/
+func (client *Szengine) ReevaluateRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (string, error) {
+	return client.call(ctx, OperationReevaluateRecord, flags, func(flags int64) (string, error) {
+		return client.SzEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (client *Szengine) call(
+	ctx context.Context,
+	operation Operation,
+	flags int64,
+	method func(flags int64) (string, error),
+) (string, error) {
+	requested := flags&senzing.SzWithInfo != 0
+	if client.AlwaysWithInfo {
+		flags |= senzing.SzWithInfo
+	}
+
+	result, err := method(flags)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	if flags&senzing.SzWithInfo != 0 {
+		client.publish(ctx, operation, result)
+	}
+
+	if client.AlwaysWithInfo && !requested {
+		result = ""
+	}
+
+	return result, nil
+}
+
+func (client *Szengine) publish(ctx context.Context, operation Operation, infoJSON string) {
+	info, err := Parse(infoJSON)
+	if err != nil {
+		if client.ErrorHandler != nil {
+			client.ErrorHandler(ctx, wraperror.Errorf(err, "%s", operation))
+		}
+
+		return
+	}
+
+	event := Event{
+		AffectedEntityIDs:   info.AffectedEntityIDs(),
+		DataSource:          info.DataSource,
+		Info:                info,
+		InterestingEntities: info.InterestingEntities.Entities,
+		Operation:           operation,
+		RecordID:            info.RecordID,
+	}
+
+	if client.Handler != nil {
+		client.Handler(ctx, event)
+	}
+
+	if client.Events != nil {
+		select {
+		case client.Events <- event:
+		case <-ctx.Done():
+		}
+	}
+}
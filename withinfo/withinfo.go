@@ -0,0 +1,102 @@
+package withinfo
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Info is the "WithInfo" JSON document returned by SzEngine methods called with the senzing.SzWithInfo flag.
+*/
+type Info struct {
+	AffectedEntities    []AffectedEntity    `json:"AFFECTED_ENTITIES"`
+	DataSource          string              `json:"DATA_SOURCE"`
+	InterestingEntities InterestingEntities `json:"INTERESTING_ENTITIES"`
+	RecordID            string              `json:"RECORD_ID"`
+}
+
+/*
+AffectedEntity identifies an entity created, changed, or removed by an operation.
+*/
+type AffectedEntity struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+/*
+InterestingEntities holds the entities flagged as interesting by an operation.
+*/
+type InterestingEntities struct {
+	Entities []InterestingEntity `json:"ENTITIES"`
+}
+
+/*
+InterestingEntity is an entity, within a number of degrees of the changed entity, flagged as interesting.
+*/
+type InterestingEntity struct {
+	Degrees       int64       `json:"DEGREES"`
+	EntityID      int64       `json:"ENTITY_ID"`
+	Flags         []string    `json:"FLAGS"`
+	SampleRecords []RecordKey `json:"SAMPLE_RECORDS"`
+}
+
+/*
+RecordKey identifies a record by data source code and record identifier.
+*/
+type RecordKey struct {
+	DataSource string   `json:"DATA_SOURCE"`
+	Flags      []string `json:"FLAGS"`
+	RecordID   string   `json:"RECORD_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Parse function converts a "WithInfo" JSON document into an [Info].
+
+Input
+  - infoJSON: The JSON returned by an SzEngine method called with the senzing.SzWithInfo flag.
+
+Output
+  - The parsed information.
+*/
+func Parse(infoJSON string) (*Info, error) {
+	if len(strings.TrimSpace(infoJSON)) == 0 {
+		return nil, wraperror.Errorf(errForPackage, "empty WithInfo document")
+	}
+
+	result := &Info{} //exhaustruct:ignore
+
+	err := json.Unmarshal([]byte(infoJSON), result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Info methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AffectedEntityIDs returns the identifiers of the affected entities, in the order reported.
+
+Output
+  - Entity identifiers.
+*/
+func (info *Info) AffectedEntityIDs() []int64 {
+	result := make([]int64, 0, len(info.AffectedEntities))
+	for _, affectedEntity := range info.AffectedEntities {
+		result = append(result, affectedEntity.EntityID)
+	}
+
+	return result
+}
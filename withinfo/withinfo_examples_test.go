@@ -0,0 +1,52 @@
+package withinfo_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-grpc/withinfo"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleParse() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/withinfo/withinfo_test.go
+	info, err := withinfo.Parse(`{"AFFECTED_ENTITIES":[{"ENTITY_ID":100}],"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"2171"}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(info.AffectedEntityIDs())
+	// Output: [100]
+}
+
+func ExampleSzengine_AddRecord() {
+	// For more information, visit https://github.com/senzing-garage/sz-sdk-go-grpc/blob/main/withinfo/withinfo_test.go
+	ctx := context.TODO()
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine:       getSzEngine(ctx),
+		AlwaysWithInfo: true,
+		Handler: func(_ context.Context, event withinfo.Event) {
+			fmt.Printf("%s %s:%s affected %v\n", event.Operation, event.DataSource, event.RecordID, event.AffectedEntityIDs)
+		},
+	}
+
+	_, err := szEngine.AddRecord(ctx, "CUSTOMERS", "2171", `{"NAME_FULL": "Robert Smith"}`, senzing.SzNoFlags)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output: AddRecord CUSTOMERS:2171 affected [100]
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func getSzEngine(_ context.Context) senzing.SzEngine {
+	return &fakeSzEngine{ //exhaustruct:ignore
+		info: `{"AFFECTED_ENTITIES":[{"ENTITY_ID":100}],"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"2171"}`,
+	}
+}
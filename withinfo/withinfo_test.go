@@ -0,0 +1,220 @@
+package withinfo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	truncator "github.com/aquilax/truncate"
+	"github.com/senzing-garage/sz-sdk-go-grpc/withinfo"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultTruncation = 76
+	printErrors       = false
+	printResults      = false
+)
+
+const (
+	addRecordInfo = `{"AFFECTED_ENTITIES":[{"ENTITY_ID":100},{"ENTITY_ID":101}],"DATA_SOURCE":"CUSTOMERS",` +
+		`"INTERESTING_ENTITIES":{"ENTITIES":[{"DEGREES":1,"ENTITY_ID":200,"FLAGS":["SAME_NAME"],` +
+		`"SAMPLE_RECORDS":[{"DATA_SOURCE":"WATCHLIST","FLAGS":["SAME_NAME"],"RECORD_ID":"1"}]}]},"RECORD_ID":"2171"}`
+	reevaluateEntityInfo = `{"AFFECTED_ENTITIES":[{"ENTITY_ID":100}],"DATA_SOURCE":"","RECORD_ID":""}`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParse(test *testing.T) {
+	info, err := withinfo.Parse(addRecordInfo)
+	printDebug(test, err, info)
+	require.NoError(test, err)
+	require.Equal(test, "CUSTOMERS", info.DataSource)
+	require.Equal(test, "2171", info.RecordID)
+	require.Equal(test, []int64{100, 101}, info.AffectedEntityIDs())
+	require.Len(test, info.InterestingEntities.Entities, 1)
+	require.Equal(test, int64(200), info.InterestingEntities.Entities[0].EntityID)
+	require.Equal(test, "WATCHLIST", info.InterestingEntities.Entities[0].SampleRecords[0].DataSource)
+}
+
+func TestParse_empty(test *testing.T) {
+	_, err := withinfo.Parse("")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestParse_badJSON(test *testing.T) {
+	_, err := withinfo.Parse("{")
+	printDebug(test, err)
+	require.Error(test, err)
+}
+
+func TestSzengine_AddRecord_handler(test *testing.T) {
+	ctx := test.Context()
+	events := []withinfo.Event{}
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{info: addRecordInfo}, //exhaustruct:ignore
+		Handler: func(_ context.Context, event withinfo.Event) {
+			events = append(events, event)
+		},
+	}
+	actual, err := szEngine.AddRecord(ctx, "CUSTOMERS", "2171", "{}", senzing.SzWithInfo)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Equal(test, addRecordInfo, actual)
+	require.Len(test, events, 1)
+	require.Equal(test, withinfo.OperationAddRecord, events[0].Operation)
+	require.Equal(test, []int64{100, 101}, events[0].AffectedEntityIDs)
+	require.Equal(test, "2171", events[0].RecordID)
+	require.Len(test, events[0].InterestingEntities, 1)
+}
+
+func TestSzengine_DeleteRecord_channel(test *testing.T) {
+	ctx := test.Context()
+	events := make(chan withinfo.Event, 1)
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{info: addRecordInfo}, //exhaustruct:ignore
+		Events:   events,
+	}
+	_, err := szEngine.DeleteRecord(ctx, "CUSTOMERS", "2171", senzing.SzWithInfo)
+	require.NoError(test, err)
+
+	event := <-events
+	printDebug(test, err, event)
+	require.Equal(test, withinfo.OperationDeleteRecord, event.Operation)
+	require.Equal(test, "CUSTOMERS", event.DataSource)
+}
+
+func TestSzengine_ReevaluateEntity_alwaysWithInfo(test *testing.T) {
+	ctx := test.Context()
+	events := []withinfo.Event{}
+	fake := &fakeSzEngine{info: reevaluateEntityInfo} //exhaustruct:ignore
+	szEngine := &withinfo.Szengine{                   //exhaustruct:ignore
+		SzEngine:       fake,
+		AlwaysWithInfo: true,
+		Handler: func(_ context.Context, event withinfo.Event) {
+			events = append(events, event)
+		},
+	}
+	actual, err := szEngine.ReevaluateEntity(ctx, 100, senzing.SzNoFlags)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	require.Empty(test, actual)
+	require.Equal(test, senzing.SzWithInfo, fake.lastFlags)
+	require.Len(test, events, 1)
+	require.Equal(test, withinfo.OperationReevaluateEntity, events[0].Operation)
+	require.Equal(test, []int64{100}, events[0].AffectedEntityIDs)
+}
+
+func TestSzengine_ReevaluateRecord_withoutInfo(test *testing.T) {
+	ctx := test.Context()
+	events := []withinfo.Event{}
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{info: ""}, //exhaustruct:ignore
+		Handler: func(_ context.Context, event withinfo.Event) {
+			events = append(events, event)
+		},
+	}
+	_, err := szEngine.ReevaluateRecord(ctx, "CUSTOMERS", "2171", senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.Empty(test, events)
+}
+
+func TestSzengine_ProcessRedoRecord_error(test *testing.T) {
+	ctx := test.Context()
+	events := []withinfo.Event{}
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{err: szerror.New(10, `{"reason":"SENZ0010|Retry timeout exceeded"}`)}, //exhaustruct:ignore
+		Handler: func(_ context.Context, event withinfo.Event) {
+			events = append(events, event)
+		},
+	}
+	_, err := szEngine.ProcessRedoRecord(ctx, "{}", senzing.SzWithInfo)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+	require.Empty(test, events)
+}
+
+func TestSzengine_badInfo(test *testing.T) {
+	ctx := test.Context()
+
+	var handledErr error
+
+	szEngine := &withinfo.Szengine{ //exhaustruct:ignore
+		SzEngine: &fakeSzEngine{info: "not JSON"}, //exhaustruct:ignore
+		ErrorHandler: func(_ context.Context, err error) {
+			handledErr = err
+		},
+	}
+	actual, err := szEngine.AddRecord(ctx, "CUSTOMERS", "2171", "{}", senzing.SzWithInfo)
+	printDebug(test, handledErr, actual)
+	require.NoError(test, err)
+	require.Equal(test, "not JSON", actual)
+	require.Error(test, handledErr)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+type fakeSzEngine struct {
+	senzing.SzEngine
+
+	err       error
+	info      string
+	lastFlags int64
+}
+
+func (engine *fakeSzEngine) AddRecord(_ context.Context, _ string, _ string, _ string, flags int64) (string, error) {
+	return engine.respond(flags)
+}
+
+func (engine *fakeSzEngine) DeleteRecord(_ context.Context, _ string, _ string, flags int64) (string, error) {
+	return engine.respond(flags)
+}
+
+func (engine *fakeSzEngine) ProcessRedoRecord(_ context.Context, _ string, flags int64) (string, error) {
+	return engine.respond(flags)
+}
+
+func (engine *fakeSzEngine) ReevaluateEntity(_ context.Context, _ int64, flags int64) (string, error) {
+	return engine.respond(flags)
+}
+
+func (engine *fakeSzEngine) ReevaluateRecord(_ context.Context, _ string, _ string, flags int64) (string, error) {
+	return engine.respond(flags)
+}
+
+func (engine *fakeSzEngine) respond(flags int64) (string, error) {
+	engine.lastFlags = flags
+	if engine.err != nil {
+		return "", engine.err
+	}
+
+	if flags&senzing.SzWithInfo == 0 {
+		return "", nil
+	}
+
+	return engine.info, nil
+}
+
+func printDebug(t *testing.T, err error, items ...any) {
+	t.Helper()
+
+	if printErrors {
+		if err != nil {
+			t.Logf("Error: %s\n", err.Error())
+		}
+	}
+
+	if printResults {
+		for _, item := range items {
+			outLine := truncator.Truncate(fmt.Sprintf("%v", item), defaultTruncation, "...", truncator.PositionEnd)
+			t.Logf("Result: %s\n", outLine)
+		}
+	}
+}